@@ -0,0 +1,181 @@
+package streamz
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+)
+
+// AbsenceEvent describes a key that stopped reporting, detected by AbsenceDetector.
+type AbsenceEvent struct {
+	Key      string        // Key that went silent
+	LastSeen time.Time     // Arrival of the key's last item
+	Timeout  time.Duration // Silence tolerated before alerting
+}
+
+// AbsenceDetector is a dead-man's switch for keyed streams: it remembers when
+// each key last reported and raises an alert when a key that was active stops
+// reporting, such as a service whose heartbeats have ceased.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type AbsenceDetector[T any] struct {
+	name    string
+	keyFn   func(T) string
+	timeout time.Duration
+	clock   Clock
+}
+
+// NewAbsenceDetector creates a processor that alerts when a key has been silent for timeout.
+// Every item passes through unchanged on the first output. A key becomes active
+// when one of its items arrives; if no further item with that key arrives within
+// timeout, an AbsenceEvent is emitted on the alert output and the key is forgotten.
+// Each silence therefore alerts once, and a key that re-appears is tracked afresh
+// and alerts again if it goes silent again. Keys never seen are never reported.
+//
+// Upstream errors pass through without being tracked. Alerts still pending when
+// the input closes are not emitted. Items and alerts are sent from the same
+// goroutine, so both outputs must be consumed to avoid blocking.
+//
+// When to use:
+//   - Detecting services or devices whose heartbeats stopped
+//   - Alerting on producers that stop sending data
+//   - Noticing sessions that went quiet without a goodbye
+//
+// Example:
+//
+//	// Alert when a host misses 30 seconds of heartbeats
+//	detector := streamz.NewAbsenceDetector(func(h Heartbeat) string {
+//		return h.Host
+//	}, 30*time.Second, streamz.RealClock)
+//
+//	beats, alerts := detector.Process(ctx, heartbeats)
+//	go func() {
+//		for alert := range alerts {
+//			pager.Send(fmt.Sprintf("%s silent since %v", alert.Value().Key, alert.Value().LastSeen))
+//		}
+//	}()
+//	store(beats)
+//
+// Parameters:
+//   - keyFn: Extracts the key whose presence is tracked
+//   - timeout: Silence tolerated per key before an alert (must be positive)
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new AbsenceDetector processor.
+// Panics if timeout is not positive.
+func NewAbsenceDetector[T any](keyFn func(T) string, timeout time.Duration, clock Clock) *AbsenceDetector[T] {
+	if timeout <= 0 {
+		panic("timeout must be positive")
+	}
+	return &AbsenceDetector[T]{
+		name:    "absence-detector",
+		keyFn:   keyFn,
+		timeout: timeout,
+		clock:   clock,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "absence-detector".
+func (d *AbsenceDetector[T]) WithName(name string) *AbsenceDetector[T] {
+	d.name = name
+	return d
+}
+
+// Process forwards every item and returns the item channel and the alert channel.
+// Both channels close when the input closes or the context is canceled.
+func (d *AbsenceDetector[T]) Process(ctx context.Context, in <-chan Result[T]) (items <-chan Result[T], alerts <-chan Result[AbsenceEvent]) {
+	out := make(chan Result[T])
+	alertOut := make(chan Result[AbsenceEvent])
+
+	go func() {
+		defer close(out)
+		defer close(alertOut)
+
+		lastSeen := make(map[string]time.Time)
+
+		// A single timer tracks the earliest deadline among active keys
+		var timer Timer
+		var timerC <-chan time.Time
+		arm := func(deadline time.Time) {
+			timer = d.clock.NewTimer(deadline.Sub(d.clock.Now()))
+			timerC = timer.C()
+		}
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+
+				if item.IsSuccess() {
+					now := d.clock.Now()
+					lastSeen[d.keyFn(item.Value())] = now
+					if timerC == nil {
+						arm(now.Add(d.timeout))
+					}
+				}
+
+				select {
+				case out <- item:
+				case <-ctx.Done():
+					return
+				}
+
+			case <-timerC:
+				timerC = nil
+				now := d.clock.Now()
+
+				var next time.Time
+				var silent []AbsenceEvent
+				for key, seen := range lastSeen {
+					deadline := seen.Add(d.timeout)
+					if now.Before(deadline) {
+						if next.IsZero() || deadline.Before(next) {
+							next = deadline
+						}
+						continue
+					}
+					delete(lastSeen, key)
+					silent = append(silent, AbsenceEvent{Key: key, LastSeen: seen, Timeout: d.timeout})
+				}
+
+				// Report the longest silences first, by key on ties
+				slices.SortFunc(silent, func(a, b AbsenceEvent) int {
+					if c := a.LastSeen.Compare(b.LastSeen); c != 0 {
+						return c
+					}
+					return cmp.Compare(a.Key, b.Key)
+				})
+				for _, event := range silent {
+					select {
+					case alertOut <- NewSuccess(event):
+					case <-ctx.Done():
+						return
+					}
+				}
+
+				if !next.IsZero() {
+					arm(next)
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, alertOut
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (d *AbsenceDetector[T]) Name() string {
+	return d.name
+}
@@ -0,0 +1,174 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+func beat(device string) Result[heartbeat] {
+	return NewSuccess(heartbeat{Device: device})
+}
+
+func deviceKey(h heartbeat) string {
+	return h.Device
+}
+
+func TestAbsenceDetector_Name(t *testing.T) {
+	detector := NewAbsenceDetector(deviceKey, time.Second, RealClock)
+	if detector.Name() != "absence-detector" {
+		t.Errorf("expected name 'absence-detector', got %q", detector.Name())
+	}
+	if detector.WithName("heartbeat-watch").Name() != "heartbeat-watch" {
+		t.Errorf("expected name 'heartbeat-watch', got %q", detector.Name())
+	}
+}
+
+func TestAbsenceDetector_PanicsOnInvalidTimeout(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for non-positive timeout")
+		}
+	}()
+	NewAbsenceDetector(deviceKey, 0, RealClock)
+}
+
+func TestAbsenceDetector_SilentKeyAlertsOnce(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[heartbeat])
+	defer close(in)
+	items, alerts := NewAbsenceDetector(deviceKey, 10*time.Second, clock).Process(context.Background(), in)
+
+	seen := clock.Now()
+	if passed := sendWithBarrier(t, in, items, beat("sensor-1")); len(passed) != 1 {
+		t.Fatalf("expected heartbeat passed through, got %v", passed)
+	}
+
+	clock.Advance(9 * time.Second)
+	clock.BlockUntilReady()
+	select {
+	case alert := <-alerts:
+		t.Fatalf("unexpected alert before the timeout %+v", alert.Value())
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	clock.Advance(time.Second)
+	clock.BlockUntilReady()
+	select {
+	case alert := <-alerts:
+		if got := alert.Value(); got.Key != "sensor-1" || !got.LastSeen.Equal(seen) || got.Timeout != 10*time.Second {
+			t.Errorf("unexpected alert %+v", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for alert")
+	}
+
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+	select {
+	case alert := <-alerts:
+		t.Fatalf("expected a single alert per silence, got %+v", alert.Value())
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestAbsenceDetector_ReportingKeyNeverAlerts(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[heartbeat])
+	defer close(in)
+	items, alerts := NewAbsenceDetector(deviceKey, 10*time.Second, clock).Process(context.Background(), in)
+
+	for i := 0; i < 10; i++ {
+		sendWithBarrier(t, in, items, beat("sensor-1"))
+		clock.Advance(6 * time.Second)
+		clock.BlockUntilReady()
+	}
+	select {
+	case alert := <-alerts:
+		t.Fatalf("unexpected alert %+v", alert.Value())
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestAbsenceDetector_ReappearingKeyAlertsAgain(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[heartbeat])
+	defer close(in)
+	items, alerts := NewAbsenceDetector(deviceKey, 10*time.Second, clock).Process(context.Background(), in)
+
+	sendWithBarrier(t, in, items, beat("sensor-1"))
+	clock.Advance(10 * time.Second)
+	clock.BlockUntilReady()
+	select {
+	case <-alerts:
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for first alert")
+	}
+
+	clock.Advance(5 * time.Second)
+	reappeared := clock.Now()
+	sendWithBarrier(t, in, items, beat("sensor-1"))
+
+	clock.Advance(9 * time.Second)
+	clock.BlockUntilReady()
+	select {
+	case alert := <-alerts:
+		t.Fatalf("unexpected alert before the timeout %+v", alert.Value())
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	clock.Advance(time.Second)
+	clock.BlockUntilReady()
+	select {
+	case alert := <-alerts:
+		if !alert.Value().LastSeen.Equal(reappeared) {
+			t.Errorf("expected last seen %v, got %v", reappeared, alert.Value().LastSeen)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for second alert")
+	}
+}
+
+func TestAbsenceDetector_TracksKeysIndependently(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[heartbeat])
+	defer close(in)
+	items, alerts := NewAbsenceDetector(deviceKey, 10*time.Second, clock).Process(context.Background(), in)
+
+	sendWithBarrier(t, in, items, beat("sensor-1"))
+	clock.Advance(4 * time.Second)
+	sendWithBarrier(t, in, items, beat("sensor-2"))
+
+	// Errors pass through without registering a key
+	failed := sendWithBarrier(t, in, items, NewError(heartbeat{Device: "sensor-3"}, errors.New("bad frame"), "decode"))
+	if len(failed) != 1 || !failed[0].IsError() {
+		t.Fatalf("expected error passed through, got %v", failed)
+	}
+
+	for _, step := range []struct {
+		advance time.Duration
+		key     string
+	}{
+		{6 * time.Second, "sensor-1"},
+		{4 * time.Second, "sensor-2"},
+	} {
+		clock.Advance(step.advance)
+		clock.BlockUntilReady()
+		select {
+		case alert := <-alerts:
+			if alert.Value().Key != step.key {
+				t.Errorf("expected %s to go silent, got %q", step.key, alert.Value().Key)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timeout waiting for %s alert", step.key)
+		}
+		select {
+		case alert := <-alerts:
+			t.Fatalf("unexpected alert %+v", alert.Value())
+		case <-time.After(20 * time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package streamz
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// MetadataBatchEmitted records when an AdaptiveBatcher emitted a batch, so that
+// acknowledging the batch can measure how long downstream took to handle it.
+const MetadataBatchEmitted = "batch_emitted" // time.Time - emission time of the batch
+
+// AdaptiveBatcher groups items into batches whose size follows downstream
+// latency. Downstream acknowledges each batch once it has been written; batches
+// grow while writes finish faster than the target latency and shrink when they
+// take longer, so large batches are used when there is headroom and small ones
+// when the sink is struggling.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type AdaptiveBatcher[T any] struct {
+	name          string
+	minSize       int
+	maxSize       int
+	targetLatency time.Duration
+	maxWait       time.Duration
+	clock         Clock
+	mu            sync.Mutex
+	size          int
+}
+
+// NewAdaptiveBatcher creates a batcher that sizes batches from acknowledged latency.
+// Batches start at minSize. Each emitted batch carries MetadataBatchEmitted, and
+// each call to Ack measures the time since then and rescales the batch size by
+// targetLatency/latency, treating write time as roughly proportional to batch
+// size. A single adjustment at most doubles or halves the size, and the size
+// always stays within [minSize, maxSize].
+//
+// Errors pass through immediately as error Results and are never batched. A
+// partial batch is emitted when the input closes, or after WithMaxWait elapses
+// since its first item; without WithMaxWait a slow stream can hold a partial
+// batch until more items arrive.
+//
+// When to use:
+//   - Bulk writes to databases or APIs whose latency varies with load
+//   - Maximizing throughput without pushing a sink past its latency budget
+//   - Replacing hand-tuned batch sizes that differ between peak and off-peak
+//
+// Example:
+//
+//	batcher := streamz.NewAdaptiveBatcher[Row](10, 1000, 200*time.Millisecond, streamz.RealClock).
+//		WithMaxWait(time.Second)
+//
+//	for batch := range batcher.Process(ctx, rows) {
+//		if batch.IsError() {
+//			continue
+//		}
+//		db.BulkInsert(ctx, batch.Value())
+//		batcher.Ack(batch)
+//	}
+//
+// Parameters:
+//   - minSize: Smallest batch size, used at start (must be positive)
+//   - maxSize: Largest batch size (must be at least minSize)
+//   - targetLatency: Desired time from emitting a batch to its acknowledgment
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new AdaptiveBatcher processor.
+// Panics if minSize is less than 1 or maxSize is less than minSize.
+func NewAdaptiveBatcher[T any](minSize, maxSize int, targetLatency time.Duration, clock Clock) *AdaptiveBatcher[T] {
+	if minSize < 1 {
+		panic("adaptive batcher minSize must be positive")
+	}
+	if maxSize < minSize {
+		panic("adaptive batcher maxSize must be at least minSize")
+	}
+
+	return &AdaptiveBatcher[T]{
+		name:          "adaptive-batcher",
+		minSize:       minSize,
+		maxSize:       maxSize,
+		targetLatency: targetLatency,
+		clock:         clock,
+		size:          minSize,
+	}
+}
+
+// WithMaxWait sets how long a partial batch may wait for more items before it
+// is emitted anyway, measured from its first item.
+// If not set, partial batches are only emitted when the input closes.
+func (b *AdaptiveBatcher[T]) WithMaxWait(maxWait time.Duration) *AdaptiveBatcher[T] {
+	b.maxWait = maxWait
+	return b
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "adaptive-batcher".
+func (b *AdaptiveBatcher[T]) WithName(name string) *AdaptiveBatcher[T] {
+	b.name = name
+	return b
+}
+
+// BatchSize returns the current target batch size.
+// Safe to call concurrently with Process.
+func (b *AdaptiveBatcher[T]) BatchSize() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.size
+}
+
+// Ack reports that downstream has finished with batch, which must be a Result
+// received from Process, and adapts the batch size to the elapsed latency. It
+// returns false, without adapting, if batch carries no emission time. Each batch
+// should be acknowledged once.
+// Safe to call concurrently with Process.
+func (b *AdaptiveBatcher[T]) Ack(batch Result[[]T]) bool {
+	emitted, found, err := batch.GetTimeMetadata(MetadataBatchEmitted)
+	if !found || err != nil {
+		return false
+	}
+	latency := b.clock.Since(emitted)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.size = b.adapt(b.size, latency)
+	return true
+}
+
+// adapt returns the batch size that would bring latency to the target.
+func (b *AdaptiveBatcher[T]) adapt(size int, latency time.Duration) int {
+	next := size * 2
+	if latency > 0 {
+		next = int(math.Round(float64(size) * float64(b.targetLatency) / float64(latency)))
+	}
+
+	// Always move at least one step in the indicated direction
+	switch {
+	case latency < b.targetLatency && next <= size:
+		next = size + 1
+	case latency > b.targetLatency && next >= size:
+		next = size - 1
+	}
+
+	next = min(max(next, size/2), size*2)
+	return min(max(next, b.minSize), b.maxSize)
+}
+
+// Process groups successful items into batches of the current adaptive size.
+func (b *AdaptiveBatcher[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[[]T] {
+	out := make(chan Result[[]T])
+
+	go func() {
+		defer close(out)
+
+		var batch []T
+		var timer Timer
+		var timerC <-chan time.Time
+
+		stopTimer := func() {
+			if timer != nil {
+				timer.Stop()
+				timer = nil
+				timerC = nil
+			}
+		}
+		defer stopTimer()
+
+		emit := func() bool {
+			stopTimer()
+			if len(batch) == 0 {
+				return true
+			}
+			result := NewSuccess(batch).WithMetadata(MetadataBatchEmitted, b.clock.Now())
+			batch = nil
+			select {
+			case out <- result:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					emit()
+					return
+				}
+
+				if item.IsError() {
+					select {
+					case out <- NewError(make([]T, 0), item.Error().Err, item.Error().ProcessorName):
+					case <-ctx.Done():
+						return
+					}
+					continue
+				}
+
+				if batch == nil {
+					batch = make([]T, 0, b.BatchSize())
+					if b.maxWait > 0 {
+						timer = b.clock.NewTimer(b.maxWait)
+						timerC = timer.C()
+					}
+				}
+				batch = append(batch, item.Value())
+
+				if len(batch) >= b.BatchSize() && !emit() {
+					return
+				}
+
+			case <-timerC:
+				timer = nil
+				timerC = nil
+				if !emit() {
+					return
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (b *AdaptiveBatcher[T]) Name() string {
+	return b.name
+}
@@ -0,0 +1,155 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+func TestAdaptiveBatcher_Name(t *testing.T) {
+	batcher := NewAdaptiveBatcher[int](1, 10, time.Second, RealClock)
+	if batcher.Name() != "adaptive-batcher" {
+		t.Errorf("expected name 'adaptive-batcher', got %q", batcher.Name())
+	}
+	if batcher.WithName("bulk-writer").Name() != "bulk-writer" {
+		t.Errorf("expected name 'bulk-writer', got %q", batcher.Name())
+	}
+}
+
+func TestAdaptiveBatcher_PanicsOnInvalidBounds(t *testing.T) {
+	for _, bounds := range [][2]int{{0, 10}, {10, 5}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for bounds %v", bounds)
+				}
+			}()
+			NewAdaptiveBatcher[int](bounds[0], bounds[1], time.Second, RealClock)
+		}()
+	}
+}
+
+func TestAdaptiveBatcher_GrowsUnderLowLatency(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	batcher := NewAdaptiveBatcher[int](2, 20, 100*time.Millisecond, clock)
+	in := make(chan Result[int])
+	defer close(in)
+	out := batcher.Process(context.Background(), in)
+
+	if size := batcher.BatchSize(); size != 2 {
+		t.Fatalf("expected initial size 2, got %d", size)
+	}
+
+	// Writes at a quarter of the target double the size each time, capped at max
+	steps := []struct {
+		latency time.Duration
+		size    int
+	}{
+		{25 * time.Millisecond, 4},
+		{25 * time.Millisecond, 8},
+		{25 * time.Millisecond, 16},
+		{time.Millisecond, 20},
+		{90 * time.Millisecond, 20},
+	}
+	for i, step := range steps {
+		size := batcher.BatchSize()
+		for j := 0; j < size; j++ {
+			in <- NewSuccess(j)
+		}
+		batch := <-out
+		if len(batch.Value()) != size {
+			t.Fatalf("write %d: expected batch of %d, got %d", i, size, len(batch.Value()))
+		}
+
+		clock.Advance(step.latency)
+		if !batcher.Ack(batch) {
+			t.Fatalf("write %d: expected batch to be acknowledged", i)
+		}
+		if got := batcher.BatchSize(); got != step.size {
+			t.Errorf("write %d at %v: expected size %d, got %d", i, step.latency, step.size, got)
+		}
+	}
+}
+
+func TestAdaptiveBatcher_ShrinksUnderHighLatency(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	batcher := NewAdaptiveBatcher[int](2, 64, 100*time.Millisecond, clock)
+	in := make(chan Result[int])
+	defer close(in)
+	out := batcher.Process(context.Background(), in)
+
+	steps := []struct {
+		latency time.Duration
+		size    int
+	}{
+		// Instant writes grow the size to max
+		{0, 4}, {0, 8}, {0, 16}, {0, 32}, {0, 64},
+		// Writes at twice the target halve the size, floored at min
+		{200 * time.Millisecond, 32},
+		{200 * time.Millisecond, 16},
+		{200 * time.Millisecond, 8},
+		{200 * time.Millisecond, 4},
+		{200 * time.Millisecond, 2},
+		{200 * time.Millisecond, 2},
+		// Slightly slow writes still shrink by at least one
+		{0, 4}, {0, 8}, {0, 16},
+		{101 * time.Millisecond, 15},
+	}
+	for i, step := range steps {
+		size := batcher.BatchSize()
+		for j := 0; j < size; j++ {
+			in <- NewSuccess(j)
+		}
+		batch := <-out
+		if len(batch.Value()) != size {
+			t.Fatalf("write %d: expected batch of %d, got %d", i, size, len(batch.Value()))
+		}
+
+		clock.Advance(step.latency)
+		if !batcher.Ack(batch) {
+			t.Fatalf("write %d: expected batch to be acknowledged", i)
+		}
+		if got := batcher.BatchSize(); got != step.size {
+			t.Fatalf("write %d at %v: expected size %d, got %d", i, step.latency, step.size, got)
+		}
+	}
+}
+
+func TestAdaptiveBatcher_ErrorsAndPartialBatches(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	batcher := NewAdaptiveBatcher[int](5, 10, time.Second, clock).WithMaxWait(time.Second)
+	in := make(chan Result[int])
+	out := batcher.Process(context.Background(), in)
+
+	in <- NewSuccess(1)
+	in <- NewError(2, errors.New("bad"), "upstream")
+	if result := <-out; !result.IsError() || result.Error().ProcessorName != "upstream" {
+		t.Fatalf("expected error passed through, got %v", result)
+	}
+
+	// The max wait emits the partial batch
+	clock.Advance(time.Second)
+	clock.BlockUntilReady()
+	result := <-out
+	if got := result.Value(); len(got) != 1 || got[0] != 1 {
+		t.Errorf("expected partial batch [1], got %v", got)
+	}
+
+	// Input close flushes what is left
+	in <- NewSuccess(3)
+	close(in)
+	result = <-out
+	if got := result.Value(); len(got) != 1 || got[0] != 3 {
+		t.Errorf("expected flushed batch [3], got %v", got)
+	}
+	if _, ok := <-out; ok {
+		t.Error("expected output closed")
+	}
+
+	if batcher.Ack(NewSuccess([]int{1})) {
+		t.Error("expected Ack without emission time to be rejected")
+	}
+}
@@ -0,0 +1,179 @@
+package streamz
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// AdaptiveThrottle is a leading edge throttle whose interval adapts to runs of
+// errors in the stream. Each error that follows another error widens the interval
+// by a factor up to a maximum, and each emitted success narrows it back toward the
+// base, so the pipeline slows down while downstream is consistently unhappy and
+// recovers once it is healthy again. Isolated errors do not change the interval.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type AdaptiveThrottle[T any] struct {
+	name     string
+	clock    Clock
+	base     time.Duration
+	max      time.Duration
+	factor   float64
+	interval time.Duration
+	errorRun int
+	lastEmit time.Time
+	mutex    sync.Mutex
+	dropped  atomic.Uint64
+}
+
+// NewAdaptiveThrottle creates a throttle that backs off on errors.
+// Successes are throttled with leading edge behavior like Throttle: one item is
+// emitted, then items are dropped until the current interval has elapsed.
+// Errors always pass through immediately. An error extends the current run of
+// consecutive errors, and every error after the first in a run multiplies the
+// interval by the factor (default 2) up to max. Any success ends the run, and each
+// emitted success divides the interval by the factor, down to base.
+//
+// When to use:
+//   - Self-protecting pipelines that ease off when a dependency fails
+//   - Reducing load on a struggling service without stopping entirely
+//   - Sampling a noisy stream more sparsely during incidents
+//
+// Example:
+//
+//	// Normally one request per 100ms, backing off to one per 5s on failures
+//	throttle := streamz.NewAdaptiveThrottle[Request](100*time.Millisecond, 5*time.Second, streamz.RealClock)
+//	calls := streamz.NewMapper(callService).Process(ctx, throttle.Process(ctx, requests))
+//
+// Parameters:
+//   - base: Interval while the stream is healthy (0 disables throttling when healthy)
+//   - maxInterval: Upper bound on the interval under sustained errors
+//   - clock: Clock interface for time operations
+//
+// Returns a new AdaptiveThrottle processor.
+func NewAdaptiveThrottle[T any](base, maxInterval time.Duration, clock Clock) *AdaptiveThrottle[T] {
+	return &AdaptiveThrottle[T]{
+		name:     "adaptive-throttle",
+		clock:    clock,
+		base:     base,
+		max:      maxInterval,
+		factor:   2,
+		interval: base,
+	}
+}
+
+// WithFactor sets the multiplier applied to the interval on each consecutive error and
+// divided out on each emitted success. Values <= 1 are ignored.
+// If not set, defaults to 2.
+func (th *AdaptiveThrottle[T]) WithFactor(factor float64) *AdaptiveThrottle[T] {
+	if factor > 1 {
+		th.factor = factor
+	}
+	return th
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "adaptive-throttle".
+func (th *AdaptiveThrottle[T]) WithName(name string) *AdaptiveThrottle[T] {
+	th.name = name
+	return th
+}
+
+// Interval returns the current throttle interval.
+// Safe to call concurrently with Process.
+func (th *AdaptiveThrottle[T]) Interval() time.Duration {
+	th.mutex.Lock()
+	defer th.mutex.Unlock()
+	return th.interval
+}
+
+// DroppedCount returns the number of successes dropped by the throttle.
+// Safe to call concurrently with Process.
+func (th *AdaptiveThrottle[T]) DroppedCount() uint64 {
+	return th.dropped.Load()
+}
+
+// Process throttles successes at the current interval and adapts it to errors.
+func (th *AdaptiveThrottle[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			select {
+			case result, ok := <-in:
+				if !ok {
+					return
+				}
+
+				if result.IsError() {
+					th.failed()
+				} else if !th.admit() {
+					th.dropped.Add(1)
+					continue
+				}
+
+				select {
+				case out <- result:
+				case <-ctx.Done():
+					return
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// admit ends the current error run and reports whether a success may be emitted
+// now, narrowing the interval if so.
+func (th *AdaptiveThrottle[T]) admit() bool {
+	th.mutex.Lock()
+	defer th.mutex.Unlock()
+
+	th.errorRun = 0
+
+	now := th.clock.Now()
+	// lastEmit zero value means the first success always passes
+	if now.Sub(th.lastEmit) < th.interval {
+		return false
+	}
+	th.lastEmit = now
+
+	th.interval = time.Duration(float64(th.interval) / th.factor)
+	if th.interval < th.base {
+		th.interval = th.base
+	}
+	return true
+}
+
+// failed extends the error run, growing the interval once the run has more than one error.
+func (th *AdaptiveThrottle[T]) failed() {
+	th.mutex.Lock()
+	defer th.mutex.Unlock()
+
+	th.errorRun++
+	if th.errorRun < 2 {
+		return
+	}
+
+	next := time.Duration(float64(th.interval) * th.factor)
+	if next == 0 {
+		// A zero base cannot grow multiplicatively; start from the smallest step
+		next = time.Millisecond
+	}
+	if next > th.max {
+		next = th.max
+	}
+	th.interval = next
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (th *AdaptiveThrottle[T]) Name() string {
+	return th.name
+}
@@ -0,0 +1,179 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+var errUnavailable = errors.New("downstream unavailable")
+
+func TestAdaptiveThrottle_Name(t *testing.T) {
+	throttle := NewAdaptiveThrottle[int](time.Second, time.Minute, RealClock)
+	if throttle.Name() != "adaptive-throttle" {
+		t.Errorf("expected name 'adaptive-throttle', got %q", throttle.Name())
+	}
+	if throttle.WithName("backoff").Name() != "backoff" {
+		t.Errorf("expected name 'backoff', got %q", throttle.Name())
+	}
+}
+
+func TestAdaptiveThrottle_HealthyStreamUsesBase(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	throttle := NewAdaptiveThrottle[int](100*time.Millisecond, time.Second, clock)
+	in := make(chan Result[int])
+	defer close(in)
+	out := throttle.Process(context.Background(), in)
+
+	in <- NewSuccess(1)
+	if result := <-out; result.Value() != 1 {
+		t.Fatalf("expected first item to pass, got %v", result)
+	}
+	if emitted := sendWithBarrier(t, in, out, NewSuccess(2)); len(emitted) != 0 {
+		t.Error("expected item within base interval to be dropped")
+	}
+	clock.Advance(100 * time.Millisecond)
+	if emitted := sendWithBarrier(t, in, out, NewSuccess(3)); len(emitted) != 1 {
+		t.Error("expected item after base interval to pass")
+	}
+	if throttle.Interval() != 100*time.Millisecond {
+		t.Errorf("expected interval 100ms, got %v", throttle.Interval())
+	}
+}
+
+func TestAdaptiveThrottle_ErrorsWidenAndSuccessesNarrow(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	throttle := NewAdaptiveThrottle[int](100*time.Millisecond, 800*time.Millisecond, clock)
+	in := make(chan Result[int])
+	defer close(in)
+	out := throttle.Process(context.Background(), in)
+
+	in <- NewSuccess(0)
+	if result := <-out; result.Value() != 0 {
+		t.Fatalf("expected first item to pass, got %v", result)
+	}
+
+	// The first error of a run leaves the interval alone; each consecutive
+	// error after it doubles the interval, capped at max
+	for _, expected := range []time.Duration{100, 200, 400, 800, 800} {
+		in <- NewError(0, errUnavailable, "client")
+		if result := <-out; !result.IsError() {
+			t.Fatalf("expected error to pass through, got %v", result)
+		}
+		if got := throttle.Interval(); got != expected*time.Millisecond {
+			t.Errorf("expected interval %v, got %v", expected*time.Millisecond, got)
+		}
+	}
+
+	// Offer one success every 100ms; each emission halves the interval back toward base.
+	// The barrier after each success is a lone error, so it never widens the interval.
+	var passedAt []int
+	var intervals []time.Duration
+	for step := 1; step <= 16; step++ {
+		clock.Advance(100 * time.Millisecond)
+		if emitted := sendWithBarrier(t, in, out, NewSuccess(step)); len(emitted) == 1 {
+			passedAt = append(passedAt, step)
+			intervals = append(intervals, throttle.Interval())
+		}
+	}
+
+	expectedSteps := []int{8, 12, 14, 15, 16}
+	expectedIntervals := []time.Duration{400, 200, 100, 100, 100}
+	if len(passedAt) != len(expectedSteps) {
+		t.Fatalf("expected successes at steps %v, got %v", expectedSteps, passedAt)
+	}
+	for i := range expectedSteps {
+		if passedAt[i] != expectedSteps[i] {
+			t.Errorf("expected successes at steps %v, got %v", expectedSteps, passedAt)
+			break
+		}
+		if intervals[i] != expectedIntervals[i]*time.Millisecond {
+			t.Errorf("after pass %d: expected interval %v, got %v", i, expectedIntervals[i]*time.Millisecond, intervals[i])
+		}
+	}
+}
+
+func TestAdaptiveThrottle_IsolatedErrorsDoNotWiden(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	throttle := NewAdaptiveThrottle[int](100*time.Millisecond, time.Second, clock)
+	in := make(chan Result[int])
+	defer close(in)
+	out := throttle.Process(context.Background(), in)
+
+	in <- NewSuccess(0)
+	<-out
+
+	// Errors separated by successes never form a run, even when the success is
+	// dropped. Each error is read back only after the success before it was handled.
+	for step := 1; step <= 4; step++ {
+		in <- NewError(0, errUnavailable, "client")
+		<-out
+		if got := throttle.Interval(); got != 100*time.Millisecond {
+			t.Fatalf("error %d: expected interval 100ms, got %v", step, got)
+		}
+		in <- NewSuccess(step)
+	}
+
+	// Two in a row widen once; a success resets the run, so the next error does not
+	for i := 0; i < 2; i++ {
+		in <- NewError(0, errUnavailable, "client")
+		<-out
+	}
+	if got := throttle.Interval(); got != 200*time.Millisecond {
+		t.Errorf("expected interval 200ms, got %v", got)
+	}
+	in <- NewSuccess(10)
+	in <- NewError(0, errUnavailable, "client")
+	<-out
+	if got := throttle.Interval(); got != 200*time.Millisecond {
+		t.Errorf("expected interval 200ms, got %v", got)
+	}
+
+	// Every success after the first fell within the interval
+	if throttle.DroppedCount() != 5 {
+		t.Errorf("expected 5 dropped, got %d", throttle.DroppedCount())
+	}
+}
+
+func TestAdaptiveThrottle_WithFactor(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	throttle := NewAdaptiveThrottle[int](50*time.Millisecond, time.Second, clock).
+		WithFactor(4).
+		WithFactor(0.5) // ignored
+	in := make(chan Result[int])
+	defer close(in)
+	out := throttle.Process(context.Background(), in)
+
+	for _, expected := range []time.Duration{50 * time.Millisecond, 200 * time.Millisecond, 800 * time.Millisecond, time.Second} {
+		in <- NewError(0, errUnavailable, "client")
+		<-out
+		if got := throttle.Interval(); got != expected {
+			t.Errorf("expected interval %v, got %v", expected, got)
+		}
+	}
+
+	for _, expected := range []time.Duration{250 * time.Millisecond, 62500 * time.Microsecond, 50 * time.Millisecond} {
+		clock.Advance(time.Second)
+		in <- NewSuccess(1)
+		if result := <-out; result.Value() != 1 {
+			t.Fatalf("expected item after a full max interval to pass, got %v", result)
+		}
+		if got := throttle.Interval(); got != expected {
+			t.Errorf("expected interval %v, got %v", expected, got)
+		}
+	}
+}
+
+func TestAdaptiveThrottle_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[int])
+	out := NewAdaptiveThrottle[int](time.Second, time.Minute, clockz.NewFakeClock()).Process(ctx, in)
+
+	cancel()
+	if _, ok := <-out; ok {
+		t.Error("expected output to close after cancellation")
+	}
+}
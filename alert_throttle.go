@@ -0,0 +1,153 @@
+package streamz
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+)
+
+// AlertThrottle caps how often each distinct alert may be emitted, so a flapping
+// check or an error storm produces a handful of alerts per window instead of
+// hundreds of identical ones. Alerts are told apart by a signature function,
+// and each signature is limited independently.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type AlertThrottle[T any] struct {
+	name         string
+	sigFn        func(T) string
+	perSignature int
+	window       time.Duration
+	clock        Clock
+
+	mu         sync.Mutex
+	suppressed map[string]int
+}
+
+// alertWindow tracks the emissions of one signature within its current window.
+type alertWindow struct {
+	start   time.Time
+	emitted int
+}
+
+// NewAlertThrottle creates a processor that emits at most perSignature items per
+// signature in each window. A signature's window starts with its first emission
+// and lasts for window; items beyond the limit are dropped and counted until the
+// window ends, after which the next item starts a new window.
+// Errors pass through unchanged and are never throttled.
+//
+// When to use:
+//   - Collapsing repeated alerts from flapping health checks
+//   - Limiting identical log lines during an incident
+//   - Protecting paging and chat integrations from alert storms
+//
+// Example:
+//
+//	// At most 3 alerts per check and host every 10 minutes
+//	throttle := streamz.NewAlertThrottle(func(a Alert) string {
+//		return a.Check + "@" + a.Host
+//	}, 3, 10*time.Minute, streamz.RealClock)
+//
+//	pages := throttle.Process(ctx, alerts)
+//	// ... later
+//	for sig, n := range throttle.SuppressedCounts() {
+//		log.Printf("suppressed %d alerts for %s", n, sig)
+//	}
+//
+// Parameters:
+//   - sigFn: Extracts the signature identifying duplicate alerts
+//   - perSignature: Emissions allowed per signature in each window (must be positive)
+//   - window: Length of each signature's window
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new AlertThrottle processor.
+// Panics if perSignature is less than 1.
+func NewAlertThrottle[T any](sigFn func(T) string, perSignature int, window time.Duration, clock Clock) *AlertThrottle[T] {
+	if perSignature < 1 {
+		panic("perSignature must be positive")
+	}
+	return &AlertThrottle[T]{
+		name:         "alert-throttle",
+		sigFn:        sigFn,
+		perSignature: perSignature,
+		window:       window,
+		clock:        clock,
+		suppressed:   make(map[string]int),
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "alert-throttle".
+func (a *AlertThrottle[T]) WithName(name string) *AlertThrottle[T] {
+	a.name = name
+	return a
+}
+
+// SuppressedCounts returns the number of items suppressed so far for each
+// signature that has had any suppressed. The returned map is a copy.
+// Safe to call concurrently with Process.
+func (a *AlertThrottle[T]) SuppressedCounts() map[string]int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return maps.Clone(a.suppressed)
+}
+
+// Process forwards items until their signature reaches its limit for the window.
+func (a *AlertThrottle[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		windows := make(map[string]*alertWindow)
+		var lastSweep time.Time
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsSuccess() {
+				now := a.clock.Now()
+
+				// Forget signatures whose window ended, at most once per window
+				if now.Sub(lastSweep) >= a.window {
+					for sig, w := range windows {
+						if now.Sub(w.start) >= a.window {
+							delete(windows, sig)
+						}
+					}
+					lastSweep = now
+				}
+
+				sig := a.sigFn(item.Value())
+				w, exists := windows[sig]
+				if !exists || now.Sub(w.start) >= a.window {
+					w = &alertWindow{start: now}
+					windows[sig] = w
+				}
+				if w.emitted >= a.perSignature {
+					a.mu.Lock()
+					a.suppressed[sig]++
+					a.mu.Unlock()
+					continue
+				}
+				w.emitted++
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (a *AlertThrottle[T]) Name() string {
+	return a.name
+}
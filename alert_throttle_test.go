@@ -0,0 +1,142 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+func entryMessage(e logEntry) string { return e.Message }
+
+// throttleAlert sends an entry through the throttle and reports whether it was
+// forwarded. A marker error follows it, accepted only once the entry is handled.
+func throttleAlert(in chan<- Result[logEntry], out <-chan Result[logEntry], message string) bool {
+	in <- NewSuccess(logEntry{Level: "ERROR", Message: message})
+	marker := NewError(logEntry{}, errors.New("marker"), "test")
+	forwarded := false
+	select {
+	case in <- marker:
+	case <-out:
+		forwarded = true
+		in <- marker
+	}
+	<-out
+	return forwarded
+}
+
+func TestAlertThrottle_Name(t *testing.T) {
+	throttle := NewAlertThrottle(entryMessage, 3, time.Minute, RealClock)
+	if throttle.Name() != "alert-throttle" {
+		t.Errorf("expected name 'alert-throttle', got %q", throttle.Name())
+	}
+	if throttle.WithName("pager").Name() != "pager" {
+		t.Errorf("expected name 'pager', got %q", throttle.Name())
+	}
+}
+
+func TestAlertThrottle_PanicsOnInvalidLimit(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for perSignature of 0")
+		}
+	}()
+	NewAlertThrottle(entryMessage, 0, time.Minute, RealClock)
+}
+
+func TestAlertThrottle_CapsEachSignatureIndependently(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	throttle := NewAlertThrottle(entryMessage, 3, time.Minute, clock)
+
+	in := make(chan Result[logEntry])
+	defer close(in)
+	out := throttle.Process(context.Background(), in)
+
+	forwarded := map[string]int{}
+	for i := 0; i < 50; i++ {
+		for _, message := range []string{"disk full", "db down"} {
+			if i%5 == 0 && message == "db down" {
+				continue
+			}
+			if throttleAlert(in, out, message) {
+				forwarded[message]++
+			}
+		}
+	}
+
+	if want := map[string]int{"disk full": 3, "db down": 3}; !maps.Equal(forwarded, want) {
+		t.Errorf("expected 3 forwarded per signature, got %v", forwarded)
+	}
+	if want := map[string]int{"disk full": 47, "db down": 37}; !maps.Equal(throttle.SuppressedCounts(), want) {
+		t.Errorf("expected suppressed counts %v, got %v", want, throttle.SuppressedCounts())
+	}
+
+	// A new window allows each signature through again
+	clock.Advance(time.Minute)
+	forwarded = map[string]int{}
+	for i := 0; i < 5; i++ {
+		for _, message := range []string{"disk full", "db down"} {
+			if throttleAlert(in, out, message) {
+				forwarded[message]++
+			}
+		}
+	}
+	if want := map[string]int{"disk full": 3, "db down": 3}; !maps.Equal(forwarded, want) {
+		t.Errorf("expected 3 forwarded per signature in the next window, got %v", forwarded)
+	}
+	if want := map[string]int{"disk full": 49, "db down": 39}; !maps.Equal(throttle.SuppressedCounts(), want) {
+		t.Errorf("expected suppressed counts %v, got %v", want, throttle.SuppressedCounts())
+	}
+}
+
+func TestAlertThrottle_WindowStartsPerSignature(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	throttle := NewAlertThrottle(entryMessage, 1, time.Minute, clock)
+
+	in := make(chan Result[logEntry])
+	defer close(in)
+	out := throttle.Process(context.Background(), in)
+
+	if !throttleAlert(in, out, "disk full") {
+		t.Fatal("expected first disk alert forwarded")
+	}
+	clock.Advance(30 * time.Second)
+	if !throttleAlert(in, out, "db down") {
+		t.Fatal("expected first db alert forwarded")
+	}
+
+	// The disk window ends before the db window
+	clock.Advance(30 * time.Second)
+	if !throttleAlert(in, out, "disk full") {
+		t.Error("expected disk alert forwarded after its window ended")
+	}
+	if throttleAlert(in, out, "db down") {
+		t.Error("expected db alert suppressed within its window")
+	}
+}
+
+func TestAlertThrottle_ErrorsPassThrough(t *testing.T) {
+	throttle := NewAlertThrottle(entryMessage, 1, time.Minute, clockz.NewFakeClock())
+	in := make(chan Result[logEntry], 5)
+	for i := 0; i < 5; i++ {
+		in <- NewError(logEntry{Message: "disk full"}, errors.New("parse failed"), "parser")
+	}
+	close(in)
+
+	count := 0
+	for result := range throttle.Process(context.Background(), in) {
+		if !result.IsError() {
+			t.Errorf("expected only errors, got %v", result.Value())
+		}
+		count++
+	}
+	if count != 5 {
+		t.Errorf("expected all 5 errors forwarded, got %d", count)
+	}
+	if len(throttle.SuppressedCounts()) != 0 {
+		t.Errorf("expected no suppression for errors, got %v", throttle.SuppressedCounts())
+	}
+}
@@ -38,9 +38,18 @@
 package streamz
 
 import (
+	"context"
 	"time"
 )
 
+// Processor is implemented by processors that transform a Result[T] stream into
+// another Result[T] stream of the same type, such as Filter, Throttle, Tap, or a
+// Mapper[T, T]. It allows such stages to be composed generically, as in Parallel.
+type Processor[T any] interface {
+	Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T]
+	Name() string
+}
+
 // BatchConfig configures batching behavior for the Batcher processor.
 type BatchConfig struct {
 	// MaxLatency is the maximum time to wait before emitting a partial batch.
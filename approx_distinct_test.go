@@ -0,0 +1,127 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+func ipKey(s string) string { return s }
+
+// feedKeys sends n distinct keys, each repeated, and drains the output.
+func feedKeys(ctx context.Context, distinct *ApproxDistinct[string], n, repeats int) {
+	in := make(chan Result[string], 1024)
+	go func() {
+		defer close(in)
+		for r := 0; r < repeats; r++ {
+			for i := 0; i < n; i++ {
+				in <- NewSuccess(fmt.Sprintf("10.0.%d.%d", i/256, i%256))
+			}
+		}
+	}()
+	for range distinct.Process(ctx, in) {
+	}
+}
+
+func withinError(estimate uint64, actual int, tolerance float64) bool {
+	return math.Abs(float64(estimate)-float64(actual)) <= tolerance*float64(actual)
+}
+
+func TestApproxDistinct_Name(t *testing.T) {
+	distinct := NewApproxDistinct(ipKey, RealClock)
+	if distinct.Name() != "approx-distinct" {
+		t.Errorf("expected name 'approx-distinct', got %q", distinct.Name())
+	}
+	if distinct.WithName("unique-ips").Name() != "unique-ips" {
+		t.Errorf("expected name 'unique-ips', got %q", distinct.Name())
+	}
+}
+
+func TestApproxDistinct_EstimateWithinErrorBound(t *testing.T) {
+	// Standard error at precision 14 is ~0.8%; allow 3%
+	for _, n := range []int{100, 5000, 50000} {
+		t.Run(fmt.Sprintf("%d distinct", n), func(t *testing.T) {
+			distinct := NewApproxDistinct(ipKey, clockz.NewFakeClock())
+			feedKeys(context.Background(), distinct, n, 3)
+
+			estimate := distinct.EstimatedCardinality()
+			if !withinError(estimate, n, 0.03) {
+				t.Errorf("expected estimate within 3%% of %d, got %d", n, estimate)
+			}
+		})
+	}
+}
+
+func TestApproxDistinct_ForwardsUnchanged(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[string], 3)
+	in <- NewSuccess("a")
+	in <- NewError("b", errors.New("bad"), "parser")
+	in <- NewSuccess("a")
+	close(in)
+
+	distinct := NewApproxDistinct(ipKey, clockz.NewFakeClock())
+	var results []Result[string]
+	for result := range distinct.Process(ctx, in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 3 || !results[1].IsError() {
+		t.Errorf("expected all items forwarded unchanged, got %v", results)
+	}
+	if estimate := distinct.EstimatedCardinality(); estimate != 1 {
+		t.Errorf("expected errors not to be counted, got estimate %d", estimate)
+	}
+}
+
+func TestApproxDistinct_WindowReset(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	in := make(chan Result[string])
+	distinct := NewApproxDistinct(ipKey, clock).WithWindow(time.Minute)
+	out := distinct.Process(ctx, in)
+
+	send := func(key string) {
+		in <- NewSuccess(key)
+		<-out
+	}
+
+	for i := 0; i < 500; i++ {
+		send(fmt.Sprintf("user-%d", i))
+	}
+	if estimate := distinct.EstimatedCardinality(); !withinError(estimate, 500, 0.03) {
+		t.Fatalf("expected ~500 before reset, got %d", estimate)
+	}
+	if distinct.LastWindowCardinality() != 0 {
+		t.Errorf("expected no completed window yet, got %d", distinct.LastWindowCardinality())
+	}
+
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+
+	deadline := time.After(time.Second)
+	for distinct.EstimatedCardinality() != 0 {
+		select {
+		case <-deadline:
+			t.Fatalf("expected estimate reset after window, got %d", distinct.EstimatedCardinality())
+		case <-time.After(time.Millisecond):
+		}
+	}
+	if last := distinct.LastWindowCardinality(); !withinError(last, 500, 0.03) {
+		t.Errorf("expected last window ~500, got %d", last)
+	}
+
+	// Keys from the previous window count again in the new one
+	for i := 0; i < 50; i++ {
+		send(fmt.Sprintf("user-%d", i))
+	}
+	if estimate := distinct.EstimatedCardinality(); !withinError(estimate, 50, 0.03) {
+		t.Errorf("expected ~50 in new window, got %d", estimate)
+	}
+	close(in)
+}
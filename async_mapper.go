@@ -6,6 +6,10 @@ import (
 	"sync"
 )
 
+// MetadataWorkerID records which AsyncMapper worker produced a result when
+// worker metadata is enabled.
+const MetadataWorkerID = "worker_id" // int - worker index in [0, workers)
+
 // AsyncMapper processes items concurrently using multiple worker goroutines.
 // It supports both ordered processing (preserving input sequence) and unordered
 // processing (emitting results as they complete). This enables parallelization
@@ -19,6 +23,7 @@ type AsyncMapper[In, Out any] struct {
 	workers    int
 	ordered    bool
 	bufferSize int
+	workerMeta bool
 }
 
 // NewAsyncMapper creates a processor that executes transformations concurrently.
@@ -100,6 +105,14 @@ func (a *AsyncMapper[In, Out]) WithBufferSize(size int) *AsyncMapper[In, Out] {
 	return a
 }
 
+// WithWorkerMetadata tags each result with the index of the worker that
+// produced it under MetadataWorkerID, for diagnosing uneven work distribution.
+// Works in both ordered and unordered modes. Defaults to false.
+func (a *AsyncMapper[In, Out]) WithWorkerMetadata(enabled bool) *AsyncMapper[In, Out] {
+	a.workerMeta = enabled
+	return a
+}
+
 // WithName sets a custom name for this processor.
 // If not set, defaults to "async-mapper".
 func (a *AsyncMapper[In, Out]) WithName(name string) *AsyncMapper[In, Out] {
@@ -139,18 +152,18 @@ func (a *AsyncMapper[In, Out]) processUnordered(ctx context.Context, in <-chan R
 		var wg sync.WaitGroup
 		for i := 0; i < a.workers; i++ {
 			wg.Add(1)
-			go func() {
+			go func(workerID int) {
 				defer wg.Done()
 				for item := range work {
 					if item.IsError() {
 						// Pass through errors unchanged
 						select {
-						case out <- Result[Out]{err: &StreamError[Out]{
+						case out <- a.tagWorker(Result[Out]{err: &StreamError[Out]{
 							Item:          *new(Out), // zero value
 							Err:           item.Error(),
 							ProcessorName: a.name,
 							Timestamp:     item.Error().Timestamp,
-						}}:
+						}}, workerID):
 						case <-ctx.Done():
 							return
 						}
@@ -161,25 +174,29 @@ func (a *AsyncMapper[In, Out]) processUnordered(ctx context.Context, in <-chan R
 					result, err := a.fn(ctx, item.Value())
 					if err != nil {
 						select {
-						case out <- NewError(result, err, a.name):
+						case out <- a.tagWorker(NewError(result, err, a.name), workerID):
 						case <-ctx.Done():
 							return
 						}
 					} else {
 						select {
-						case out <- NewSuccess(result):
+						case out <- a.tagWorker(NewSuccess(result), workerID):
 						case <-ctx.Done():
 							return
 						}
 					}
 				}
-			}()
+			}(i)
 		}
 
 		// Feed work to workers
 		go func() {
 			defer close(work)
-			for item := range in {
+			for {
+				item, ok := receive(ctx, in)
+				if !ok {
+					return
+				}
 				select {
 				case work <- item:
 				case <-ctx.Done():
@@ -206,7 +223,11 @@ func (a *AsyncMapper[In, Out]) processOrdered(ctx context.Context, in <-chan Res
 	go func() {
 		defer close(sequenced)
 		var seq uint64
-		for item := range in {
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
 			select {
 			case sequenced <- sequencedItem[Result[In]]{item: item, seq: seq}:
 				seq++
@@ -220,7 +241,7 @@ func (a *AsyncMapper[In, Out]) processOrdered(ctx context.Context, in <-chan Res
 	var wg sync.WaitGroup
 	for i := 0; i < a.workers; i++ {
 		wg.Add(1)
-		go func() {
+		go func(workerID int) {
 			defer wg.Done()
 			for seqItem := range sequenced {
 				var result Result[Out]
@@ -244,12 +265,12 @@ func (a *AsyncMapper[In, Out]) processOrdered(ctx context.Context, in <-chan Res
 				}
 
 				select {
-				case results <- sequencedItem[Result[Out]]{item: result, seq: seqItem.seq}:
+				case results <- sequencedItem[Result[Out]]{item: a.tagWorker(result, workerID), seq: seqItem.seq}:
 				case <-ctx.Done():
 					return
 				}
 			}
-		}()
+		}(i)
 	}
 
 	// Close results when workers finish
@@ -298,6 +319,14 @@ func (a *AsyncMapper[In, Out]) processOrdered(ctx context.Context, in <-chan Res
 	return out
 }
 
+// tagWorker stamps the worker index on a result when worker metadata is enabled.
+func (a *AsyncMapper[In, Out]) tagWorker(result Result[Out], workerID int) Result[Out] {
+	if !a.workerMeta {
+		return result
+	}
+	return result.WithMetadata(MetadataWorkerID, workerID)
+}
+
 // Name returns the processor name for debugging and monitoring.
 func (a *AsyncMapper[In, Out]) Name() string {
 	return a.name
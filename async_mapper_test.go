@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -387,6 +388,84 @@ func TestAsyncMapper_Name(t *testing.T) {
 	}
 }
 
+func TestAsyncMapper_WorkerMetadata(t *testing.T) {
+	const workers = 4
+	const items = 50
+
+	for _, ordered := range []bool{true, false} {
+		t.Run(fmt.Sprintf("ordered=%v", ordered), func(t *testing.T) {
+			ctx := context.Background()
+
+			// The first call on each worker waits until every worker has started one,
+			// guaranteeing all workers take part
+			var calls atomic.Int32
+			var barrier sync.WaitGroup
+			barrier.Add(workers)
+
+			mapper := NewAsyncMapper(func(_ context.Context, i int) (int, error) {
+				if calls.Add(1) <= workers {
+					barrier.Done()
+					barrier.Wait()
+				}
+				return i * 2, nil
+			}).WithWorkers(workers).WithOrdered(ordered).WithWorkerMetadata(true)
+
+			in := make(chan Result[int])
+			go func() {
+				defer close(in)
+				for i := 0; i < items; i++ {
+					in <- NewSuccess(i)
+				}
+			}()
+
+			seen := make(map[int]bool)
+			values := make([]int, 0, items)
+			for result := range mapper.Process(ctx, in) {
+				id, ok := result.GetMetadata(MetadataWorkerID)
+				workerID, isInt := id.(int)
+				if !ok || !isInt || workerID < 0 || workerID >= workers {
+					t.Errorf("expected worker_id in [0, %d), got %v", workers, id)
+					continue
+				}
+				seen[workerID] = true
+				values = append(values, result.Value())
+			}
+
+			if len(seen) != workers {
+				t.Errorf("expected all %d workers observed, got %v", workers, seen)
+			}
+			if len(values) != items {
+				t.Fatalf("expected %d results, got %d", items, len(values))
+			}
+			sort.Ints(values)
+			for i, v := range values {
+				if v != i*2 {
+					t.Fatalf("expected values unaffected, got %d at %d", v, i)
+				}
+			}
+		})
+	}
+}
+
+func TestAsyncMapper_WorkerMetadataDisabled(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int], 3)
+	for i := 0; i < 3; i++ {
+		in <- NewSuccess(i)
+	}
+	close(in)
+
+	mapper := NewAsyncMapper(func(_ context.Context, i int) (int, error) {
+		return i, nil
+	}).WithWorkers(2)
+
+	for result := range mapper.Process(ctx, in) {
+		if result.HasMetadata() {
+			t.Errorf("expected no metadata by default, got keys %v", result.MetadataKeys())
+		}
+	}
+}
+
 // Benchmarks
 
 func BenchmarkAsyncMapper_Ordered(b *testing.B) {
@@ -16,11 +16,21 @@ import (
 //
 //nolint:govet // fieldalignment: struct layout optimized for readability
 type Batcher[T any] struct {
-	config BatchConfig
-	name   string
-	clock  Clock
+	config     BatchConfig
+	name       string
+	clock      Clock
+	onBatch    func(size int, trigger string)
+	onError    func(*StreamError[T])
+	onComplete func(status string)
 }
 
+// Batch emission triggers reported to OnBatch callbacks.
+const (
+	BatchTriggerSize    = "size"    // Batch reached MaxSize
+	BatchTriggerLatency = "latency" // MaxLatency expired before the batch filled
+	BatchTriggerClose   = "close"   // Input closed with a partial batch pending
+)
+
 // NewBatcher creates a processor that intelligently groups items into batches.
 // Batches are emitted when either the size limit is reached OR the time limit expires,
 // whichever comes first. This dual-trigger approach balances throughput with latency.
@@ -78,6 +88,39 @@ func NewBatcher[T any](config BatchConfig, clock Clock) *Batcher[T] {
 	}
 }
 
+// OnBatch registers a callback invoked after each batch is emitted.
+// The callback receives the batch size and the trigger that caused emission:
+// BatchTriggerSize, BatchTriggerLatency, or BatchTriggerClose.
+// Callbacks run on the processing goroutine and should return quickly.
+func (b *Batcher[T]) OnBatch(fn func(size int, trigger string)) *Batcher[T] {
+	b.onBatch = fn
+	return b
+}
+
+// OnError registers a callback invoked after each error is passed through.
+// Callbacks run on the processing goroutine and should return quickly.
+func (b *Batcher[T]) OnError(fn func(*StreamError[T])) *Batcher[T] {
+	b.onError = fn
+	return b
+}
+
+// OnComplete registers a callback invoked once when processing ends, after the
+// final batch is emitted and before the output channel closes. The callback
+// receives CompletionStatusCompleted when the input closed normally, or
+// CompletionStatusCanceled when the context was canceled.
+// Callbacks run on the processing goroutine and should return quickly.
+func (b *Batcher[T]) OnComplete(fn func(status string)) *Batcher[T] {
+	b.onComplete = fn
+	return b
+}
+
+// notifyBatch reports an emitted batch to the OnBatch callback if registered.
+func (b *Batcher[T]) notifyBatch(size int, trigger string) {
+	if b.onBatch != nil {
+		b.onBatch(size, trigger)
+	}
+}
+
 // Process groups input items into batches according to the configured constraints.
 // It returns a channel of Result[[]T] where successful results contain batches and
 // error results contain individual item processing errors.
@@ -98,6 +141,7 @@ func (b *Batcher[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Re
 
 	go func() {
 		defer close(out)
+		defer notifyComplete(ctx, b.onComplete)
 
 		batch := make([]T, 0, b.config.MaxSize)
 		var timer Timer
@@ -112,6 +156,7 @@ func (b *Batcher[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Re
 					if len(batch) > 0 {
 						select {
 						case out <- NewSuccess(batch):
+							b.notifyBatch(len(batch), BatchTriggerLatency)
 							// Create new batch with pre-allocated capacity
 							batch = make([]T, 0, b.config.MaxSize)
 						case <-ctx.Done():
@@ -138,6 +183,7 @@ func (b *Batcher[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Re
 					if len(batch) > 0 {
 						select {
 						case out <- NewSuccess(batch):
+							b.notifyBatch(len(batch), BatchTriggerClose)
 						case <-ctx.Done():
 						}
 					}
@@ -150,6 +196,9 @@ func (b *Batcher[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Re
 					errorResult := NewError(make([]T, 0), result.Error().Err, result.Error().ProcessorName)
 					select {
 					case out <- errorResult:
+						if b.onError != nil {
+							b.onError(result.Error())
+						}
 					case <-ctx.Done():
 						return
 					}
@@ -181,6 +230,7 @@ func (b *Batcher[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Re
 
 					select {
 					case out <- NewSuccess(batch):
+						b.notifyBatch(len(batch), BatchTriggerSize)
 						// Create new batch with pre-allocated capacity
 						batch = make([]T, 0, b.config.MaxSize)
 					case <-ctx.Done():
@@ -193,6 +243,7 @@ func (b *Batcher[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Re
 				if len(batch) > 0 {
 					select {
 					case out <- NewSuccess(batch):
+						b.notifyBatch(len(batch), BatchTriggerLatency)
 						// Create new batch
 						batch = make([]T, 0, b.config.MaxSize)
 					case <-ctx.Done():
@@ -683,3 +683,156 @@ func BenchmarkBatcher_MixedItems(b *testing.B) {
 		}
 	}
 }
+
+// batchEvent records an OnBatch callback invocation.
+type batchEvent struct {
+	trigger string
+	size    int
+}
+
+func TestBatcher_OnBatchTriggers(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	var events []batchEvent
+	batcher := NewBatcher[int](BatchConfig{
+		MaxSize:    2,
+		MaxLatency: 100 * time.Millisecond,
+	}, clock).OnBatch(func(size int, trigger string) {
+		events = append(events, batchEvent{trigger: trigger, size: size})
+	})
+	ctx := context.Background()
+
+	in := make(chan Result[int])
+	out := batcher.Process(ctx, in)
+
+	// Size-triggered batch
+	in <- NewSuccess(1)
+	in <- NewSuccess(2)
+	if batch := (<-out).Value(); len(batch) != 2 {
+		t.Fatalf("expected size batch of 2, got %v", batch)
+	}
+
+	// Latency-triggered batch; the pass-through error confirms the timer is armed
+	in <- NewSuccess(3)
+	in <- NewError(0, errors.New("sync"), "test")
+	if !(<-out).IsError() {
+		t.Fatal("expected pass-through error")
+	}
+	clock.Advance(100 * time.Millisecond)
+	clock.BlockUntilReady()
+	if batch := (<-out).Value(); len(batch) != 1 || batch[0] != 3 {
+		t.Fatalf("expected latency batch [3], got %v", batch)
+	}
+
+	// Close-triggered flush
+	in <- NewSuccess(4)
+	close(in)
+	if batch := (<-out).Value(); len(batch) != 1 || batch[0] != 4 {
+		t.Fatalf("expected close batch [4], got %v", batch)
+	}
+
+	// Channel close guarantees all callbacks have run
+	if _, ok := <-out; ok {
+		t.Fatal("expected channel to be closed")
+	}
+
+	expected := []batchEvent{
+		{trigger: BatchTriggerSize, size: 2},
+		{trigger: BatchTriggerLatency, size: 1},
+		{trigger: BatchTriggerClose, size: 1},
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("expected %d OnBatch events, got %v", len(expected), events)
+	}
+	for i := range expected {
+		if events[i] != expected[i] {
+			t.Errorf("event %d: expected %+v, got %+v", i, expected[i], events[i])
+		}
+	}
+}
+
+func TestBatcher_OnErrorCallback(t *testing.T) {
+	var errs []*StreamError[int]
+	var batches int
+	batcher := NewBatcher[int](BatchConfig{MaxSize: 10}, RealClock).
+		OnError(func(err *StreamError[int]) {
+			errs = append(errs, err)
+		}).
+		OnBatch(func(_ int, _ string) {
+			batches++
+		})
+	ctx := context.Background()
+
+	in := make(chan Result[int], 4)
+	in <- NewError(1, errors.New("first"), "upstream")
+	in <- NewSuccess(2)
+	in <- NewError(3, errors.New("second"), "upstream")
+	close(in)
+
+	var results []Result[[]int]
+	for result := range batcher.Process(ctx, in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("expected 2 errors and 1 batch, got %d results", len(results))
+	}
+	if len(errs) != 2 {
+		t.Fatalf("expected OnError to fire twice, got %d", len(errs))
+	}
+	if errs[0].Item != 1 || errs[0].Err.Error() != "first" {
+		t.Errorf("unexpected first error: %v", errs[0])
+	}
+	if errs[1].Item != 3 || errs[1].Err.Error() != "second" {
+		t.Errorf("unexpected second error: %v", errs[1])
+	}
+	if batches != 1 {
+		t.Errorf("expected one OnBatch call for the close flush, got %d", batches)
+	}
+}
+
+func TestBatcher_OnComplete(t *testing.T) {
+	t.Run("completed after final batch", func(t *testing.T) {
+		var calls []string
+		batcher := NewBatcher[int](BatchConfig{MaxSize: 10}, clockz.NewFakeClock()).
+			OnBatch(func(_ int, trigger string) {
+				calls = append(calls, trigger)
+			}).
+			OnComplete(func(status string) {
+				calls = append(calls, status)
+			})
+
+		in := make(chan Result[int], 2)
+		in <- NewSuccess(1)
+		in <- NewSuccess(2)
+		close(in)
+
+		for range batcher.Process(context.Background(), in) {
+		}
+
+		expected := []string{BatchTriggerClose, CompletionStatusCompleted}
+		if len(calls) != len(expected) || calls[0] != expected[0] || calls[1] != expected[1] {
+			t.Errorf("expected %v, got %v", expected, calls)
+		}
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		var statuses []string
+		batcher := NewBatcher[int](BatchConfig{MaxSize: 10}, clockz.NewFakeClock()).
+			OnComplete(func(status string) {
+				statuses = append(statuses, status)
+			})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan Result[int])
+		out := batcher.Process(ctx, in)
+		in <- NewSuccess(1)
+		cancel()
+
+		for range out {
+		}
+
+		if len(statuses) != 1 || statuses[0] != CompletionStatusCanceled {
+			t.Errorf("expected a single canceled status, got %v", statuses)
+		}
+	})
+}
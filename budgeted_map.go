@@ -0,0 +1,169 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrBudgetExceeded is reported for items whose function call outlived the
+// time budget carried in their metadata.
+var ErrBudgetExceeded = errors.New("item budget exceeded")
+
+// BudgetedMap transforms items under a per-item time budget read from metadata,
+// enforcing individual request SLAs inside a shared pipeline.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type BudgetedMap[T any] struct {
+	name      string
+	fn        func(context.Context, T) (T, error)
+	budgetKey string
+	clock     Clock
+}
+
+// NewBudgetedMap creates a processor that runs fn on each item within that item's budget.
+// The budget is the time.Duration stored in the item's metadata under budgetKey.
+// fn receives a context that expires when the budget runs out; if fn has not
+// returned by then, the item becomes an error Result wrapping ErrBudgetExceeded
+// and context.DeadlineExceeded, carrying the original item. A budget that is
+// zero or negative is already spent, and fn is not called.
+//
+// Items without a budget, or with a value that is not a time.Duration, run under
+// the parent context with no deadline. Results keep the metadata of their input.
+// Errors pass through unchanged.
+//
+// Items are processed one at a time. A timed-out call is abandoned rather than
+// waited for: fn keeps running in the background until it returns, so it should
+// honor its context to release resources promptly.
+//
+// When to use:
+//   - Enforcing per-request deadlines propagated from API callers
+//   - Giving priority traffic a longer processing allowance than bulk traffic
+//   - Failing fast on slow lookups without a pipeline-wide timeout
+//
+// Example:
+//
+//	// Each request carries the time remaining on its caller's deadline
+//	enrich := streamz.NewBudgetedMap(func(ctx context.Context, r Request) (Request, error) {
+//		profile, err := profiles.Get(ctx, r.UserID)
+//		r.Profile = profile
+//		return r, err
+//	}, "budget", streamz.RealClock)
+//
+//	requests = enrich.Process(ctx, requests)
+//	for result := range requests {
+//		if errors.Is(result.Error(), streamz.ErrBudgetExceeded) {
+//			respondTimeout(result.Error().Item)
+//		}
+//	}
+//
+// Parameters:
+//   - fn: Transformation applied to each successful item
+//   - budgetKey: Metadata key holding each item's time.Duration budget
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new BudgetedMap processor.
+func NewBudgetedMap[T any](fn func(context.Context, T) (T, error), budgetKey string, clock Clock) *BudgetedMap[T] {
+	return &BudgetedMap[T]{
+		name:      "budgeted-map",
+		fn:        fn,
+		budgetKey: budgetKey,
+		clock:     clock,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "budgeted-map".
+func (b *BudgetedMap[T]) WithName(name string) *BudgetedMap[T] {
+	b.name = name
+	return b
+}
+
+// Process applies fn to each successful item within its metadata budget.
+func (b *BudgetedMap[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsSuccess() {
+				value, err := b.call(ctx, item)
+				if ctx.Err() != nil {
+					return
+				}
+				if err != nil {
+					item = Result[T]{err: NewStreamError(item.Value(), err, b.name), metadata: item.metadata}
+				} else {
+					item = Result[T]{value: value, metadata: item.metadata}
+				}
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// budgetedCall is the outcome of one call to fn.
+type budgetedCall[T any] struct {
+	value T
+	err   error
+}
+
+// call runs fn on item under the item's budget, if it has one.
+func (b *BudgetedMap[T]) call(ctx context.Context, item Result[T]) (T, error) {
+	raw, found := item.GetMetadata(b.budgetKey)
+	budget, ok := raw.(time.Duration)
+	if !found || !ok {
+		return b.fn(ctx, item.Value())
+	}
+
+	var zero T
+	if budget <= 0 {
+		return zero, b.exceeded(budget)
+	}
+
+	callCtx, cancel := b.clock.WithTimeout(ctx, budget)
+	defer cancel()
+
+	done := make(chan budgetedCall[T], 1)
+	go func() {
+		value, err := b.fn(callCtx, item.Value())
+		done <- budgetedCall[T]{value: value, err: err}
+	}()
+
+	select {
+	case result := <-done:
+		if result.err != nil && errors.Is(callCtx.Err(), context.DeadlineExceeded) {
+			return zero, b.exceeded(budget)
+		}
+		return result.value, result.err
+	case <-callCtx.Done():
+		if ctx.Err() != nil {
+			return zero, ctx.Err()
+		}
+		return zero, b.exceeded(budget)
+	}
+}
+
+// exceeded builds the error reported for an item that ran out of budget.
+func (*BudgetedMap[T]) exceeded(budget time.Duration) error {
+	return fmt.Errorf("%w (budget %v): %w", ErrBudgetExceeded, budget, context.DeadlineExceeded)
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (b *BudgetedMap[T]) Name() string {
+	return b.name
+}
@@ -0,0 +1,171 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+const testBudgetKey = "budget"
+
+// slowExclaim waits for delay on the clock, or until ctx ends, before appending "!".
+func slowExclaim(clock Clock, delay time.Duration) func(context.Context, string) (string, error) {
+	return func(ctx context.Context, s string) (string, error) {
+		select {
+		case <-clock.After(delay):
+			return s + "!", nil
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+}
+
+func TestBudgetedMap_Name(t *testing.T) {
+	m := NewBudgetedMap(slowExclaim(RealClock, 0), testBudgetKey, RealClock)
+	if m.Name() != "budgeted-map" {
+		t.Errorf("expected name 'budgeted-map', got %q", m.Name())
+	}
+	if m.WithName("enrich").Name() != "enrich" {
+		t.Errorf("expected name 'enrich', got %q", m.Name())
+	}
+}
+
+func TestBudgetedMap_GenerousBudget(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	var deadline time.Time
+	m := NewBudgetedMap(func(ctx context.Context, s string) (string, error) {
+		deadline, _ = ctx.Deadline()
+		return s + "!", nil
+	}, testBudgetKey, clock)
+
+	in := make(chan Result[string], 1)
+	in <- NewSuccess("ok").WithMetadata(testBudgetKey, time.Second)
+	close(in)
+	out := m.Process(context.Background(), in)
+
+	result := <-out
+	if result.IsError() {
+		t.Fatalf("unexpected error: %v", result.Error())
+	}
+	if result.Value() != "ok!" {
+		t.Errorf("expected 'ok!', got %q", result.Value())
+	}
+	if !deadline.Equal(clock.Now().Add(time.Second)) {
+		t.Errorf("expected deadline one budget from now, got %v", deadline)
+	}
+	if budget, _ := result.GetMetadata(testBudgetKey); budget != time.Second {
+		t.Errorf("expected metadata preserved, got %v", budget)
+	}
+}
+
+func TestBudgetedMap_TightBudgetTimesOut(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	m := NewBudgetedMap(slowExclaim(clock, time.Second), testBudgetKey, clock)
+
+	in := make(chan Result[string], 1)
+	in <- NewSuccess("slow").WithMetadata(testBudgetKey, 50*time.Millisecond)
+	close(in)
+	out := m.Process(context.Background(), in)
+
+	waitForTimer(t, clock)
+	clock.Advance(50 * time.Millisecond)
+	clock.BlockUntilReady()
+
+	select {
+	case result := <-out:
+		if !result.IsError() {
+			t.Fatalf("expected budget error, got %q", result.Value())
+		}
+		if !errors.Is(result.Error(), ErrBudgetExceeded) || !errors.Is(result.Error(), context.DeadlineExceeded) {
+			t.Errorf("expected ErrBudgetExceeded and DeadlineExceeded, got %v", result.Error())
+		}
+		if result.Error().Item != "slow" {
+			t.Errorf("expected source item 'slow', got %q", result.Error().Item)
+		}
+		if result.Error().ProcessorName != "budgeted-map" {
+			t.Errorf("expected processor 'budgeted-map', got %q", result.Error().ProcessorName)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected timeout once the budget elapsed")
+	}
+}
+
+func TestBudgetedMap_BudgetIgnoringFunction(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	release := make(chan struct{})
+	defer close(release)
+	m := NewBudgetedMap(func(_ context.Context, s string) (string, error) {
+		<-release
+		return s, nil
+	}, testBudgetKey, clock)
+
+	in := make(chan Result[string], 1)
+	in <- NewSuccess("stuck").WithMetadata(testBudgetKey, time.Second)
+	close(in)
+	out := m.Process(context.Background(), in)
+
+	waitForTimer(t, clock)
+	clock.Advance(time.Second)
+	clock.BlockUntilReady()
+
+	result := <-out
+	if !errors.Is(result.Error(), ErrBudgetExceeded) {
+		t.Errorf("expected budget enforced even when fn ignores its context, got %v", result)
+	}
+}
+
+func TestBudgetedMap_MissingBudgetUsesParentContext(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	var hadDeadline bool
+	m := NewBudgetedMap(func(ctx context.Context, s string) (string, error) {
+		_, hadDeadline = ctx.Deadline()
+		return s + "!", nil
+	}, testBudgetKey, clock)
+
+	in := make(chan Result[string], 3)
+	in <- NewSuccess("plain")
+	in <- NewSuccess("mistyped").WithMetadata(testBudgetKey, "1s")
+	in <- NewError("bad", errors.New("parse failed"), "parser")
+	close(in)
+
+	var results []Result[string]
+	for result := range m.Process(context.Background(), in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if results[0].Value() != "plain!" || results[1].Value() != "mistyped!" {
+		t.Errorf("expected unbudgeted items mapped, got %q and %q", results[0].Value(), results[1].Value())
+	}
+	if hadDeadline {
+		t.Error("expected unbudgeted items to run without a deadline")
+	}
+	if !results[2].IsError() || results[2].Error().ProcessorName != "parser" {
+		t.Errorf("expected upstream error passed through, got %v", results[2])
+	}
+}
+
+func TestBudgetedMap_SpentBudget(t *testing.T) {
+	called := false
+	m := NewBudgetedMap(func(_ context.Context, s string) (string, error) {
+		called = true
+		return s, nil
+	}, testBudgetKey, clockz.NewFakeClock())
+
+	in := make(chan Result[string], 1)
+	in <- NewSuccess("late").WithMetadata(testBudgetKey, time.Duration(0))
+	close(in)
+
+	result := <-m.Process(context.Background(), in)
+	if !errors.Is(result.Error(), ErrBudgetExceeded) {
+		t.Errorf("expected spent budget to fail immediately, got %v", result)
+	}
+	if called {
+		t.Error("expected fn not called for a spent budget")
+	}
+}
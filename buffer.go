@@ -12,8 +12,9 @@ import (
 // whether they contain successful values or errors. It provides buffering between
 // pipeline stages without any transformation logic.
 type Buffer[T any] struct {
-	name string
-	size int
+	name       string
+	size       int
+	onComplete func(status string)
 }
 
 // NewBuffer creates a processor with a simple buffered output channel.
@@ -57,6 +58,14 @@ func NewBuffer[T any](size int) *Buffer[T] {
 	}
 }
 
+// OnComplete registers a callback invoked once, just before the output channel
+// closes, with CompletionStatusCompleted or CompletionStatusCanceled.
+// Callbacks run on the processing goroutine and should return quickly.
+func (b *Buffer[T]) OnComplete(fn func(status string)) *Buffer[T] {
+	b.onComplete = fn
+	return b
+}
+
 // Process creates a buffered channel and passes through all Result[T] items unchanged.
 // Both successful values and errors are preserved without modification.
 // The buffer provides decoupling between producer and consumer goroutines.
@@ -65,8 +74,13 @@ func (b *Buffer[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Res
 
 	go func() {
 		defer close(out)
+		defer notifyComplete(ctx, b.onComplete)
 
-		for item := range in {
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
 			select {
 			case out <- item:
 			case <-ctx.Done():
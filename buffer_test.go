@@ -575,3 +575,49 @@ func collectResultsWithTimeout[T any](ch <-chan Result[T], timeout time.Duration
 		}
 	}
 }
+
+func TestBuffer_OnComplete(t *testing.T) {
+	t.Run("completed", func(t *testing.T) {
+		var statuses []string
+		buffer := NewBuffer[int](5).OnComplete(func(status string) {
+			statuses = append(statuses, status)
+		})
+
+		in := make(chan Result[int], 3)
+		for i := 0; i < 3; i++ {
+			in <- NewSuccess(i)
+		}
+		close(in)
+
+		count := 0
+		for range buffer.Process(context.Background(), in) {
+			count++
+		}
+
+		if count != 3 {
+			t.Errorf("expected 3 items before completion, got %d", count)
+		}
+		if len(statuses) != 1 || statuses[0] != CompletionStatusCompleted {
+			t.Errorf("expected a single completed status, got %v", statuses)
+		}
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		var statuses []string
+		buffer := NewBuffer[int](0).OnComplete(func(status string) {
+			statuses = append(statuses, status)
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan Result[int])
+		out := buffer.Process(ctx, in)
+		cancel()
+
+		for range out {
+		}
+
+		if len(statuses) != 1 || statuses[0] != CompletionStatusCanceled {
+			t.Errorf("expected a single canceled status, got %v", statuses)
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package streamz
+
+import (
+	"context"
+	"sync"
+)
+
+// BulkMap batches items, transforms each batch concurrently with a bulk function,
+// and emits the outputs individually. It composes Batcher, a worker pool, and
+// unbatching into one stage for enrichment against bulk APIs.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type BulkMap[T, U any] struct {
+	name    string
+	batcher *Batcher[T]
+	workers int
+	fn      func(context.Context, []T) ([]U, error)
+}
+
+// NewBulkMap creates a processor that applies fn to batches of items with up to
+// workers batches in flight at once. Batches are formed according to config,
+// exactly as by Batcher. Every output of a successful batch is emitted as its
+// own success Result; fn may return any number of outputs per batch.
+//
+// When fn fails, each item of the batch becomes a separate error Result carrying
+// the batch error, so downstream per-item error handling sees one failure per
+// input. Upstream errors pass through as errors without reaching fn.
+//
+// Batches complete in any order, so outputs from different batches may interleave
+// out of input order. Outputs from a single batch keep the order fn returned them in.
+//
+// When to use:
+//   - Enriching items through a bulk lookup endpoint
+//   - Writing to a store that accepts multi-row inserts, with per-item results
+//   - Amortizing per-call overhead while keeping a per-item stream
+//
+// Example:
+//
+//	// Look up 100 users per call, with 4 calls in flight
+//	enrich := streamz.NewBulkMap(streamz.BatchConfig{
+//		MaxSize:    100,
+//		MaxLatency: 50 * time.Millisecond,
+//	}, 4, func(ctx context.Context, ids []string) ([]User, error) {
+//		return users.GetMany(ctx, ids)
+//	}, streamz.RealClock)
+//
+//	profiles := enrich.Process(ctx, userIDs)
+//
+// Parameters:
+//   - config: Batch size and latency limits
+//   - workers: Maximum number of batches processed concurrently (must be positive)
+//   - fn: Bulk transformation, safe for concurrent use
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new BulkMap processor.
+// Panics if workers is less than 1.
+func NewBulkMap[T, U any](config BatchConfig, workers int, fn func(context.Context, []T) ([]U, error), clock Clock) *BulkMap[T, U] {
+	if workers < 1 {
+		panic("bulk map workers must be positive")
+	}
+
+	return &BulkMap[T, U]{
+		name:    "bulk-map",
+		batcher: NewBatcher[T](config, clock),
+		workers: workers,
+		fn:      fn,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "bulk-map".
+func (b *BulkMap[T, U]) WithName(name string) *BulkMap[T, U] {
+	b.name = name
+	return b
+}
+
+// Process batches input, runs fn on batches concurrently, and emits individual outputs.
+func (b *BulkMap[T, U]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[U] {
+	out := make(chan Result[U])
+	batches := b.batcher.Process(ctx, in)
+
+	go func() {
+		defer close(out)
+
+		var wg sync.WaitGroup
+		for i := 0; i < b.workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for batch := range batches {
+					if !b.processBatch(ctx, out, batch) {
+						return
+					}
+				}
+			}()
+		}
+		wg.Wait()
+	}()
+
+	return out
+}
+
+// processBatch runs fn on one batch and emits its per-item Results.
+// Returns false if the context was canceled while emitting.
+func (b *BulkMap[T, U]) processBatch(ctx context.Context, out chan<- Result[U], batch Result[[]T]) bool {
+	var results []Result[U]
+	var zero U
+
+	if batch.IsError() {
+		results = []Result[U]{NewError(zero, batch.Error().Err, batch.Error().ProcessorName)}
+	} else if outputs, err := b.fn(ctx, batch.Value()); err != nil {
+		results = make([]Result[U], len(batch.Value()))
+		for i := range results {
+			results[i] = NewError(zero, err, b.name)
+		}
+	} else {
+		results = make([]Result[U], len(outputs))
+		for i, output := range outputs {
+			results[i] = NewSuccess(output)
+		}
+	}
+
+	for _, result := range results {
+		select {
+		case out <- result:
+		case <-ctx.Done():
+			return false
+		}
+	}
+	return true
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (b *BulkMap[T, U]) Name() string {
+	return b.name
+}
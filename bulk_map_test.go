@@ -0,0 +1,179 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+func TestBulkMap_Name(t *testing.T) {
+	bulk := NewBulkMap(BatchConfig{MaxSize: 10}, 1, func(_ context.Context, items []int) ([]int, error) {
+		return items, nil
+	}, RealClock)
+	if bulk.Name() != "bulk-map" {
+		t.Errorf("expected name 'bulk-map', got %q", bulk.Name())
+	}
+	if bulk.WithName("enrich").Name() != "enrich" {
+		t.Errorf("expected name 'enrich', got %q", bulk.Name())
+	}
+}
+
+func TestBulkMap_PanicsOnInvalidWorkers(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for zero workers")
+		}
+	}()
+	NewBulkMap(BatchConfig{MaxSize: 10}, 0, func(_ context.Context, items []int) ([]int, error) {
+		return items, nil
+	}, RealClock)
+}
+
+func TestBulkMap_BatchesAndUnbatches(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	var mu sync.Mutex
+	var sizes []int
+
+	bulk := NewBulkMap(BatchConfig{MaxSize: 3, MaxLatency: time.Second}, 1, func(_ context.Context, items []int) ([]string, error) {
+		mu.Lock()
+		sizes = append(sizes, len(items))
+		mu.Unlock()
+		outputs := make([]string, len(items))
+		for i, item := range items {
+			outputs[i] = strconv.Itoa(item * 10)
+		}
+		return outputs, nil
+	}, clock)
+
+	in := make(chan Result[int])
+	out := bulk.Process(context.Background(), in)
+
+	// A partial batch is flushed once MaxLatency expires
+	in <- NewSuccess(1)
+	waitForTimer(t, clock)
+	clock.Advance(time.Second)
+	clock.BlockUntilReady()
+	if got := <-out; got.Value() != "10" {
+		t.Errorf("expected latency-flushed output '10', got %q", got.Value())
+	}
+
+	for i := 2; i <= 4; i++ {
+		in <- NewSuccess(i)
+	}
+	for _, want := range []string{"20", "30", "40"} {
+		if got := <-out; got.Value() != want {
+			t.Errorf("expected %q, got %q", want, got.Value())
+		}
+	}
+	close(in)
+
+	if _, ok := <-out; ok {
+		t.Error("expected output closed after input closed")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if !slices.Equal(sizes, []int{1, 3}) {
+		t.Errorf("expected batch sizes [1 3], got %v", sizes)
+	}
+}
+
+func TestBulkMap_FailingBatchProducesPerItemErrors(t *testing.T) {
+	errBulk := errors.New("bulk api unavailable")
+	bulk := NewBulkMap(BatchConfig{MaxSize: 3}, 1, func(_ context.Context, items []int) ([]int, error) {
+		if items[0] == 4 {
+			return nil, errBulk
+		}
+		return items, nil
+	}, clockz.NewFakeClock())
+
+	in := make(chan Result[int], 7)
+	for i := 1; i <= 6; i++ {
+		in <- NewSuccess(i)
+	}
+	in <- NewError(7, errors.New("parse failed"), "parser")
+	close(in)
+
+	var successes, failures int
+	var upstream *StreamError[int]
+	for result := range bulk.Process(context.Background(), in) {
+		switch {
+		case result.IsSuccess():
+			successes++
+		case errors.Is(result.Error(), errBulk):
+			failures++
+			if result.Error().ProcessorName != "bulk-map" {
+				t.Errorf("expected processor name 'bulk-map', got %q", result.Error().ProcessorName)
+			}
+		default:
+			upstream = result.Error()
+		}
+	}
+
+	if successes != 3 {
+		t.Errorf("expected 3 successes from the first batch, got %d", successes)
+	}
+	if failures != 3 {
+		t.Errorf("expected one error per item of the failing batch, got %d", failures)
+	}
+	if upstream == nil || upstream.ProcessorName != "parser" {
+		t.Errorf("expected upstream error passed through, got %v", upstream)
+	}
+}
+
+func TestBulkMap_BoundsConcurrency(t *testing.T) {
+	const workers = 2
+	release := make(chan struct{})
+	started := make(chan struct{}, 6)
+	var mu sync.Mutex
+	var active, peak int
+
+	bulk := NewBulkMap(BatchConfig{MaxSize: 1}, workers, func(_ context.Context, items []int) ([]int, error) {
+		mu.Lock()
+		active++
+		peak = max(peak, active)
+		mu.Unlock()
+		started <- struct{}{}
+
+		<-release
+
+		mu.Lock()
+		active--
+		mu.Unlock()
+		return items, nil
+	}, clockz.NewFakeClock())
+
+	in := make(chan Result[int], 6)
+	for i := 0; i < 6; i++ {
+		in <- NewSuccess(i)
+	}
+	close(in)
+	out := bulk.Process(context.Background(), in)
+
+	for i := 0; i < workers; i++ {
+		<-started
+	}
+	select {
+	case <-started:
+		t.Fatal("expected no more than 2 batches in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	count := 0
+	for range out {
+		count++
+	}
+
+	if count != 6 {
+		t.Errorf("expected 6 outputs, got %d", count)
+	}
+	if peak != workers {
+		t.Errorf("expected peak concurrency %d, got %d", workers, peak)
+	}
+}
@@ -0,0 +1,116 @@
+package streamz
+
+import (
+	"context"
+	"time"
+)
+
+// MetadataBurst flags items that arrived as part of a burst, set by BurstDetector.
+const MetadataBurst = "burst" // bool - more than the threshold of items arrived within the window ending at this item
+
+// BurstDetector watches arrival times and flags items that arrive in bursts,
+// letting downstream adaptive stages, such as samplers or throttles, react to
+// sudden spikes in traffic without measuring rates themselves.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type BurstDetector[T any] struct {
+	name      string
+	threshold int
+	window    time.Duration
+	clock     Clock
+}
+
+// NewBurstDetector creates a processor that flags items arriving in bursts.
+// Every item is forwarded with MetadataBurst: true when more than threshold
+// items, including this one, arrived within the window ending at its arrival,
+// and false otherwise. The flag therefore clears on its own as soon as arrivals
+// slow below the threshold. Errors count as arrivals and are flagged the same way.
+// Only the last threshold arrival times are kept, so memory is constant.
+//
+// When to use:
+//   - Triggering adaptive sampling or shedding during spikes
+//   - Annotating traffic for burst analysis
+//   - Detecting retry storms or thundering-herd reconnects
+//
+// Example:
+//
+//	// Flag traffic above 100 requests per second
+//	detector := streamz.NewBurstDetector[Request](100, time.Second, streamz.RealClock)
+//
+//	for result := range detector.Process(ctx, requests) {
+//		if burst, _ := result.GetMetadata(streamz.MetadataBurst); burst == true {
+//			sampler.Sample(result)
+//			continue
+//		}
+//		handle(result)
+//	}
+//
+// Parameters:
+//   - threshold: Most items allowed within window before items are flagged (must be positive)
+//   - window: Span of time over which arrivals are counted
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new BurstDetector processor.
+// Panics if threshold is less than 1.
+func NewBurstDetector[T any](threshold int, window time.Duration, clock Clock) *BurstDetector[T] {
+	if threshold < 1 {
+		panic("burst threshold must be positive")
+	}
+	return &BurstDetector[T]{
+		name:      "burst-detector",
+		threshold: threshold,
+		window:    window,
+		clock:     clock,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "burst-detector".
+func (b *BurstDetector[T]) WithName(name string) *BurstDetector[T] {
+	b.name = name
+	return b
+}
+
+// Process forwards every item, flagging those that arrive in a burst.
+func (b *BurstDetector[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		// Ring of the last threshold arrival times; next is the oldest once full
+		arrivals := make([]time.Time, 0, b.threshold)
+		next := 0
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			now := b.clock.Now()
+			burst := false
+			if len(arrivals) < b.threshold {
+				arrivals = append(arrivals, now)
+			} else {
+				// The oldest of the last threshold arrivals plus this one
+				burst = now.Sub(arrivals[next]) < b.window
+				arrivals[next] = now
+				next = (next + 1) % b.threshold
+			}
+
+			select {
+			case out <- item.WithMetadata(MetadataBurst, burst):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (b *BurstDetector[T]) Name() string {
+	return b.name
+}
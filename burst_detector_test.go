@@ -0,0 +1,82 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+func TestBurstDetector_Name(t *testing.T) {
+	detector := NewBurstDetector[int](3, time.Second, RealClock)
+	if detector.Name() != "burst-detector" {
+		t.Errorf("expected name 'burst-detector', got %q", detector.Name())
+	}
+	if detector.WithName("spikes").Name() != "spikes" {
+		t.Errorf("expected name 'spikes', got %q", detector.Name())
+	}
+}
+
+func TestBurstDetector_PanicsOnInvalidThreshold(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for threshold of 0")
+		}
+	}()
+	NewBurstDetector[int](0, time.Second, RealClock)
+}
+
+func TestBurstDetector_SpacedItemsNotFlagged(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[int])
+	defer close(in)
+	out := NewBurstDetector[int](3, time.Second, clock).Process(context.Background(), in)
+
+	for i := 0; i < 10; i++ {
+		in <- NewSuccess(i)
+		if flag, _ := (<-out).GetMetadata(MetadataBurst); flag != false {
+			t.Errorf("item %d: expected burst flag false at 400ms spacing, got %v", i, flag)
+		}
+		clock.Advance(400 * time.Millisecond)
+	}
+}
+
+func TestBurstDetector_FlagsClusterAndClears(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[int])
+	defer close(in)
+	out := NewBurstDetector[int](3, time.Second, clock).Process(context.Background(), in)
+
+	// Five simultaneous arrivals: only those beyond the threshold are flagged
+	var flags []interface{}
+	for i := 0; i < 5; i++ {
+		in <- NewSuccess(i)
+		flag, _ := (<-out).GetMetadata(MetadataBurst)
+		flags = append(flags, flag)
+	}
+	if want := []interface{}{false, false, false, true, true}; !slices.Equal(flags, want) {
+		t.Errorf("expected flags %v, got %v", want, flags)
+	}
+
+	// Errors count as arrivals too
+	clock.Advance(500 * time.Millisecond)
+	in <- NewError(5, errors.New("timeout"), "client")
+	if flag, _ := (<-out).GetMetadata(MetadataBurst); flag != true {
+		t.Error("expected the burst to continue while the window holds more than 3 items")
+	}
+
+	// Once the cluster slides out of the window, the flag clears
+	clock.Advance(600 * time.Millisecond)
+	in <- NewSuccess(6)
+	if flag, _ := (<-out).GetMetadata(MetadataBurst); flag != false {
+		t.Error("expected the flag to clear once the rate dropped")
+	}
+	clock.Advance(100 * time.Millisecond)
+	in <- NewSuccess(7)
+	if flag, _ := (<-out).GetMetadata(MetadataBurst); flag != false {
+		t.Error("expected no burst at the reduced rate")
+	}
+}
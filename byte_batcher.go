@@ -0,0 +1,217 @@
+package streamz
+
+import (
+	"context"
+	"time"
+)
+
+// MetadataBatchOversized marks a batch holding a single item larger than the byte limit.
+const MetadataBatchOversized = "batch_oversized" // bool - single item exceeded maxBytes
+
+// ByteBatcher groups items into batches bounded by their accumulated byte size.
+// It is intended for sinks with a maximum payload size, such as message brokers
+// or object-store multipart uploads, where item count alone is not a useful bound.
+//
+// A batch is emitted before adding an item that would push it past maxBytes, so
+// emitted batches never exceed the limit, and a batch that reaches exactly maxBytes
+// is emitted at once. An item that is larger than maxBytes on its own is emitted as
+// a single-item batch tagged with MetadataBatchOversized. Partial batches are also
+// emitted when maxLatency expires.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type ByteBatcher[T any] struct {
+	name       string
+	sizeFn     func(T) int
+	maxBytes   int
+	maxLatency time.Duration
+	clock      Clock
+}
+
+// NewByteBatcher creates a processor that batches items by accumulated byte size.
+// Errors are passed through immediately without affecting the current batch.
+//
+// When to use:
+//   - Writing to Kafka, SQS, or other brokers with message size limits
+//   - Building S3 multipart upload parts of bounded size
+//   - Packing log lines into fixed-size network frames
+//
+// Example:
+//
+//	// Pack events into batches of at most 1MB, flushing at least every second
+//	batcher := streamz.NewByteBatcher(
+//		func(e Event) int { return len(e.Payload) },
+//		1<<20,
+//		time.Second,
+//		streamz.RealClock,
+//	)
+//
+//	for result := range batcher.Process(ctx, events) {
+//		if result.IsError() {
+//			continue
+//		}
+//		if oversized, _ := result.GetMetadata(streamz.MetadataBatchOversized); oversized == true {
+//			log.Printf("event exceeds payload limit")
+//		}
+//		publish(result.Value())
+//	}
+//
+// Parameters:
+//   - sizeFn: Returns the byte size of an item
+//   - maxBytes: Maximum accumulated size of a batch
+//   - maxLatency: Maximum time to hold a partial batch (0 disables time-based flushing)
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new ByteBatcher processor.
+func NewByteBatcher[T any](sizeFn func(T) int, maxBytes int, maxLatency time.Duration, clock Clock) *ByteBatcher[T] {
+	return &ByteBatcher[T]{
+		name:       "byte-batcher",
+		sizeFn:     sizeFn,
+		maxBytes:   maxBytes,
+		maxLatency: maxLatency,
+		clock:      clock,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "byte-batcher".
+func (b *ByteBatcher[T]) WithName(name string) *ByteBatcher[T] {
+	b.name = name
+	return b
+}
+
+// Process groups input items into byte-bounded batches.
+//
+// Batching behavior:
+//   - Errors pass through immediately without being batched
+//   - The current batch is emitted before an item that would exceed maxBytes is added
+//   - Items larger than maxBytes are emitted alone and tagged as oversized
+//   - Partial batches are emitted when maxLatency expires or the input closes
+func (b *ByteBatcher[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[[]T] {
+	out := make(chan Result[[]T])
+
+	go func() {
+		defer close(out)
+
+		var batch []T
+		var batchBytes int
+		var timer Timer
+		var timerC <-chan time.Time
+
+		stopTimer := func() {
+			if timer != nil {
+				timer.Stop()
+				timer = nil
+				timerC = nil
+			}
+		}
+
+		emit := func(result Result[[]T]) bool {
+			select {
+			case out <- result:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		flush := func() bool {
+			stopTimer()
+			if len(batch) == 0 {
+				return true
+			}
+			pending := batch
+			batch = nil
+			batchBytes = 0
+			return emit(NewSuccess(pending))
+		}
+
+		for {
+			// Phase 1: Check timer first with higher priority
+			if timerC != nil {
+				select {
+				case <-timerC:
+					timer = nil
+					timerC = nil
+					if !flush() {
+						return
+					}
+					continue
+				default:
+				}
+			}
+
+			// Phase 2: Process input/context
+			select {
+			case result, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+
+				if result.IsError() {
+					errorResult := NewError(make([]T, 0), result.Error().Err, result.Error().ProcessorName)
+					if !emit(errorResult) {
+						return
+					}
+					continue
+				}
+
+				item := result.Value()
+				size := b.sizeFn(item)
+
+				// Oversized items are emitted alone after the pending batch
+				if size > b.maxBytes {
+					if !flush() {
+						return
+					}
+					oversized := NewSuccess([]T{item}).WithMetadata(MetadataBatchOversized, true)
+					if !emit(oversized) {
+						return
+					}
+					continue
+				}
+
+				// Emit before the item would push the batch past the limit
+				if batchBytes+size > b.maxBytes {
+					if !flush() {
+						return
+					}
+				}
+
+				batch = append(batch, item)
+				batchBytes += size
+
+				// A full batch has no room left, so emit it rather than wait for the next item
+				if batchBytes == b.maxBytes {
+					if !flush() {
+						return
+					}
+					continue
+				}
+
+				if len(batch) == 1 && b.maxLatency > 0 {
+					timer = b.clock.NewTimer(b.maxLatency)
+					timerC = timer.C()
+				}
+
+			case <-timerC:
+				timer = nil
+				timerC = nil
+				if !flush() {
+					return
+				}
+
+			case <-ctx.Done():
+				stopTimer()
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (b *ByteBatcher[T]) Name() string {
+	return b.name
+}
@@ -0,0 +1,193 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+func newStringByteBatcher(maxBytes int, maxLatency time.Duration, clock Clock) *ByteBatcher[string] {
+	return NewByteBatcher(func(s string) int { return len(s) }, maxBytes, maxLatency, clock)
+}
+
+func TestByteBatcher_Name(t *testing.T) {
+	batcher := newStringByteBatcher(10, 0, RealClock)
+	if batcher.Name() != "byte-batcher" {
+		t.Errorf("expected name 'byte-batcher', got %q", batcher.Name())
+	}
+	if batcher.WithName("frames").Name() != "frames" {
+		t.Errorf("expected name 'frames', got %q", batcher.Name())
+	}
+}
+
+func TestByteBatcher_JustUnderLimit(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[string], 3)
+	in <- NewSuccess("aaaa")
+	in <- NewSuccess("bbbb")
+	in <- NewSuccess("c")
+	close(in)
+
+	var batches [][]string
+	for result := range newStringByteBatcher(10, 0, RealClock).Process(ctx, in) {
+		batches = append(batches, result.Value())
+	}
+
+	// 4+4+1 = 9 bytes fits within the 10 byte limit
+	if len(batches) != 1 || len(batches[0]) != 3 {
+		t.Fatalf("expected a single batch of 3 items, got %v", batches)
+	}
+}
+
+func TestByteBatcher_OverLimitEmitsBeforeOverflow(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[string])
+	out := newStringByteBatcher(10, 0, RealClock).Process(ctx, in)
+
+	in <- NewSuccess("aaaa")
+	in <- NewSuccess("bbbb")
+
+	// 8 + 3 would exceed 10 bytes, so the pending batch is emitted first
+	go func() { in <- NewSuccess("ccc") }()
+
+	result := <-out
+	if result.IsError() {
+		t.Fatalf("unexpected error: %v", result.Error())
+	}
+	batch := result.Value()
+	if len(batch) != 2 || batch[0] != "aaaa" || batch[1] != "bbbb" {
+		t.Errorf("expected [aaaa bbbb], got %v", batch)
+	}
+	if _, ok := result.GetMetadata(MetadataBatchOversized); ok {
+		t.Error("regular batch should not be marked oversized")
+	}
+
+	close(in)
+	result = <-out
+	if batch := result.Value(); len(batch) != 1 || batch[0] != "ccc" {
+		t.Errorf("expected remaining batch [ccc], got %v", batch)
+	}
+	if _, ok := <-out; ok {
+		t.Error("expected output to be closed")
+	}
+}
+
+func TestByteBatcher_ExactlyFullEmitsImmediately(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[string])
+	defer close(in)
+	out := newStringByteBatcher(10, 0, RealClock).Process(ctx, in)
+
+	// 4+6 = 10 bytes fills the batch, so it is emitted without waiting for more input
+	in <- NewSuccess("aaaa")
+	in <- NewSuccess("bbbbbb")
+
+	select {
+	case result := <-out:
+		if batch := result.Value(); len(batch) != 2 || batch[0] != "aaaa" || batch[1] != "bbbbbb" {
+			t.Errorf("expected [aaaa bbbbbb], got %v", batch)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the full batch to be emitted")
+	}
+
+	// The next item starts a fresh batch
+	in <- NewSuccess("cccccccccc")
+	select {
+	case result := <-out:
+		if batch := result.Value(); len(batch) != 1 || batch[0] != "cccccccccc" {
+			t.Errorf("expected [cccccccccc], got %v", batch)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a single item of exactly maxBytes to be emitted")
+	}
+}
+
+func TestByteBatcher_OversizedItem(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[string], 3)
+	in <- NewSuccess("ab")
+	in <- NewSuccess("this item is far too large")
+	in <- NewSuccess("cd")
+	close(in)
+
+	var results []Result[[]string]
+	for result := range newStringByteBatcher(10, 0, RealClock).Process(ctx, in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 batches, got %d", len(results))
+	}
+	if batch := results[0].Value(); len(batch) != 1 || batch[0] != "ab" {
+		t.Errorf("expected pending batch [ab] flushed first, got %v", batch)
+	}
+
+	oversized := results[1]
+	if batch := oversized.Value(); len(batch) != 1 || batch[0] != "this item is far too large" {
+		t.Errorf("expected oversized item alone, got %v", batch)
+	}
+	if flag, ok := oversized.GetMetadata(MetadataBatchOversized); !ok || flag != true {
+		t.Errorf("expected oversized flag, got %v", flag)
+	}
+
+	if batch := results[2].Value(); len(batch) != 1 || batch[0] != "cd" {
+		t.Errorf("expected trailing batch [cd], got %v", batch)
+	}
+}
+
+func TestByteBatcher_LatencyFlush(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	in := make(chan Result[string])
+	out := newStringByteBatcher(100, 50*time.Millisecond, clock).Process(ctx, in)
+
+	in <- NewSuccess("a")
+	in <- NewSuccess("b")
+
+	select {
+	case result := <-out:
+		t.Fatalf("unexpected early batch: %v", result)
+	default:
+	}
+
+	clock.Advance(50 * time.Millisecond)
+	clock.BlockUntilReady()
+
+	result := <-out
+	if batch := result.Value(); len(batch) != 2 || batch[0] != "a" || batch[1] != "b" {
+		t.Errorf("expected latency batch [a b], got %v", batch)
+	}
+
+	close(in)
+	if _, ok := <-out; ok {
+		t.Error("expected output to be closed")
+	}
+}
+
+func TestByteBatcher_ErrorsPassThrough(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[string], 3)
+	in <- NewSuccess("a")
+	in <- NewError("bad", errors.New("encode failed"), "encoder")
+	in <- NewSuccess("b")
+	close(in)
+
+	var results []Result[[]string]
+	for result := range newStringByteBatcher(10, 0, RealClock).Process(ctx, in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("expected error and one batch, got %d results", len(results))
+	}
+	if !results[0].IsError() || results[0].Error().Err.Error() != "encode failed" {
+		t.Errorf("expected pass-through error first, got %v", results[0])
+	}
+	if batch := results[1].Value(); len(batch) != 2 {
+		t.Errorf("expected errors not to break the batch, got %v", batch)
+	}
+}
@@ -0,0 +1,99 @@
+package streamz
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+)
+
+// CallbackSink hands each Result to a callback-based API at the edge of a
+// pipeline, converting Result[T] into the familiar (T, error) pair.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type CallbackSink[T any] struct {
+	name   string
+	fn     func(T, error)
+	panics atomic.Uint64
+}
+
+// NewCallbackSink creates a sink that invokes fn once per Result, in order.
+// Successes are passed as fn(value, nil) and errors as fn(zero, err), where err
+// is the Result's *StreamError. A panic in fn is recovered, logged, and counted
+// in PanicCount, and consumption continues with the next item.
+//
+// When to use:
+//   - Feeding results to an existing callback or handler interface
+//   - Bridging to SDKs that accept (value, error) completion functions
+//   - Terminating a pipeline without writing a drain loop
+//
+// Example:
+//
+//	sink := streamz.NewCallbackSink(func(o Order, err error) {
+//		if err != nil {
+//			metrics.Failed(err)
+//			return
+//		}
+//		client.Submit(o)
+//	})
+//
+//	if err := sink.Consume(ctx, orders); err != nil {
+//		log.Printf("pipeline canceled: %v", err)
+//	}
+//
+// Parameters:
+//   - fn: Callback receiving each value or error
+//
+// Returns a new CallbackSink.
+func NewCallbackSink[T any](fn func(T, error)) *CallbackSink[T] {
+	return &CallbackSink[T]{
+		name: "callback-sink",
+		fn:   fn,
+	}
+}
+
+// WithName sets a custom name for this sink.
+// If not set, defaults to "callback-sink".
+func (s *CallbackSink[T]) WithName(name string) *CallbackSink[T] {
+	s.name = name
+	return s
+}
+
+// PanicCount returns the number of callback invocations that panicked.
+// Safe to call concurrently with Consume.
+func (s *CallbackSink[T]) PanicCount() uint64 {
+	return s.panics.Load()
+}
+
+// Consume drains in, invoking the callback for every Result, and blocks until
+// the input closes or the context is canceled. It returns nil once the input has
+// been fully drained, or the context's error if consumption was canceled.
+func (s *CallbackSink[T]) Consume(ctx context.Context, in <-chan Result[T]) error {
+	for {
+		item, ok := receive(ctx, in)
+		if !ok {
+			return ctx.Err()
+		}
+
+		if item.IsError() {
+			s.invoke(*new(T), item.Error())
+		} else {
+			s.invoke(item.Value(), nil)
+		}
+	}
+}
+
+// invoke calls the callback with panic recovery.
+func (s *CallbackSink[T]) invoke(value T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.panics.Add(1)
+			log.Printf("CallbackSink[%s]: callback panicked: %v", s.name, r)
+		}
+	}()
+	s.fn(value, err)
+}
+
+// Name returns the sink name for debugging and monitoring.
+func (s *CallbackSink[T]) Name() string {
+	return s.name
+}
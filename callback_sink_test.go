@@ -0,0 +1,96 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type callbackCall struct {
+	value int
+	err   error
+}
+
+func TestCallbackSink_Name(t *testing.T) {
+	sink := NewCallbackSink(func(int, error) {})
+	if sink.Name() != "callback-sink" {
+		t.Errorf("expected name 'callback-sink', got %q", sink.Name())
+	}
+	if sink.WithName("submitter").Name() != "submitter" {
+		t.Errorf("expected name 'submitter', got %q", sink.Name())
+	}
+}
+
+func TestCallbackSink_InvokesOncePerItem(t *testing.T) {
+	errUpstream := errors.New("upstream failed")
+	in := make(chan Result[int], 3)
+	in <- NewSuccess(1)
+	in <- NewError(2, errUpstream, "parser")
+	in <- NewSuccess(3)
+	close(in)
+
+	var calls []callbackCall
+	sink := NewCallbackSink(func(v int, err error) {
+		calls = append(calls, callbackCall{value: v, err: err})
+	})
+
+	if err := sink.Consume(context.Background(), in); err != nil {
+		t.Fatalf("expected nil after input closed, got %v", err)
+	}
+
+	if len(calls) != 3 {
+		t.Fatalf("expected 3 callback invocations, got %d", len(calls))
+	}
+	if calls[0].value != 1 || calls[0].err != nil || calls[2].value != 3 || calls[2].err != nil {
+		t.Errorf("expected successes as (value, nil), got %+v", calls)
+	}
+	if calls[1].value != 0 || !errors.Is(calls[1].err, errUpstream) {
+		t.Errorf("expected error as (zero, err), got %+v", calls[1])
+	}
+}
+
+func TestCallbackSink_IsolatesPanics(t *testing.T) {
+	in := make(chan Result[int], 4)
+	for i := 1; i <= 4; i++ {
+		in <- NewSuccess(i)
+	}
+	close(in)
+
+	var seen []int
+	sink := NewCallbackSink(func(v int, _ error) {
+		if v%2 == 0 {
+			panic("handler bug")
+		}
+		seen = append(seen, v)
+	})
+
+	if err := sink.Consume(context.Background(), in); err != nil {
+		t.Fatalf("expected nil after input closed, got %v", err)
+	}
+	if len(seen) != 2 || seen[0] != 1 || seen[1] != 3 {
+		t.Errorf("expected consumption to continue past panics, got %v", seen)
+	}
+	if sink.PanicCount() != 2 {
+		t.Errorf("expected 2 panics counted, got %d", sink.PanicCount())
+	}
+}
+
+func TestCallbackSink_ReturnsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[int])
+	sink := NewCallbackSink(func(int, error) {})
+
+	done := make(chan error, 1)
+	go func() { done <- sink.Consume(ctx, in) }()
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected Consume to return after cancellation")
+	}
+}
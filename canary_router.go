@@ -0,0 +1,209 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	mathrand "math/rand/v2"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrInvalidWeights is returned by CanaryRouter.UpdateWeights for weights that
+// name unknown outputs, are negative or not finite, or sum to zero.
+var ErrInvalidWeights = errors.New("invalid canary weights")
+
+// CanaryRouter routes each item to one of several named outputs at random, with
+// probability proportional to each output's weight, such as 95% to "stable" and
+// 5% to "canary". It is the probabilistic counterpart to Switch, and weights can
+// be shifted while it runs to ramp a canary up or roll it back.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type CanaryRouter[T any] struct {
+	name       string
+	outputs    []string // sorted, fixing the order of the cumulative weights
+	seed       uint64
+	bufferSize int
+	mu         sync.RWMutex
+	cumulative []float64
+	routed     []atomic.Uint64
+}
+
+// NewCanaryRouter creates a router with one output per weights entry.
+// Each item, success or error, independently goes to output i with probability
+// weights[i] divided by the sum of all weights; an output with weight 0 receives
+// nothing until its weight is raised. Decisions come from a pseudo-random
+// generator seeded with seed, so a fixed seed reproduces the same routing for
+// the same input; use a random seed in production.
+//
+// When to use:
+//   - Sending a small share of traffic to a canary deployment
+//   - Gradually shifting load between implementations
+//   - Weighted A/B/n experiments
+//
+// Example:
+//
+//	router := streamz.NewCanaryRouter[Request](map[string]float64{
+//		"stable": 95,
+//		"canary": 5,
+//	}, uint64(time.Now().UnixNano()))
+//
+//	outputs := router.Process(ctx, requests)
+//	go serve(stableService, outputs["stable"])
+//	go serve(canaryService, outputs["canary"])
+//
+//	// Later: promote the canary to half of traffic
+//	router.UpdateWeights(map[string]float64{"stable": 50, "canary": 50})
+//
+// Parameters:
+//   - weights: Relative weight per output name (non-negative, at least one positive)
+//   - seed: Seed for the routing decisions
+//
+// Returns a new CanaryRouter processor.
+// Panics if weights is empty, contains a negative or non-finite weight, or sums to zero.
+func NewCanaryRouter[T any](weights map[string]float64, seed uint64) *CanaryRouter[T] {
+	outputs := slices.Sorted(maps.Keys(weights))
+	r := &CanaryRouter[T]{
+		name:    "canary-router",
+		outputs: outputs,
+		seed:    seed,
+		routed:  make([]atomic.Uint64, len(outputs)),
+	}
+
+	cumulative, err := r.cumulate(weights)
+	if err != nil {
+		panic(fmt.Sprintf("canary router: %v", err))
+	}
+	r.cumulative = cumulative
+	return r
+}
+
+// WithBufferSize sets the buffer size of each output channel.
+// If not set, defaults to 0 (unbuffered).
+func (r *CanaryRouter[T]) WithBufferSize(size int) *CanaryRouter[T] {
+	r.bufferSize = size
+	return r
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "canary-router".
+func (r *CanaryRouter[T]) WithName(name string) *CanaryRouter[T] {
+	r.name = name
+	return r
+}
+
+// UpdateWeights replaces the routing weights for all subsequent items. Outputs
+// missing from weights get weight 0; outputs cannot be added after construction.
+// Returns an error wrapping ErrInvalidWeights, leaving the current weights in
+// place, if weights names an unknown output, contains a negative or non-finite
+// weight, or sums to zero.
+// Safe to call concurrently with Process.
+func (r *CanaryRouter[T]) UpdateWeights(weights map[string]float64) error {
+	cumulative, err := r.cumulate(weights)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cumulative = cumulative
+	return nil
+}
+
+// RoutedCounts returns the number of items sent to each output so far.
+// Safe to call concurrently with Process.
+func (r *CanaryRouter[T]) RoutedCounts() map[string]uint64 {
+	counts := make(map[string]uint64, len(r.outputs))
+	for i, output := range r.outputs {
+		counts[output] = r.routed[i].Load()
+	}
+	return counts
+}
+
+// Process routes each item to a weighted random output and returns the outputs by name.
+// Every output channel must be consumed, since a blocked output holds back the
+// rest. All output channels close when the input closes or the context is canceled.
+func (r *CanaryRouter[T]) Process(ctx context.Context, in <-chan Result[T]) map[string]<-chan Result[T] {
+	channels := make([]chan Result[T], len(r.outputs))
+	outs := make(map[string]<-chan Result[T], len(r.outputs))
+	for i, output := range r.outputs {
+		channels[i] = make(chan Result[T], r.bufferSize)
+		outs[output] = channels[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, ch := range channels {
+				close(ch)
+			}
+		}()
+
+		random := mathrand.New(mathrand.NewPCG(r.seed, 0)).Float64 // #nosec G404 -- routing needs reproducibility, not secrecy
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			i := r.pick(random())
+			r.routed[i].Add(1)
+
+			select {
+			case channels[i] <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return outs
+}
+
+// pick maps a uniform value in [0, 1) to an output index by cumulative weight.
+func (r *CanaryRouter[T]) pick(u float64) int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	target := u * r.cumulative[len(r.cumulative)-1]
+	// The first output whose cumulative weight exceeds target; zero-weight outputs
+	// share their predecessor's cumulative weight and are never chosen
+	return sort.Search(len(r.cumulative), func(i int) bool {
+		return r.cumulative[i] > target
+	})
+}
+
+// cumulate validates weights and returns their running totals in output order.
+func (r *CanaryRouter[T]) cumulate(weights map[string]float64) ([]float64, error) {
+	if len(r.outputs) == 0 {
+		return nil, fmt.Errorf("%w: no outputs", ErrInvalidWeights)
+	}
+	for output, weight := range weights {
+		if _, known := slices.BinarySearch(r.outputs, output); !known {
+			return nil, fmt.Errorf("%w: unknown output %q", ErrInvalidWeights, output)
+		}
+		if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return nil, fmt.Errorf("%w: output %q has weight %v", ErrInvalidWeights, output, weight)
+		}
+	}
+
+	cumulative := make([]float64, len(r.outputs))
+	var total float64
+	for i, output := range r.outputs {
+		total += weights[output]
+		cumulative[i] = total
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("%w: weights sum to zero", ErrInvalidWeights)
+	}
+	return cumulative, nil
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (r *CanaryRouter[T]) Name() string {
+	return r.name
+}
@@ -0,0 +1,160 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+// routeN sends n items through a running router, returning the output each went to.
+func routeN(t *testing.T, in chan<- Result[int], outs map[string]<-chan Result[int], n int) []string {
+	t.Helper()
+	stable, canary := outs["stable"], outs["canary"]
+	routes := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		in <- NewSuccess(i)
+		select {
+		case <-stable:
+			routes = append(routes, "stable")
+		case <-canary:
+			routes = append(routes, "canary")
+		case <-time.After(time.Second):
+			t.Fatalf("timed out routing item %d", i)
+		}
+	}
+	return routes
+}
+
+// share returns the fraction of routes equal to output.
+func share(routes []string, output string) float64 {
+	count := 0
+	for _, r := range routes {
+		if r == output {
+			count++
+		}
+	}
+	return float64(count) / float64(len(routes))
+}
+
+func TestCanaryRouter_Name(t *testing.T) {
+	router := NewCanaryRouter[int](map[string]float64{"stable": 1}, 1)
+	if router.Name() != "canary-router" {
+		t.Errorf("expected name 'canary-router', got %q", router.Name())
+	}
+	if router.WithName("checkout-canary").Name() != "checkout-canary" {
+		t.Errorf("expected name 'checkout-canary', got %q", router.Name())
+	}
+}
+
+func TestCanaryRouter_PanicsOnInvalidWeights(t *testing.T) {
+	for _, weights := range []map[string]float64{
+		{},
+		{"stable": 0, "canary": 0},
+		{"stable": 95, "canary": -5},
+		{"stable": math.NaN()},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for weights %v", weights)
+				}
+			}()
+			NewCanaryRouter[int](weights, 1)
+		}()
+	}
+}
+
+func TestCanaryRouter_DistributionMatchesWeights(t *testing.T) {
+	const n = 20000
+	router := NewCanaryRouter[int](map[string]float64{"stable": 95, "canary": 5}, 42)
+	in := make(chan Result[int])
+	defer close(in)
+
+	routes := routeN(t, in, router.Process(context.Background(), in), n)
+	if got := share(routes, "canary"); math.Abs(got-0.05) > 0.01 {
+		t.Errorf("expected canary share near 5%%, got %.2f%%", got*100)
+	}
+
+	var canary uint64
+	for _, r := range routes {
+		if r == "canary" {
+			canary++
+		}
+	}
+	if counts := router.RoutedCounts(); counts["canary"] != canary || counts["stable"] != n-canary {
+		t.Errorf("expected routed counts to match observed routes, got %v", counts)
+	}
+}
+
+func TestCanaryRouter_SeedIsReproducible(t *testing.T) {
+	run := func() []string {
+		router := NewCanaryRouter[int](map[string]float64{"stable": 1, "canary": 1}, 7)
+		in := make(chan Result[int])
+		defer close(in)
+		return routeN(t, in, router.Process(context.Background(), in), 200)
+	}
+
+	first, second := run(), run()
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("expected identical routing for the same seed, diverged at item %d", i)
+		}
+	}
+}
+
+func TestCanaryRouter_UpdateWeightsShiftsRouting(t *testing.T) {
+	const n = 10000
+	router := NewCanaryRouter[int](map[string]float64{"stable": 95, "canary": 5}, 3)
+	in := make(chan Result[int])
+	defer close(in)
+	outs := router.Process(context.Background(), in)
+
+	if got := share(routeN(t, in, outs, n), "canary"); math.Abs(got-0.05) > 0.01 {
+		t.Fatalf("expected canary share near 5%% before update, got %.2f%%", got*100)
+	}
+
+	if err := router.UpdateWeights(map[string]float64{"stable": 1, "canary": 1}); err != nil {
+		t.Fatalf("unexpected error updating weights: %v", err)
+	}
+	if got := share(routeN(t, in, outs, n), "canary"); math.Abs(got-0.5) > 0.02 {
+		t.Errorf("expected canary share near 50%% after update, got %.2f%%", got*100)
+	}
+
+	// A missing output gets weight 0
+	if err := router.UpdateWeights(map[string]float64{"canary": 1}); err != nil {
+		t.Fatalf("unexpected error updating weights: %v", err)
+	}
+	if got := share(routeN(t, in, outs, 1000), "canary"); got != 1 {
+		t.Errorf("expected all traffic to canary, got %.2f%%", got*100)
+	}
+}
+
+func TestCanaryRouter_RejectsInvalidUpdates(t *testing.T) {
+	router := NewCanaryRouter[int](map[string]float64{"stable": 1, "canary": 0}, 1)
+
+	for _, weights := range []map[string]float64{
+		{"stable": 1, "shadow": 1},
+		{"stable": -1, "canary": 2},
+		{"stable": 0},
+	} {
+		if err := router.UpdateWeights(weights); !errors.Is(err, ErrInvalidWeights) {
+			t.Errorf("expected ErrInvalidWeights for %v, got %v", weights, err)
+		}
+	}
+
+	// The previous weights still apply, and errors are routed like any item
+	in := make(chan Result[int], 2)
+	in <- NewError(0, errors.New("bad"), "upstream")
+	in <- NewSuccess(1)
+	close(in)
+	outs := router.Process(context.Background(), in)
+	results := collectResults(outs["stable"], time.Second)
+	if len(results) != 2 || !results[0].IsError() || results[1].Value() != 1 {
+		t.Errorf("expected both items on stable, got %v", results)
+	}
+	if _, ok := <-outs["canary"]; ok {
+		t.Error("expected nothing on zero-weight canary")
+	}
+}
@@ -0,0 +1,196 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"math"
+	mathrand "math/rand/v2"
+	"sync/atomic"
+	"time"
+)
+
+// ErrChaosInjected is the error carried by failures injected by Chaos.
+var ErrChaosInjected = errors.New("chaos: injected failure")
+
+// LatencyDistribution maps a uniform random number in [0.0, 1.0) to a delay,
+// letting Chaos draw injected latency from any distribution.
+type LatencyDistribution func(r float64) time.Duration
+
+// FixedLatency returns a LatencyDistribution that always delays by d.
+func FixedLatency(d time.Duration) LatencyDistribution {
+	return func(float64) time.Duration { return d }
+}
+
+// UniformLatency returns a LatencyDistribution uniform over [minDelay, maxDelay).
+func UniformLatency(minDelay, maxDelay time.Duration) LatencyDistribution {
+	return func(r float64) time.Duration {
+		return minDelay + time.Duration(r*float64(maxDelay-minDelay))
+	}
+}
+
+// ChaosConfig sets the probability of each kind of fault injected by Chaos.
+// Every rate must be between 0.0 and 1.0; the zero value injects nothing.
+type ChaosConfig struct {
+	// ErrorRate is the probability that a successful item is turned into an
+	// error Result carrying ErrChaosInjected.
+	ErrorRate float64
+
+	// DropRate is the probability that an item, success or error, is dropped.
+	DropRate float64
+
+	// LatencyRate is the probability that an item is delayed before emission.
+	LatencyRate float64
+
+	// Latency draws the delay for items selected by LatencyRate.
+	// Nil disables latency injection.
+	Latency LatencyDistribution
+}
+
+// ChaosStats counts the faults injected by Chaos.
+type ChaosStats struct {
+	Dropped uint64 // Items dropped
+	Errors  uint64 // Successes turned into errors
+	Delayed uint64 // Items delayed before emission
+}
+
+// Chaos deliberately injects drops, errors, and latency into a stream so that
+// resilience stages such as DLQ, retry, and circuit breaking can be exercised
+// under controlled fault conditions. It is intended for tests and staging
+// environments, not production traffic.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Chaos[T any] struct {
+	name          string
+	config        ChaosConfig
+	clock         Clock
+	seed          uint64
+	deterministic bool
+
+	dropped  atomic.Uint64
+	injected atomic.Uint64
+	delayed  atomic.Uint64
+}
+
+// NewChaos creates a processor that injects faults at the configured rates.
+// For each item it decides, in order, whether to drop it, whether to turn a
+// success into an error, and whether to delay it. Items that escape every fault
+// pass through unchanged, metadata included.
+//
+// When to use:
+//   - Verifying DLQ, retry, and fallback paths in integration tests
+//   - Rehearsing incident behavior in staging pipelines
+//   - Measuring how latency spikes propagate through downstream stages
+//
+// Example:
+//
+//	// 5% failures, 1% drops, and a quarter of items delayed 10-200ms
+//	chaos := streamz.NewChaos[Order](streamz.ChaosConfig{
+//		ErrorRate:   0.05,
+//		DropRate:    0.01,
+//		LatencyRate: 0.25,
+//		Latency:     streamz.UniformLatency(10*time.Millisecond, 200*time.Millisecond),
+//	}, streamz.RealClock).WithSeed(42)
+//
+//	results := dlq.Process(ctx, chaos.Process(ctx, orders))
+//
+// Parameters:
+//   - config: Fault injection rates and latency distribution
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new Chaos processor.
+// Panics if any rate is outside the valid range [0.0, 1.0].
+func NewChaos[T any](config ChaosConfig, clock Clock) *Chaos[T] {
+	for _, rate := range []float64{config.ErrorRate, config.DropRate, config.LatencyRate} {
+		if rate < 0.0 || rate > 1.0 || math.IsNaN(rate) {
+			panic("chaos rates must be between 0.0 and 1.0")
+		}
+	}
+
+	return &Chaos[T]{
+		name:   "chaos",
+		config: config,
+		clock:  clock,
+	}
+}
+
+// WithSeed makes fault injection reproducible: each Process call draws from a
+// pseudo-random generator seeded with seed instead of crypto/rand.
+func (c *Chaos[T]) WithSeed(seed uint64) *Chaos[T] {
+	c.seed = seed
+	c.deterministic = true
+	return c
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "chaos".
+func (c *Chaos[T]) WithName(name string) *Chaos[T] {
+	c.name = name
+	return c
+}
+
+// Stats returns a snapshot of the injected fault counters.
+// Safe to call concurrently with Process.
+func (c *Chaos[T]) Stats() ChaosStats {
+	return ChaosStats{
+		Dropped: c.dropped.Load(),
+		Errors:  c.injected.Load(),
+		Delayed: c.delayed.Load(),
+	}
+}
+
+// Process forwards items, injecting faults at the configured rates.
+func (c *Chaos[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		random := cryptoFloat64
+		if c.deterministic {
+			random = mathrand.New(mathrand.NewPCG(c.seed, 0)).Float64 // #nosec G404 -- reproducible fault injection requested via WithSeed
+		}
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if c.config.DropRate > 0 && random() < c.config.DropRate {
+				c.dropped.Add(1)
+				continue
+			}
+
+			if item.IsSuccess() && c.config.ErrorRate > 0 && random() < c.config.ErrorRate {
+				c.injected.Add(1)
+				item = Result[T]{err: NewStreamError(item.Value(), ErrChaosInjected, c.name), metadata: item.metadata}
+			}
+
+			if c.config.Latency != nil && c.config.LatencyRate > 0 && random() < c.config.LatencyRate {
+				c.delayed.Add(1)
+				if delay := c.config.Latency(random()); delay > 0 {
+					timer := c.clock.NewTimer(delay)
+					select {
+					case <-timer.C():
+					case <-ctx.Done():
+						timer.Stop()
+						return
+					}
+				}
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (c *Chaos[T]) Name() string {
+	return c.name
+}
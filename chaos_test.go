@@ -0,0 +1,161 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+// runChaos feeds n successes through chaos and returns the outputs.
+func runChaos(chaos *Chaos[int], n int) []Result[int] {
+	in := make(chan Result[int], 64)
+	go func() {
+		defer close(in)
+		for i := 0; i < n; i++ {
+			in <- NewSuccess(i)
+		}
+	}()
+
+	var results []Result[int]
+	for result := range chaos.Process(context.Background(), in) {
+		results = append(results, result)
+	}
+	return results
+}
+
+func TestChaos_Name(t *testing.T) {
+	chaos := NewChaos[int](ChaosConfig{}, RealClock)
+	if chaos.Name() != "chaos" {
+		t.Errorf("expected name 'chaos', got %q", chaos.Name())
+	}
+	if chaos.WithName("fault-injector").Name() != "fault-injector" {
+		t.Errorf("expected name 'fault-injector', got %q", chaos.Name())
+	}
+}
+
+func TestChaos_InvalidRate(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic for invalid error rate")
+		}
+	}()
+	NewChaos[int](ChaosConfig{ErrorRate: 1.5}, RealClock)
+}
+
+func TestChaos_ZeroRatesPassThrough(t *testing.T) {
+	in := make(chan Result[int], 3)
+	in <- NewSuccess(1).WithMetadata(MetadataSource, "api")
+	in <- NewError(2, errors.New("upstream"), "parser")
+	in <- NewSuccess(3)
+	close(in)
+
+	chaos := NewChaos[int](ChaosConfig{Latency: FixedLatency(time.Hour)}, clockz.NewFakeClock())
+	var results []Result[int]
+	for result := range chaos.Process(context.Background(), in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 3 || results[0].Value() != 1 || !results[1].IsError() || results[2].Value() != 3 {
+		t.Fatalf("expected items unchanged, got %v", results)
+	}
+	if source, _, _ := results[0].GetStringMetadata(MetadataSource); source != "api" {
+		t.Errorf("expected metadata preserved, got %q", source)
+	}
+	if stats := chaos.Stats(); stats != (ChaosStats{}) {
+		t.Errorf("expected no injected faults, got %+v", stats)
+	}
+}
+
+func TestChaos_SeededRates(t *testing.T) {
+	const n = 10000
+	config := ChaosConfig{ErrorRate: 0.1, DropRate: 0.2}
+	chaos := NewChaos[int](config, clockz.NewFakeClock()).WithSeed(7)
+	results := runChaos(chaos, n)
+
+	injected := 0
+	for _, result := range results {
+		if result.IsError() {
+			if !errors.Is(result.Error(), ErrChaosInjected) || result.Error().ProcessorName != "chaos" {
+				t.Fatalf("expected injected chaos error, got %v", result.Error())
+			}
+			injected++
+		}
+	}
+
+	stats := chaos.Stats()
+	if len(results)+int(stats.Dropped) != n {
+		t.Errorf("expected emitted plus dropped to equal %d, got %d + %d", n, len(results), stats.Dropped)
+	}
+	if stats.Dropped < 1800 || stats.Dropped > 2200 {
+		t.Errorf("expected ~20%% dropped, got %d of %d", stats.Dropped, n)
+	}
+	// Errors are injected into the ~8000 items that were not dropped
+	if injected < 700 || injected > 900 || uint64(injected) != stats.Errors {
+		t.Errorf("expected ~800 injected errors matching stats, got %d (stats %d)", injected, stats.Errors)
+	}
+
+	// The same seed reproduces the same faults
+	again := runChaos(NewChaos[int](config, clockz.NewFakeClock()).WithSeed(7), n)
+	if len(again) != len(results) {
+		t.Fatalf("expected reproducible output, got %d then %d items", len(results), len(again))
+	}
+	for i := range results {
+		if results[i].IsError() != again[i].IsError() {
+			t.Fatalf("expected identical fault at %d", i)
+		}
+		if results[i].IsSuccess() && results[i].Value() != again[i].Value() {
+			t.Fatalf("expected identical item at %d, got %d and %d", i, results[i].Value(), again[i].Value())
+		}
+	}
+}
+
+func TestChaos_LatencyUsesClock(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	chaos := NewChaos[int](ChaosConfig{
+		LatencyRate: 1,
+		Latency:     FixedLatency(100 * time.Millisecond),
+	}, clock)
+
+	in := make(chan Result[int])
+	out := chaos.Process(ctx, in)
+
+	in <- NewSuccess(1)
+	waitForTimer(t, clock)
+
+	clock.Advance(99 * time.Millisecond)
+	select {
+	case result := <-out:
+		t.Fatalf("expected item to be delayed, got %v", result)
+	default:
+	}
+
+	clock.Advance(time.Millisecond)
+	clock.BlockUntilReady()
+	select {
+	case result := <-out:
+		if result.Value() != 1 {
+			t.Errorf("expected delayed item 1, got %v", result)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected item after the injected latency")
+	}
+	close(in)
+
+	if stats := chaos.Stats(); stats.Delayed != 1 {
+		t.Errorf("expected 1 delayed item, got %d", stats.Delayed)
+	}
+}
+
+func TestChaos_UniformLatency(t *testing.T) {
+	latency := UniformLatency(10*time.Millisecond, 20*time.Millisecond)
+	if d := latency(0); d != 10*time.Millisecond {
+		t.Errorf("expected minimum delay at 0, got %v", d)
+	}
+	if d := latency(0.5); d != 15*time.Millisecond {
+		t.Errorf("expected midpoint delay at 0.5, got %v", d)
+	}
+}
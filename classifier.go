@@ -0,0 +1,147 @@
+package streamz
+
+import (
+	"context"
+	"slices"
+)
+
+// MetadataLabels holds the labels a Classifier attached to an item.
+const MetadataLabels = "labels" // []string - matching rule labels in rule order, or the default label
+
+// ClassifyRule labels the items its matcher accepts.
+type ClassifyRule[T any] struct {
+	Label string
+	Match func(T) bool
+}
+
+// Classifier labels items by evaluating an ordered list of rules, turning ad hoc
+// pattern matching into a reusable step whose output downstream stages can route
+// or filter on.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Classifier[T any] struct {
+	name         string
+	rules        []ClassifyRule[T]
+	allMatches   bool
+	defaultLabel string
+}
+
+// NewClassifier creates a processor that attaches rule labels to successful items.
+// Rules are evaluated in order. By default the label of the first matching rule is
+// attached; with WithAllMatches every matching label is, in rule order. Items no
+// rule matches get the default label, "unclassified" unless set with
+// WithDefaultLabel. Labels are stored as a []string under MetadataLabels, and a
+// matcher that panics is treated as not matching. Errors pass through unchanged.
+//
+// When to use:
+//   - Tagging security events with the attack patterns they resemble
+//   - Assigning support tickets or log lines to categories
+//   - Labeling items once so several routers can share the decision
+//
+// Example:
+//
+//	classifier := streamz.NewClassifier([]streamz.ClassifyRule[Request]{
+//		{Label: "sql-injection", Match: func(r Request) bool { return sqlPattern.MatchString(r.Query) }},
+//		{Label: "path-traversal", Match: func(r Request) bool { return strings.Contains(r.Path, "../") }},
+//	}).WithAllMatches().WithDefaultLabel("clean")
+//
+//	for result := range classifier.Process(ctx, requests) {
+//		if !streamz.HasLabel(result, "clean") {
+//			flag(result)
+//		}
+//	}
+//
+// Parameters:
+//   - rules: Label rules in priority order
+//
+// Returns a new Classifier processor.
+func NewClassifier[T any](rules []ClassifyRule[T]) *Classifier[T] {
+	return &Classifier[T]{
+		name:         "classifier",
+		rules:        slices.Clone(rules),
+		defaultLabel: "unclassified",
+	}
+}
+
+// WithAllMatches attaches the labels of every matching rule rather than only the first.
+func (c *Classifier[T]) WithAllMatches() *Classifier[T] {
+	c.allMatches = true
+	return c
+}
+
+// WithDefaultLabel sets the label attached to items no rule matches.
+// If not set, defaults to "unclassified".
+func (c *Classifier[T]) WithDefaultLabel(label string) *Classifier[T] {
+	c.defaultLabel = label
+	return c
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "classifier".
+func (c *Classifier[T]) WithName(name string) *Classifier[T] {
+	c.name = name
+	return c
+}
+
+// Process labels each successful item and forwards it.
+func (c *Classifier[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsSuccess() {
+				item = item.WithMetadata(MetadataLabels, c.classify(item.Value()))
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// classify returns the labels of the rules value matches, or the default label.
+func (c *Classifier[T]) classify(value T) []string {
+	var labels []string
+	for _, rule := range c.rules {
+		if !safeMatch(rule.Match, value) {
+			continue
+		}
+		labels = append(labels, rule.Label)
+		if !c.allMatches {
+			break
+		}
+	}
+	if len(labels) == 0 {
+		return []string{c.defaultLabel}
+	}
+	return labels
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (c *Classifier[T]) Name() string {
+	return c.name
+}
+
+// Labels returns the labels a Classifier attached to a Result, or nil if it has none.
+func Labels[T any](r Result[T]) []string {
+	raw, _ := r.GetMetadata(MetadataLabels)
+	labels, _ := raw.([]string)
+	return labels
+}
+
+// HasLabel reports whether a Classifier attached label to a Result.
+func HasLabel[T any](r Result[T], label string) bool {
+	return slices.Contains(Labels(r), label)
+}
@@ -0,0 +1,96 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// requestRules overlap: "../etc/passwd" is both traversal and sensitive.
+func requestRules() []ClassifyRule[string] {
+	return []ClassifyRule[string]{
+		{Label: "traversal", Match: func(s string) bool { return strings.Contains(s, "../") }},
+		{Label: "sensitive", Match: func(s string) bool { return strings.Contains(s, "passwd") }},
+		{Label: "broken", Match: func(string) bool { panic("bad rule") }},
+		{Label: "admin", Match: func(s string) bool { return strings.HasPrefix(s, "/admin") }},
+	}
+}
+
+// classifyAll runs paths through classifier and returns the labels of each result.
+func classifyAll(t *testing.T, classifier *Classifier[string], paths ...string) [][]string {
+	t.Helper()
+	in := make(chan Result[string], len(paths))
+	for _, p := range paths {
+		in <- NewSuccess(p)
+	}
+	close(in)
+
+	results := collectResults(classifier.Process(context.Background(), in), time.Second)
+	if len(results) != len(paths) {
+		t.Fatalf("expected %d results, got %d", len(paths), len(results))
+	}
+	labels := make([][]string, len(results))
+	for i, r := range results {
+		labels[i] = Labels(r)
+	}
+	return labels
+}
+
+func TestClassifier_Name(t *testing.T) {
+	classifier := NewClassifier(requestRules())
+	if classifier.Name() != "classifier" {
+		t.Errorf("expected name 'classifier', got %q", classifier.Name())
+	}
+	if classifier.WithName("threat-labels").Name() != "threat-labels" {
+		t.Errorf("expected name 'threat-labels', got %q", classifier.Name())
+	}
+}
+
+func TestClassifier_FirstMatch(t *testing.T) {
+	labels := classifyAll(t, NewClassifier(requestRules()),
+		"/files/../etc/passwd", "/etc/passwd", "/admin/users", "/home")
+
+	want := [][]string{{"traversal"}, {"sensitive"}, {"admin"}, {"unclassified"}}
+	for i := range want {
+		if !slices.Equal(labels[i], want[i]) {
+			t.Errorf("item %d: expected %v, got %v", i, want[i], labels[i])
+		}
+	}
+}
+
+func TestClassifier_AllMatches(t *testing.T) {
+	classifier := NewClassifier(requestRules()).WithAllMatches().WithDefaultLabel("clean")
+	labels := classifyAll(t, classifier,
+		"/admin/../etc/passwd", "/files/../etc/passwd", "/home")
+
+	want := [][]string{{"traversal", "sensitive", "admin"}, {"traversal", "sensitive"}, {"clean"}}
+	for i := range want {
+		if !slices.Equal(labels[i], want[i]) {
+			t.Errorf("item %d: expected %v, got %v", i, want[i], labels[i])
+		}
+	}
+}
+
+func TestClassifier_ErrorsPassThrough(t *testing.T) {
+	in := make(chan Result[string], 2)
+	in <- NewError("/x", errors.New("bad"), "upstream")
+	in <- NewSuccess("/admin").WithMetadata(MetadataSource, "edge")
+	close(in)
+
+	results := collectResults(NewClassifier(requestRules()).Process(context.Background(), in), time.Second)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if !results[0].IsError() || results[0].HasMetadata() {
+		t.Errorf("expected error passed through unlabeled, got %v", results[0])
+	}
+	if !HasLabel(results[1], "admin") || HasLabel(results[1], "unclassified") {
+		t.Errorf("expected admin label, got %v", Labels(results[1]))
+	}
+	if source, _, _ := results[1].GetStringMetadata(MetadataSource); source != "edge" {
+		t.Errorf("expected existing metadata preserved, got %q", source)
+	}
+}
@@ -0,0 +1,184 @@
+package streamz
+
+import (
+	"context"
+)
+
+// MetadataCollector aggregates Results into groups keyed by an arbitrary value
+// derived from each Result, typically one of its metadata entries. It generalizes
+// WindowCollector, which only groups by exact window boundaries, to any grouping
+// such as batch identifiers, correlation IDs, or tenant keys.
+//
+// A group is emitted as soon as the flush function reports its key as complete.
+// Groups that are still open when the input closes or the context is canceled
+// are emitted in the order their keys were first seen.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type MetadataCollector[T any, K comparable] struct {
+	name  string
+	keyFn func(Result[T]) K
+	flush func(K) bool
+}
+
+// MetadataCollection represents aggregated results sharing a single group key.
+type MetadataCollection[T any, K comparable] struct {
+	Key     K
+	Results []Result[T]
+}
+
+// NewMetadataCollector creates a collector that groups Results by a derived key.
+// The keyFn is called once per Result to determine its group. After the Result
+// has been added, flush is called with the group key; returning true emits the
+// group and starts a fresh one for subsequent Results with the same key.
+//
+// Both functions are called from a single goroutine, so flush may rely on state
+// updated by keyFn (for example, a marker seen while extracting the key).
+//
+// When to use:
+//   - Joining Results produced by different processors under a shared ID
+//   - Collecting all items of a logical batch before committing it
+//   - Grouping by metadata that is not a time window
+//
+// Example:
+//
+//	// Group by batch ID and flush once 100 items have arrived for a batch
+//	counts := make(map[string]int)
+//	collector := streamz.NewMetadataCollector(
+//		func(result streamz.Result[Event]) string {
+//			id, _, _ := result.GetStringMetadata("batch_id")
+//			counts[id]++
+//			return id
+//		},
+//		func(id string) bool {
+//			return counts[id] >= 100
+//		},
+//	)
+//
+//	for collection := range collector.Process(ctx, events) {
+//		commitBatch(collection.Key, collection.Values())
+//	}
+//
+// Parameters:
+//   - keyFn: Extracts the group key from each Result (success or error)
+//   - flush: Reports whether the group for a key is complete
+//
+// Returns a new MetadataCollector.
+func NewMetadataCollector[T any, K comparable](keyFn func(Result[T]) K, flush func(K) bool) *MetadataCollector[T, K] {
+	return &MetadataCollector[T, K]{
+		name:  "metadata-collector",
+		keyFn: keyFn,
+		flush: flush,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "metadata-collector".
+func (c *MetadataCollector[T, K]) WithName(name string) *MetadataCollector[T, K] {
+	c.name = name
+	return c
+}
+
+// Process groups Results by key and emits each group once flush reports it complete.
+// Remaining groups are emitted in first-seen order when the input closes or the
+// context is canceled; after cancellation they are delivered regardless, so the
+// consumer must drain the output.
+func (c *MetadataCollector[T, K]) Process(ctx context.Context, in <-chan Result[T]) <-chan MetadataCollection[T, K] {
+	out := make(chan MetadataCollection[T, K])
+
+	go func() {
+		defer close(out)
+
+		groups := make(map[K][]Result[T])
+		var order []K
+
+		for {
+			select {
+			case <-ctx.Done():
+				// Flush open groups with a background context to ensure delivery
+				c.emitAll(context.Background(), out, groups, order)
+				return
+
+			case result, ok := <-in:
+				if !ok {
+					c.emitAll(ctx, out, groups, order)
+					return
+				}
+
+				key := c.keyFn(result)
+				if _, exists := groups[key]; !exists {
+					order = append(order, key)
+				}
+				groups[key] = append(groups[key], result)
+
+				if !c.flush(key) {
+					continue
+				}
+
+				collection := MetadataCollection[T, K]{Key: key, Results: groups[key]}
+				delete(groups, key)
+				order = removeKey(order, key)
+
+				select {
+				case out <- collection:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// emitAll emits every open group in first-seen order.
+func (*MetadataCollector[T, K]) emitAll(ctx context.Context, out chan<- MetadataCollection[T, K], groups map[K][]Result[T], order []K) {
+	for _, key := range order {
+		select {
+		case out <- MetadataCollection[T, K]{Key: key, Results: groups[key]}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (c *MetadataCollector[T, K]) Name() string {
+	return c.name
+}
+
+// removeKey returns keys without the first occurrence of key.
+func removeKey[K comparable](keys []K, key K) []K {
+	for i, k := range keys {
+		if k == key {
+			return append(keys[:i], keys[i+1:]...)
+		}
+	}
+	return keys
+}
+
+// Values returns all successful values from the collection.
+func (mc MetadataCollection[T, K]) Values() []T {
+	var values []T
+	for _, result := range mc.Results {
+		if result.IsSuccess() {
+			values = append(values, result.Value())
+		}
+	}
+	return values
+}
+
+// Errors returns all errors from the collection.
+func (mc MetadataCollection[T, K]) Errors() []*StreamError[T] {
+	var errors []*StreamError[T]
+	for _, result := range mc.Results {
+		if result.IsError() {
+			errors = append(errors, result.Error())
+		}
+	}
+	return errors
+}
+
+// Count returns the total number of results in the collection.
+func (mc MetadataCollection[T, K]) Count() int {
+	return len(mc.Results)
+}
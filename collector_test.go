@@ -0,0 +1,210 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+const testMetadataBatchID = "batch_id"
+
+// batchItem builds a Result tagged with a batch_id metadata entry.
+func batchItem(batchID string, value int) Result[int] {
+	return NewSuccess(value).WithMetadata(testMetadataBatchID, batchID)
+}
+
+// batchMarker builds an end-of-batch sentinel for the given batch.
+func batchMarker(batchID string) Result[int] {
+	return NewSuccess(-1).
+		WithMetadata(testMetadataBatchID, batchID).
+		WithMetadata("end_of_batch", true)
+}
+
+// newBatchCollector groups by batch_id and flushes when the sentinel arrives.
+func newBatchCollector() *MetadataCollector[int, string] {
+	complete := make(map[string]bool)
+	return NewMetadataCollector(
+		func(result Result[int]) string {
+			id, _, _ := result.GetStringMetadata(testMetadataBatchID) //nolint:errcheck // test helper
+			if _, ok := result.GetMetadata("end_of_batch"); ok {
+				complete[id] = true
+			}
+			return id
+		},
+		func(id string) bool {
+			if complete[id] {
+				delete(complete, id)
+				return true
+			}
+			return false
+		},
+	)
+}
+
+func TestMetadataCollector_Name(t *testing.T) {
+	collector := newBatchCollector()
+	if collector.Name() != "metadata-collector" {
+		t.Errorf("expected name 'metadata-collector', got %q", collector.Name())
+	}
+
+	collector.WithName("batches")
+	if collector.Name() != "batches" {
+		t.Errorf("expected name 'batches', got %q", collector.Name())
+	}
+}
+
+func TestMetadataCollector_GroupsByBatchID(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int])
+	out := newBatchCollector().Process(ctx, in)
+
+	// Interleave two batches
+	in <- batchItem("a", 1)
+	in <- batchItem("b", 10)
+	in <- batchItem("a", 2)
+	in <- batchItem("b", 20)
+
+	// Nothing is emitted until a batch is complete
+	select {
+	case collection := <-out:
+		t.Fatalf("unexpected early emission for key %q", collection.Key)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	in <- batchMarker("b")
+	select {
+	case collection := <-out:
+		if collection.Key != "b" {
+			t.Fatalf("expected batch 'b', got %q", collection.Key)
+		}
+		values := collection.Values()
+		if len(values) != 3 || values[0] != 10 || values[1] != 20 || values[2] != -1 {
+			t.Errorf("expected [10 20 -1], got %v", values)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("timeout waiting for batch 'b'")
+	}
+
+	in <- batchItem("a", 3)
+	in <- batchMarker("a")
+	select {
+	case collection := <-out:
+		if collection.Key != "a" {
+			t.Fatalf("expected batch 'a', got %q", collection.Key)
+		}
+		if collection.Count() != 4 {
+			t.Errorf("expected 4 results in batch 'a', got %d", collection.Count())
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("timeout waiting for batch 'a'")
+	}
+
+	close(in)
+	if _, ok := <-out; ok {
+		t.Error("expected output to be closed")
+	}
+}
+
+func TestMetadataCollector_ReusedKeyStartsNewGroup(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int], 10)
+	out := newBatchCollector().Process(ctx, in)
+
+	in <- batchItem("a", 1)
+	in <- batchMarker("a")
+	in <- batchItem("a", 2)
+	close(in)
+
+	var collections []MetadataCollection[int, string]
+	for collection := range out {
+		collections = append(collections, collection)
+	}
+
+	if len(collections) != 2 {
+		t.Fatalf("expected 2 collections, got %d", len(collections))
+	}
+	if collections[0].Count() != 2 {
+		t.Errorf("expected first group of 2, got %d", collections[0].Count())
+	}
+	if collections[1].Count() != 1 || collections[1].Values()[0] != 2 {
+		t.Errorf("expected second group [2], got %v", collections[1].Values())
+	}
+}
+
+func TestMetadataCollector_FlushesOpenGroupsOnClose(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int], 10)
+	out := newBatchCollector().Process(ctx, in)
+
+	in <- batchItem("c", 1)
+	in <- batchItem("a", 2)
+	in <- NewError(3, errors.New("failed"), "upstream").WithMetadata(testMetadataBatchID, "b")
+	in <- batchItem("a", 4)
+	close(in)
+
+	var keys []string
+	for collection := range out {
+		keys = append(keys, collection.Key)
+		if collection.Key == "b" {
+			if len(collection.Errors()) != 1 || len(collection.Values()) != 0 {
+				t.Errorf("expected one error in batch 'b', got %d errors and %d values",
+					len(collection.Errors()), len(collection.Values()))
+			}
+		}
+	}
+
+	expected := []string{"c", "a", "b"}
+	if len(keys) != len(expected) {
+		t.Fatalf("expected keys %v, got %v", expected, keys)
+	}
+	for i := range expected {
+		if keys[i] != expected[i] {
+			t.Errorf("expected keys in first-seen order %v, got %v", expected, keys)
+			break
+		}
+	}
+}
+
+func TestMetadataCollector_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[int])
+	out := newBatchCollector().Process(ctx, in)
+
+	cancel()
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Error("expected no collections after cancellation")
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("output didn't close promptly after cancellation")
+	}
+}
+
+func TestMetadataCollector_FlushesOpenGroupsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[int])
+	out := newBatchCollector().Process(ctx, in)
+
+	// Unbuffered sends return once the collector has taken each item
+	in <- batchItem("a", 1)
+	in <- batchItem("b", 2)
+	in <- batchItem("a", 3)
+	cancel()
+
+	var keys []string
+	var total int
+	for collection := range out {
+		keys = append(keys, collection.Key)
+		total += len(collection.Results)
+	}
+
+	if len(keys) != 2 || keys[0] != "a" || keys[1] != "b" {
+		t.Errorf("expected open groups [a b] flushed on cancel, got %v", keys)
+	}
+	if total != 3 {
+		t.Errorf("expected all 3 items flushed, got %d", total)
+	}
+}
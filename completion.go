@@ -0,0 +1,27 @@
+package streamz
+
+import "context"
+
+// Completion statuses reported to OnComplete callbacks and by Summary.
+const (
+	CompletionStatusCompleted = "completed" // Input closed and every item was forwarded
+	CompletionStatusCanceled  = "canceled"  // Context canceled before processing finished
+)
+
+// notifyComplete reports the end of processing to an OnComplete callback if registered.
+// Processors defer it after deferring close(out), so it runs once the last item
+// has been forwarded and before the output closes.
+func notifyComplete(ctx context.Context, fn func(status string)) {
+	if fn == nil {
+		return
+	}
+	fn(completionStatus(ctx))
+}
+
+// completionStatus reports whether processing under ctx ended by cancellation.
+func completionStatus(ctx context.Context) string {
+	if ctx.Err() != nil {
+		return CompletionStatusCanceled
+	}
+	return CompletionStatusCompleted
+}
@@ -0,0 +1,203 @@
+package streamz
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+)
+
+// MetadataCompressed marks a []byte payload compressed by the Compress processor.
+const MetadataCompressed = "compressed" // bool - payload is compressed
+
+// Codec compresses and decompresses byte payloads.
+// Implementations must be safe for sequential reuse by a single processor.
+type Codec interface {
+	Compress(data []byte) ([]byte, error)
+	Decompress(data []byte) ([]byte, error)
+}
+
+// GzipCodec implements Codec using gzip.
+// Level selects the gzip compression level; the zero value uses gzip.DefaultCompression.
+type GzipCodec struct {
+	Level int
+}
+
+// Compress gzips data at the configured level.
+func (c GzipCodec) Compress(data []byte) ([]byte, error) {
+	level := c.Level
+	if level == 0 {
+		level = gzip.DefaultCompression
+	}
+
+	var buf bytes.Buffer
+	w, err := gzip.NewWriterLevel(&buf, level)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decompress reverses Compress.
+func (GzipCodec) Decompress(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close() //nolint:errcheck // reader close cannot lose data
+	return io.ReadAll(r)
+}
+
+// Compress compresses successful []byte payloads with a Codec and marks them
+// with MetadataCompressed so a downstream Decompress knows to reverse it.
+type Compress struct {
+	name  string
+	codec Codec
+}
+
+// NewCompress creates a processor that compresses []byte payloads.
+// Payloads already marked as compressed are forwarded untouched.
+// Errors pass through unchanged; a codec failure is emitted as an error carrying the original payload.
+//
+// When to use:
+//   - Reducing memory held by buffers under backpressure
+//   - Shrinking payloads before network transport or disk spooling
+//   - Pairing with Decompress at the other end of a transport
+//
+// Example:
+//
+//	compressed := streamz.NewCompress(streamz.GzipCodec{Level: gzip.BestSpeed}).Process(ctx, payloads)
+//	buffered := streamz.NewBuffer[[]byte](10000).Process(ctx, compressed)
+//	restored := streamz.NewDecompress(streamz.GzipCodec{}).Process(ctx, buffered)
+//
+// Parameters:
+//   - codec: Compression codec (e.g. GzipCodec)
+//
+// Returns a new Compress processor.
+func NewCompress(codec Codec) *Compress {
+	return &Compress{
+		name:  "compress",
+		codec: codec,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "compress".
+func (c *Compress) WithName(name string) *Compress {
+	c.name = name
+	return c
+}
+
+// Process compresses each successful payload and stamps MetadataCompressed.
+// Existing metadata is preserved.
+func (c *Compress) Process(ctx context.Context, in <-chan Result[[]byte]) <-chan Result[[]byte] {
+	return processPayloads(ctx, in, func(item Result[[]byte]) Result[[]byte] {
+		if item.IsError() || isCompressed(item) {
+			return item
+		}
+
+		data, err := c.codec.Compress(item.Value())
+		if err != nil {
+			return Result[[]byte]{err: NewStreamError(item.Value(), err, c.name), metadata: item.metadata}
+		}
+		return Result[[]byte]{value: data, metadata: item.metadata}.WithMetadata(MetadataCompressed, true)
+	})
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (c *Compress) Name() string {
+	return c.name
+}
+
+// Decompress reverses Compress for payloads marked with MetadataCompressed.
+type Decompress struct {
+	name  string
+	codec Codec
+}
+
+// NewDecompress creates a processor that decompresses []byte payloads.
+// Only payloads marked compressed are decoded; all others are forwarded untouched.
+// Errors pass through unchanged; a codec failure is emitted as an error carrying the compressed payload.
+//
+// Example:
+//
+//	restored := streamz.NewDecompress(streamz.GzipCodec{}).Process(ctx, received)
+//
+// Parameters:
+//   - codec: Compression codec matching the one used by Compress
+//
+// Returns a new Decompress processor.
+func NewDecompress(codec Codec) *Decompress {
+	return &Decompress{
+		name:  "decompress",
+		codec: codec,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "decompress".
+func (d *Decompress) WithName(name string) *Decompress {
+	d.name = name
+	return d
+}
+
+// Process decompresses payloads marked compressed and clears the flag.
+// Existing metadata is preserved.
+func (d *Decompress) Process(ctx context.Context, in <-chan Result[[]byte]) <-chan Result[[]byte] {
+	return processPayloads(ctx, in, func(item Result[[]byte]) Result[[]byte] {
+		if item.IsError() || !isCompressed(item) {
+			return item
+		}
+
+		data, err := d.codec.Decompress(item.Value())
+		if err != nil {
+			return Result[[]byte]{err: NewStreamError(item.Value(), err, d.name), metadata: item.metadata}
+		}
+		return Result[[]byte]{value: data, metadata: item.metadata}.WithMetadata(MetadataCompressed, false)
+	})
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (d *Decompress) Name() string {
+	return d.name
+}
+
+// isCompressed reports whether a payload carries MetadataCompressed=true.
+func isCompressed(item Result[[]byte]) bool {
+	flag, ok := item.GetMetadata(MetadataCompressed)
+	return ok && flag == true
+}
+
+// processPayloads applies fn to every item, forwarding results until the input
+// closes or the context is canceled.
+func processPayloads(ctx context.Context, in <-chan Result[[]byte], fn func(Result[[]byte]) Result[[]byte]) <-chan Result[[]byte] {
+	out := make(chan Result[[]byte])
+
+	go func() {
+		defer close(out)
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case out <- fn(item):
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,175 @@
+package streamz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+// failingCodec returns an error for every operation.
+type failingCodec struct{}
+
+func (failingCodec) Compress([]byte) ([]byte, error)   { return nil, errors.New("compress failed") }
+func (failingCodec) Decompress([]byte) ([]byte, error) { return nil, errors.New("decompress failed") }
+
+func collectPayloads(out <-chan Result[[]byte]) []Result[[]byte] {
+	var results []Result[[]byte]
+	for result := range out {
+		results = append(results, result)
+	}
+	return results
+}
+
+func TestCompress_Name(t *testing.T) {
+	compress := NewCompress(GzipCodec{})
+	if compress.Name() != "compress" {
+		t.Errorf("expected name 'compress', got %q", compress.Name())
+	}
+	if compress.WithName("gzip").Name() != "gzip" {
+		t.Errorf("expected name 'gzip', got %q", compress.Name())
+	}
+
+	decompress := NewDecompress(GzipCodec{})
+	if decompress.Name() != "decompress" {
+		t.Errorf("expected name 'decompress', got %q", decompress.Name())
+	}
+	if decompress.WithName("gunzip").Name() != "gunzip" {
+		t.Errorf("expected name 'gunzip', got %q", decompress.Name())
+	}
+}
+
+func TestCompress_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	rng := rand.New(rand.NewSource(42)) // #nosec G404 -- deterministic test data
+
+	payloads := make([][]byte, 20)
+	for i := range payloads {
+		payloads[i] = make([]byte, rng.Intn(4096))
+		rng.Read(payloads[i])
+	}
+	// Highly compressible payload
+	payloads = append(payloads, bytes.Repeat([]byte("streamz"), 1000))
+
+	in := make(chan Result[[]byte], len(payloads))
+	for _, p := range payloads {
+		in <- NewSuccess(p).WithMetadata(MetadataSource, "sensor")
+	}
+	close(in)
+
+	var compressedSizes []int
+	compressed := NewCompress(GzipCodec{}).Process(ctx, in)
+	observed := make(chan Result[[]byte])
+	go func() {
+		defer close(observed)
+		for result := range compressed {
+			if !isCompressed(result) {
+				t.Errorf("expected compressed flag on payload")
+			}
+			compressedSizes = append(compressedSizes, len(result.Value()))
+			observed <- result
+		}
+	}()
+
+	results := collectPayloads(NewDecompress(GzipCodec{}).Process(ctx, observed))
+
+	if len(results) != len(payloads) {
+		t.Fatalf("expected %d payloads, got %d", len(payloads), len(results))
+	}
+	for i, result := range results {
+		if result.IsError() {
+			t.Fatalf("unexpected error at %d: %v", i, result.Error())
+		}
+		if !bytes.Equal(result.Value(), payloads[i]) {
+			t.Errorf("payload %d not byte-identical after round trip", i)
+		}
+		if isCompressed(result) {
+			t.Errorf("expected compressed flag cleared at %d", i)
+		}
+		if source, _, _ := result.GetStringMetadata(MetadataSource); source != "sensor" {
+			t.Errorf("expected source metadata preserved at %d, got %q", i, source)
+		}
+	}
+
+	last := len(payloads) - 1
+	if compressedSizes[last] >= len(payloads[last]) {
+		t.Errorf("expected repetitive payload to shrink, got %d >= %d", compressedSizes[last], len(payloads[last]))
+	}
+}
+
+func TestDecompress_SkipsUnmarkedPayloads(t *testing.T) {
+	ctx := context.Background()
+	gz, err := GzipCodec{}.Compress([]byte("hello"))
+	if err != nil {
+		t.Fatalf("compress failed: %v", err)
+	}
+
+	in := make(chan Result[[]byte], 3)
+	in <- NewSuccess([]byte("plain text"))
+	in <- NewSuccess(gz) // gzip bytes without the flag are left alone
+	in <- NewSuccess(gz).WithMetadata(MetadataCompressed, true)
+	close(in)
+
+	results := collectPayloads(NewDecompress(GzipCodec{}).Process(ctx, in))
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if string(results[0].Value()) != "plain text" {
+		t.Errorf("expected plain payload untouched, got %q", results[0].Value())
+	}
+	if !bytes.Equal(results[1].Value(), gz) {
+		t.Error("expected unmarked gzip payload untouched")
+	}
+	if string(results[2].Value()) != "hello" {
+		t.Errorf("expected marked payload decompressed, got %q", results[2].Value())
+	}
+}
+
+func TestCompress_SkipsCompressedPayloads(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[[]byte], 1)
+	in <- NewSuccess([]byte("already")).WithMetadata(MetadataCompressed, true)
+	close(in)
+
+	results := collectPayloads(NewCompress(GzipCodec{}).Process(ctx, in))
+	if len(results) != 1 || string(results[0].Value()) != "already" {
+		t.Errorf("expected compressed payload forwarded untouched, got %v", results)
+	}
+}
+
+func TestCompress_ErrorHandling(t *testing.T) {
+	ctx := context.Background()
+	upstreamErr := errors.New("read failed")
+
+	in := make(chan Result[[]byte], 2)
+	in <- NewError([]byte("x"), upstreamErr, "reader")
+	in <- NewSuccess([]byte("payload"))
+	close(in)
+
+	results := collectPayloads(NewCompress(failingCodec{}).Process(ctx, in))
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if !errors.Is(results[0].Error(), upstreamErr) || results[0].Error().ProcessorName != "reader" {
+		t.Errorf("expected upstream error to pass through unchanged, got %v", results[0].Error())
+	}
+	if !results[1].IsError() || results[1].Error().ProcessorName != "compress" {
+		t.Fatalf("expected codec failure reported by compress, got %v", results[1])
+	}
+	if string(results[1].Error().Item) != "payload" {
+		t.Errorf("expected original payload on error, got %q", results[1].Error().Item)
+	}
+
+	// Corrupt compressed data surfaces as a decompress error
+	corrupt := make(chan Result[[]byte], 1)
+	corrupt <- NewSuccess([]byte("not gzip")).WithMetadata(MetadataCompressed, true)
+	close(corrupt)
+
+	results = collectPayloads(NewDecompress(GzipCodec{}).Process(ctx, corrupt))
+	if len(results) != 1 || !results[0].IsError() || results[0].Error().ProcessorName != "decompress" {
+		t.Errorf("expected decompress error for corrupt payload, got %v", results)
+	}
+}
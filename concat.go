@@ -0,0 +1,98 @@
+package streamz
+
+import (
+	"context"
+)
+
+// Concat joins multiple Result[T] channels end to end. Unlike FanIn, which
+// interleaves items as they arrive, Concat reads each stream to completion before
+// moving to the next, producing a globally ordered concatenation.
+//
+// Later streams are not read until every earlier stream has closed, so producers
+// of later streams must tolerate being blocked (or buffer) in the meantime.
+type Concat[T any] struct {
+	name    string
+	streams []<-chan Result[T]
+}
+
+// NewConcat creates a processor that emits every item from each stream in turn.
+// Both successful values and errors flow through in their original order.
+//
+// When to use:
+//   - Replaying historical data before switching to a live feed
+//   - Emitting a header stream, then a body stream, then a trailer
+//   - Processing ordered file segments or partitions sequentially
+//
+// Example:
+//
+//	// Backfill from storage, then continue with live events
+//	concat := streamz.NewConcat(historical, live)
+//
+//	for result := range concat.Process(ctx) {
+//		if result.IsError() {
+//			log.Printf("event error: %v", result.Error())
+//			continue
+//		}
+//		apply(result.Value())
+//	}
+//
+// Parameters:
+//   - streams: Input channels to concatenate, in emission order
+//
+// Returns a new Concat processor.
+func NewConcat[T any](streams ...<-chan Result[T]) *Concat[T] {
+	return &Concat[T]{
+		name:    "concat",
+		streams: streams,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "concat".
+func (c *Concat[T]) WithName(name string) *Concat[T] {
+	c.name = name
+	return c
+}
+
+// Process emits the items of each stream in order, one stream at a time.
+// The output channel closes after the last stream closes or the context is canceled.
+func (c *Concat[T]) Process(ctx context.Context) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for _, stream := range c.streams {
+			if !c.drain(ctx, stream, out) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// drain forwards every item from stream to out.
+// Returns false if the context was canceled before the stream closed.
+func (*Concat[T]) drain(ctx context.Context, stream <-chan Result[T], out chan<- Result[T]) bool {
+	for {
+		select {
+		case item, ok := <-stream:
+			if !ok {
+				return true
+			}
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return false
+			}
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (c *Concat[T]) Name() string {
+	return c.name
+}
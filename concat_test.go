@@ -0,0 +1,126 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func filledStream(values ...int) <-chan Result[int] {
+	ch := make(chan Result[int], len(values))
+	for _, v := range values {
+		ch <- NewSuccess(v)
+	}
+	close(ch)
+	return ch
+}
+
+func TestConcat_Name(t *testing.T) {
+	concat := NewConcat[int]()
+	if concat.Name() != "concat" {
+		t.Errorf("expected name 'concat', got %q", concat.Name())
+	}
+	if concat.WithName("backfill-then-live").Name() != "backfill-then-live" {
+		t.Errorf("expected name 'backfill-then-live', got %q", concat.Name())
+	}
+}
+
+func TestConcat_PreservesStreamOrder(t *testing.T) {
+	ctx := context.Background()
+	a := make(chan Result[int], 3)
+	a <- NewSuccess(1)
+	a <- NewError(2, errors.New("bad"), "source-a")
+	a <- NewSuccess(3)
+	close(a)
+	b := filledStream(10, 20, 30)
+
+	var results []Result[int]
+	for result := range NewConcat[int](a, b).Process(ctx) {
+		results = append(results, result)
+	}
+
+	expected := []int{1, 2, 3, 10, 20, 30}
+	if len(results) != len(expected) {
+		t.Fatalf("expected %d results, got %d", len(expected), len(results))
+	}
+	for i, result := range results {
+		var got int
+		if result.IsError() {
+			got = result.Error().Item
+		} else {
+			got = result.Value()
+		}
+		if got != expected[i] {
+			t.Errorf("expected %d at %d, got %d", expected[i], i, got)
+		}
+	}
+	if !results[1].IsError() {
+		t.Error("expected error to pass through in position")
+	}
+}
+
+func TestConcat_EmptyStreamInMiddle(t *testing.T) {
+	ctx := context.Background()
+
+	var values []int
+	for result := range NewConcat(filledStream(1, 2), filledStream(), filledStream(3)).Process(ctx) {
+		values = append(values, result.Value())
+	}
+
+	if len(values) != 3 || values[0] != 1 || values[1] != 2 || values[2] != 3 {
+		t.Errorf("expected [1 2 3], got %v", values)
+	}
+}
+
+func TestConcat_WaitsForEarlierStream(t *testing.T) {
+	ctx := context.Background()
+	a := make(chan Result[int])
+	b := filledStream(10)
+
+	out := NewConcat[int](a, b).Process(ctx)
+
+	a <- NewSuccess(1)
+	if result := <-out; result.Value() != 1 {
+		t.Errorf("expected 1, got %d", result.Value())
+	}
+
+	// b is ready but must not be read while a is still open
+	select {
+	case result := <-out:
+		t.Fatalf("unexpected item before first stream closed: %v", result)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(a)
+	if result := <-out; result.Value() != 10 {
+		t.Errorf("expected 10 after first stream closed, got %d", result.Value())
+	}
+	if _, ok := <-out; ok {
+		t.Error("expected output to be closed")
+	}
+}
+
+func TestConcat_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	a := make(chan Result[int])
+	b := filledStream(10, 20)
+
+	out := NewConcat[int](a, b).Process(ctx)
+
+	a <- NewSuccess(1)
+	<-out
+
+	// Cancel mid-concat while the first stream is still open
+	cancel()
+
+	select {
+	case result, ok := <-out:
+		if ok {
+			t.Errorf("expected output to close after cancellation, got %v", result)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("output didn't close after cancellation")
+	}
+	close(a)
+}
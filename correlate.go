@@ -0,0 +1,102 @@
+package streamz
+
+import (
+	"context"
+)
+
+// MetadataCorrelationID is the default metadata key used by Correlate.
+const MetadataCorrelationID = "correlation_id" // string - request/trace correlation identifier
+
+// Correlate stamps each Result with a correlation ID so downstream processors can
+// join, trace, and log related items. Results that already carry an ID keep it.
+type Correlate[T any] struct {
+	name string
+	key  string
+	gen  func() string
+}
+
+// NewCorrelate creates a processor that assigns correlation IDs to Results lacking one.
+// Both successful values and errors are stamped. The generator is called once per
+// Result missing an ID and must return unique values; if it is shared between
+// processors it must be safe for concurrent use.
+//
+// When to use:
+//   - Tracing requests across pipeline stages and services
+//   - Joining results from Fork or FanOut branches later on
+//   - Correlating error logs with the item that caused them
+//
+// Example:
+//
+//	correlate := streamz.NewCorrelate[Request](func() string {
+//		return uuid.NewString()
+//	})
+//
+//	traced := correlate.Process(ctx, requests)
+//	for result := range traced {
+//		id, _, _ := result.GetStringMetadata(streamz.MetadataCorrelationID)
+//		log.Printf("[%s] handled request", id)
+//	}
+//
+// Parameters:
+//   - gen: Generates a new unique correlation ID
+//
+// Returns a new Correlate processor.
+func NewCorrelate[T any](gen func() string) *Correlate[T] {
+	return &Correlate[T]{
+		name: "correlate",
+		key:  MetadataCorrelationID,
+		gen:  gen,
+	}
+}
+
+// WithKey sets the metadata key the correlation ID is read from and written to.
+// If not set, defaults to MetadataCorrelationID.
+func (c *Correlate[T]) WithKey(key string) *Correlate[T] {
+	c.key = key
+	return c
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "correlate".
+func (c *Correlate[T]) WithName(name string) *Correlate[T] {
+	c.name = name
+	return c
+}
+
+// Process forwards every Result, generating a correlation ID where one is missing.
+// Existing IDs are left intact.
+func (c *Correlate[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+
+				if _, exists := item.GetMetadata(c.key); !exists {
+					item = item.WithMetadata(c.key, c.gen())
+				}
+
+				select {
+				case out <- item:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (c *Correlate[T]) Name() string {
+	return c.name
+}
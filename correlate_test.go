@@ -0,0 +1,139 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// sequentialIDs returns a deterministic, concurrency-safe ID generator.
+func sequentialIDs(prefix string) func() string {
+	var counter atomic.Uint64
+	return func() string {
+		return prefix + strconv.FormatUint(counter.Add(1), 10)
+	}
+}
+
+func TestCorrelate_Name(t *testing.T) {
+	correlate := NewCorrelate[int](sequentialIDs("id-"))
+	if correlate.Name() != "correlate" {
+		t.Errorf("expected name 'correlate', got %q", correlate.Name())
+	}
+	if correlate.WithName("trace").Name() != "trace" {
+		t.Errorf("expected name 'trace', got %q", correlate.Name())
+	}
+}
+
+func TestCorrelate_AssignsUniqueIDs(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int], 3)
+	in <- NewSuccess(1)
+	in <- NewError(2, errors.New("bad"), "parser")
+	in <- NewSuccess(3)
+	close(in)
+
+	var ids []string
+	for result := range NewCorrelate[int](sequentialIDs("req-")).Process(ctx, in) {
+		id, found, err := result.GetStringMetadata(MetadataCorrelationID)
+		if err != nil || !found {
+			t.Fatalf("expected correlation ID, got found=%v err=%v", found, err)
+		}
+		ids = append(ids, id)
+	}
+
+	expected := []string{"req-1", "req-2", "req-3"}
+	if len(ids) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, ids)
+	}
+	for i := range expected {
+		if ids[i] != expected[i] {
+			t.Errorf("expected %q at %d, got %q", expected[i], i, ids[i])
+		}
+	}
+}
+
+func TestCorrelate_PreservesExistingIDs(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int], 3)
+	in <- NewSuccess(1).WithMetadata(MetadataCorrelationID, "upstream-7")
+	in <- NewSuccess(2)
+	in <- NewError(3, errors.New("bad"), "parser").WithMetadata(MetadataCorrelationID, "upstream-8")
+	close(in)
+
+	var ids []string
+	for result := range NewCorrelate[int](sequentialIDs("new-")).Process(ctx, in) {
+		id, _, _ := result.GetStringMetadata(MetadataCorrelationID)
+		ids = append(ids, id)
+	}
+
+	if len(ids) != 3 || ids[0] != "upstream-7" || ids[1] != "new-1" || ids[2] != "upstream-8" {
+		t.Errorf("expected [upstream-7 new-1 upstream-8], got %v", ids)
+	}
+}
+
+func TestCorrelate_WithKey(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int], 2)
+	in <- NewSuccess(1).WithMetadata("trace_id", "existing")
+	in <- NewSuccess(2)
+	close(in)
+
+	var results []Result[int]
+	for result := range NewCorrelate[int](sequentialIDs("t-")).WithKey("trace_id").Process(ctx, in) {
+		results = append(results, result)
+	}
+
+	if id, _, _ := results[0].GetStringMetadata("trace_id"); id != "existing" {
+		t.Errorf("expected existing trace ID kept, got %q", id)
+	}
+	if id, _, _ := results[1].GetStringMetadata("trace_id"); id != "t-1" {
+		t.Errorf("expected generated trace ID, got %q", id)
+	}
+	if _, found := results[1].GetMetadata(MetadataCorrelationID); found {
+		t.Error("expected default key to be unused when WithKey is set")
+	}
+}
+
+func TestCorrelate_ConcurrentNoCollisions(t *testing.T) {
+	ctx := context.Background()
+	gen := sequentialIDs("c-")
+
+	const workers = 4
+	const perWorker = 250
+
+	var mu sync.Mutex
+	seen := make(map[string]bool)
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		in := make(chan Result[int], perWorker)
+		for i := 0; i < perWorker; i++ {
+			in <- NewSuccess(i)
+		}
+		close(in)
+
+		out := NewCorrelate[int](gen).Process(ctx, in)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for result := range out {
+				id, _, _ := result.GetStringMetadata(MetadataCorrelationID)
+				mu.Lock()
+				if seen[id] {
+					t.Errorf("duplicate correlation ID %q", id)
+				}
+				seen[id] = true
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if len(seen) != workers*perWorker {
+		t.Errorf("expected %d unique IDs, got %d", workers*perWorker, len(seen))
+	}
+}
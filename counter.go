@@ -0,0 +1,134 @@
+package streamz
+
+import (
+	"context"
+	"time"
+)
+
+// CountEvent reports how many Results a Counter saw during one interval.
+type CountEvent struct {
+	Start     time.Time // Interval start (inclusive)
+	End       time.Time // Interval end; earlier than Start+interval for the final partial interval
+	Successes uint64    // Successful Results seen in the interval
+	Errors    uint64    // Error Results seen in the interval
+}
+
+// Total returns the number of Results seen in the interval.
+func (e CountEvent) Total() uint64 {
+	return e.Successes + e.Errors
+}
+
+// Counter forwards items unchanged while counting them in fixed time intervals,
+// reporting each completed interval through a callback. It provides the standard
+// "events per interval" metric without windowing the stream itself.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Counter[T any] struct {
+	name     string
+	interval time.Duration
+	clock    Clock
+	onCount  func(CountEvent)
+}
+
+// NewCounter creates a processor that counts items per interval.
+// A CountEvent is reported at the end of every interval, including intervals with
+// no items, and once more for the final partial interval when input closes.
+// Nothing is reported after context cancellation.
+//
+// When to use:
+//   - Throughput and error-rate dashboards
+//   - Alerting on traffic drops or spikes per minute
+//   - Lightweight metrics where full windowing is unnecessary
+//
+// Example:
+//
+//	counter := streamz.NewCounter[Order](time.Minute, streamz.RealClock).
+//		OnCount(func(e streamz.CountEvent) {
+//			metrics.Gauge("orders_per_minute", e.Successes)
+//			metrics.Gauge("order_errors_per_minute", e.Errors)
+//		})
+//
+//	orders = counter.Process(ctx, orders)
+//
+// Parameters:
+//   - interval: Length of each counting interval (must be > 0)
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new Counter processor.
+func NewCounter[T any](interval time.Duration, clock Clock) *Counter[T] {
+	return &Counter[T]{
+		name:     "counter",
+		interval: interval,
+		clock:    clock,
+	}
+}
+
+// OnCount registers a callback that receives the counts for each interval.
+// Callbacks run on the processing goroutine and should return quickly.
+func (c *Counter[T]) OnCount(fn func(CountEvent)) *Counter[T] {
+	c.onCount = fn
+	return c
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "counter".
+func (c *Counter[T]) WithName(name string) *Counter[T] {
+	c.name = name
+	return c
+}
+
+// Process forwards every item unchanged while counting it in the current interval.
+func (c *Counter[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		ticker := c.clock.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		current := CountEvent{Start: c.clock.Now()}
+		report := func(end time.Time) {
+			current.End = end
+			if c.onCount != nil {
+				c.onCount(current)
+			}
+			current = CountEvent{Start: end}
+		}
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					report(c.clock.Now())
+					return
+				}
+
+				if item.IsError() {
+					current.Errors++
+				} else {
+					current.Successes++
+				}
+
+				select {
+				case out <- item:
+				case <-ctx.Done():
+					return
+				}
+
+			case <-ticker.C():
+				report(c.clock.Now())
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (c *Counter[T]) Name() string {
+	return c.name
+}
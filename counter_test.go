@@ -0,0 +1,142 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+// countRecorder collects CountEvents reported from the processing goroutine.
+type countRecorder struct {
+	mu     sync.Mutex
+	events []CountEvent
+}
+
+func (r *countRecorder) record(e CountEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+}
+
+func (r *countRecorder) snapshot() []CountEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]CountEvent(nil), r.events...)
+}
+
+// waitForEvents polls until n events have been reported.
+func (r *countRecorder) waitForEvents(t *testing.T, n int) []CountEvent {
+	t.Helper()
+	deadline := time.After(time.Second)
+	for {
+		if events := r.snapshot(); len(events) >= n {
+			return events
+		}
+		select {
+		case <-deadline:
+			t.Fatalf("expected %d count events, got %d", n, len(r.snapshot()))
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func TestCounter_Name(t *testing.T) {
+	counter := NewCounter[int](time.Second, RealClock)
+	if counter.Name() != "counter" {
+		t.Errorf("expected name 'counter', got %q", counter.Name())
+	}
+	if counter.WithName("orders-per-minute").Name() != "orders-per-minute" {
+		t.Errorf("expected name 'orders-per-minute', got %q", counter.Name())
+	}
+}
+
+func TestCounter_CountsPerInterval(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	start := clock.Now()
+	recorder := &countRecorder{}
+	counter := NewCounter[int](time.Minute, clock).OnCount(recorder.record)
+
+	in := make(chan Result[int])
+	out := counter.Process(context.Background(), in)
+
+	send := func(r Result[int]) {
+		in <- r
+		if got := <-out; got.IsError() != r.IsError() {
+			t.Errorf("expected item forwarded unchanged, got %v", got)
+		}
+	}
+
+	// First interval: 3 successes, 1 error
+	for i := 0; i < 3; i++ {
+		send(NewSuccess(i))
+	}
+	send(NewError(0, errors.New("bad"), "parser"))
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+	recorder.waitForEvents(t, 1)
+
+	// Second interval: 5 successes
+	for i := 0; i < 5; i++ {
+		send(NewSuccess(i))
+	}
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+	recorder.waitForEvents(t, 2)
+
+	// Third interval is empty, but still reported
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+	recorder.waitForEvents(t, 3)
+
+	// Final partial interval: 2 errors, flushed on close
+	send(NewError(1, errors.New("bad"), "parser"))
+	send(NewError(2, errors.New("bad"), "parser"))
+	clock.Advance(20 * time.Second)
+	close(in)
+	if _, ok := <-out; ok {
+		t.Fatal("expected output to close")
+	}
+
+	events := recorder.snapshot()
+	expected := []CountEvent{
+		{Start: start, End: start.Add(time.Minute), Successes: 3, Errors: 1},
+		{Start: start.Add(time.Minute), End: start.Add(2 * time.Minute), Successes: 5},
+		{Start: start.Add(2 * time.Minute), End: start.Add(3 * time.Minute)},
+		{Start: start.Add(3 * time.Minute), End: start.Add(3*time.Minute + 20*time.Second), Errors: 2},
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("expected %d events, got %+v", len(expected), events)
+	}
+	for i := range expected {
+		if events[i] != expected[i] {
+			t.Errorf("event %d: expected %+v, got %+v", i, expected[i], events[i])
+		}
+	}
+	if events[0].Total() != 4 {
+		t.Errorf("expected total 4 in first interval, got %d", events[0].Total())
+	}
+}
+
+func TestCounter_NoReportAfterCancel(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	recorder := &countRecorder{}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	in := make(chan Result[int])
+	out := NewCounter[int](time.Minute, clock).OnCount(recorder.record).Process(ctx, in)
+
+	in <- NewSuccess(1)
+	<-out
+	cancel()
+
+	if _, ok := <-out; ok {
+		t.Fatal("expected output to close after cancellation")
+	}
+	if events := recorder.snapshot(); len(events) != 0 {
+		t.Errorf("expected no count events after cancellation, got %+v", events)
+	}
+}
@@ -0,0 +1,244 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrCreditGateClosed is returned by CreditGate.Acquire once the gate has stopped processing.
+var ErrCreditGateClosed = errors.New("credit gate closed")
+
+// CreditGate implements credit-based flow control between a producer and a
+// pipeline. Rather than discovering backpressure by blocking on a send, the
+// producer spends a credit for every item it sends and gets the credit back
+// once a downstream consumer receives that item, so it can throttle itself,
+// shed load, or do other work while out of credit.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type CreditGate[T any] struct {
+	name      string
+	capacity  int
+	mu        sync.Mutex
+	credits   int
+	acquired  int
+	available chan struct{}
+	taken     chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewCreditGate creates a processor that grants initialCredits credits to its producer.
+// The producer takes a credit with Acquire or TryAcquire, or checks HasCredit,
+// before each send. The gate buffers up to initialCredits credited items, so a
+// producer that respects its credits never blocks on the input channel; the
+// credit is returned once the item, success or error, is received from the output.
+//
+// A producer that sends without credit is not rejected, but once the buffer is
+// full the gate stops reading and the producer blocks as with any channel. An
+// item counts as credited when a credit taken by Acquire or TryAcquire is still
+// unmatched as it arrives; other items neither take nor return credits, so the
+// credits never exceed initialCredits.
+//
+// When processing stops, because the input closed and the buffer drained or the
+// context was canceled, waiting and future Acquire calls return ErrCreditGateClosed.
+//
+// When to use:
+//   - Producers that should shed or defer work instead of blocking
+//   - Bridging to protocols with credit-based flow control
+//   - Bounding in-flight items end to end without a blocking send
+//
+// Example:
+//
+//	gate := streamz.NewCreditGate[Reading](100)
+//	readings := make(chan streamz.Result[Reading])
+//	out := gate.Process(ctx, readings)
+//
+//	// Producer: drop samples rather than stall the sensor loop
+//	for sample := range sensor.Samples() {
+//		if !gate.TryAcquire() {
+//			dropped.Inc()
+//			continue
+//		}
+//		readings <- streamz.NewSuccess(sample)
+//	}
+//
+// Parameters:
+//   - initialCredits: Credits granted to the producer and the gate's buffer size (must be positive)
+//
+// Returns a new CreditGate processor.
+// Panics if initialCredits is less than 1.
+func NewCreditGate[T any](initialCredits int) *CreditGate[T] {
+	if initialCredits < 1 {
+		panic("credit gate initial credits must be positive")
+	}
+
+	return &CreditGate[T]{
+		name:      "credit-gate",
+		capacity:  initialCredits,
+		credits:   initialCredits,
+		available: make(chan struct{}, 1),
+		taken:     make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "credit-gate".
+func (g *CreditGate[T]) WithName(name string) *CreditGate[T] {
+	g.name = name
+	return g
+}
+
+// HasCredit reports whether a credit is currently available.
+// Safe to call concurrently with Process.
+func (g *CreditGate[T]) HasCredit() bool {
+	return g.Credits() > 0
+}
+
+// Credits returns the number of credits currently available.
+// Safe to call concurrently with Process.
+func (g *CreditGate[T]) Credits() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.credits
+}
+
+// TryAcquire takes a credit if one is available, without waiting.
+// Safe to call concurrently with Process.
+func (g *CreditGate[T]) TryAcquire() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.credits == 0 {
+		return false
+	}
+	g.credits--
+	g.acquired++
+	g.signalIfAvailable()
+
+	// Wake a full gate so it reads the credited item
+	select {
+	case g.taken <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// Acquire waits for a credit and takes it. It returns ctx.Err() if ctx is
+// canceled first, or ErrCreditGateClosed if the gate stops processing.
+// Safe to call concurrently with Process.
+func (g *CreditGate[T]) Acquire(ctx context.Context) error {
+	for {
+		if g.TryAcquire() {
+			return nil
+		}
+		select {
+		case <-g.available:
+		case <-g.done:
+			return ErrCreditGateClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// claim matches an arriving item to an outstanding credit, reporting whether it is credited.
+func (g *CreditGate[T]) claim() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.acquired == 0 {
+		return false
+	}
+	g.acquired--
+	return true
+}
+
+// outstanding reports whether credits have been taken for items not yet received.
+func (g *CreditGate[T]) outstanding() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.acquired > 0
+}
+
+// release returns the credit of a delivered item and wakes a waiting producer.
+func (g *CreditGate[T]) release() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.credits = min(g.credits+1, g.capacity)
+	g.signalIfAvailable()
+}
+
+// signalIfAvailable wakes one waiting producer while credits remain.
+// Caller must hold g.mu.
+func (g *CreditGate[T]) signalIfAvailable() {
+	if g.credits == 0 {
+		return
+	}
+	select {
+	case g.available <- struct{}{}:
+	default:
+	}
+}
+
+// Process forwards items in order, returning a credit for each credited item delivered.
+func (g *CreditGate[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+		defer g.closeOnce.Do(func() { close(g.done) })
+
+		// Items sent without credit may occupy buffer space, so credited items
+		// are still read past capacity; there are never more than capacity of them
+		buffer := make([]Result[T], 0, g.capacity)
+		var credited []bool
+
+		for {
+			// Stop reading while full, unless a credited item is on its way
+			input := in
+			if len(buffer) >= g.capacity && !g.outstanding() {
+				input = nil
+			}
+
+			var output chan<- Result[T]
+			var next Result[T]
+			if len(buffer) > 0 {
+				output = out
+				next = buffer[0]
+			}
+
+			if in == nil && len(buffer) == 0 {
+				// Input closed and everything has been delivered
+				return
+			}
+
+			select {
+			case item, ok := <-input:
+				if !ok {
+					in = nil
+					continue
+				}
+				buffer = append(buffer, item)
+				credited = append(credited, g.claim())
+			case output <- next:
+				if credited[0] {
+					g.release()
+				}
+				buffer[0] = Result[T]{}
+				buffer = buffer[1:]
+				credited = credited[1:]
+			case <-g.taken:
+				// A credit was taken; re-check whether to read past capacity
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (g *CreditGate[T]) Name() string {
+	return g.name
+}
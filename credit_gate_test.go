@@ -0,0 +1,188 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCreditGate_Name(t *testing.T) {
+	gate := NewCreditGate[int](1)
+	if gate.Name() != "credit-gate" {
+		t.Errorf("expected name 'credit-gate', got %q", gate.Name())
+	}
+	if gate.WithName("ingest").Name() != "ingest" {
+		t.Errorf("expected name 'ingest', got %q", gate.Name())
+	}
+}
+
+func TestCreditGate_PanicsOnInvalidCredits(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for zero credits")
+		}
+	}()
+	NewCreditGate[int](0)
+}
+
+func TestCreditGate_ProducerNeverOverruns(t *testing.T) {
+	const capacity = 3
+	ctx := context.Background()
+	gate := NewCreditGate[int](capacity)
+
+	in := make(chan Result[int])
+	out := gate.Process(ctx, in)
+
+	var produced atomic.Int64
+	go func() {
+		defer close(in)
+		for i := 0; i < 50; i++ {
+			if err := gate.Acquire(ctx); err != nil {
+				t.Errorf("unexpected acquire error: %v", err)
+				return
+			}
+			produced.Add(1)
+			in <- NewSuccess(i)
+		}
+	}()
+
+	consumed := int64(0)
+	for result := range out {
+		if result.Value() != int(consumed) {
+			t.Errorf("expected item %d, got %d", consumed, result.Value())
+		}
+		consumed++
+		// Credits are only returned on receipt, so the producer stays within capacity of it
+		if ahead := produced.Load() - consumed; ahead > capacity {
+			t.Fatalf("producer overran the gate: %d items in flight", ahead)
+		}
+		time.Sleep(100 * time.Microsecond)
+	}
+
+	if consumed != 50 {
+		t.Errorf("expected 50 items, got %d", consumed)
+	}
+}
+
+func TestCreditGate_CreditsReturnedOnConsumption(t *testing.T) {
+	ctx := context.Background()
+	gate := NewCreditGate[string](2)
+
+	in := make(chan Result[string])
+	out := gate.Process(ctx, in)
+
+	for i := 0; i < 2; i++ {
+		if !gate.TryAcquire() {
+			t.Fatalf("expected credit %d available", i+1)
+		}
+	}
+	if gate.HasCredit() || gate.TryAcquire() {
+		t.Fatal("expected credits exhausted")
+	}
+
+	// A producer respecting credits never blocks on send
+	in <- NewSuccess("a")
+	in <- NewError("b", errors.New("bad"), "parser")
+	if gate.HasCredit() {
+		t.Error("expected no credit while items are unconsumed")
+	}
+
+	<-out
+	waitCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	if err := gate.Acquire(waitCtx); err != nil {
+		t.Fatalf("expected credit returned after consumption: %v", err)
+	}
+
+	<-out
+	if err := gate.Acquire(waitCtx); err != nil {
+		t.Fatalf("expected credit returned after consuming an error: %v", err)
+	}
+	if gate.Credits() != 0 {
+		t.Errorf("expected 0 credits after reacquiring both, got %d", gate.Credits())
+	}
+	close(in)
+}
+
+func TestCreditGate_UncreditedSendsDoNotInflateCredits(t *testing.T) {
+	ctx := context.Background()
+	gate := NewCreditGate[int](2)
+
+	in := make(chan Result[int])
+	out := gate.Process(ctx, in)
+
+	// Items sent without credit return none when delivered
+	for i := 0; i < 3; i++ {
+		in <- NewSuccess(i)
+		<-out
+	}
+	if gate.Credits() != 2 {
+		t.Fatalf("expected credits to stay at 2, got %d", gate.Credits())
+	}
+
+	// An uncredited item takes buffer space, yet a producer respecting credits
+	// never blocks. Receiving 3 shows the gate has finished handling 4.
+	in <- NewSuccess(3)
+	in <- NewSuccess(4)
+	<-out
+	for i := 0; i < 2; i++ {
+		if !gate.TryAcquire() {
+			t.Fatalf("expected credit %d available", i+1)
+		}
+		select {
+		case in <- NewSuccess(10 + i):
+		case <-time.After(time.Second):
+			t.Fatalf("credited send %d blocked", i+1)
+		}
+	}
+	if gate.TryAcquire() {
+		t.Error("expected no credit beyond the initial credits")
+	}
+
+	close(in)
+	for _, want := range []int{4, 10, 11} {
+		if result := <-out; result.Value() != want {
+			t.Errorf("expected %d, got %d", want, result.Value())
+		}
+	}
+	// The last credit is returned before the output closes
+	if _, ok := <-out; ok {
+		t.Error("expected output closed")
+	}
+	if gate.Credits() != 2 {
+		t.Errorf("expected both credits returned, got %d", gate.Credits())
+	}
+}
+
+func TestCreditGate_CancellationReleasesProducer(t *testing.T) {
+	gate := NewCreditGate[int](1)
+	gateCtx, stopGate := context.WithCancel(context.Background())
+	gate.Process(gateCtx, make(chan Result[int]))
+
+	if !gate.TryAcquire() {
+		t.Fatal("expected initial credit")
+	}
+
+	// The producer's own context releases it
+	producerCtx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() { errs <- gate.Acquire(producerCtx) }()
+	cancel()
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+
+	// Stopping the gate releases producers waiting on it
+	go func() { errs <- gate.Acquire(context.Background()) }()
+	stopGate()
+	select {
+	case err := <-errs:
+		if !errors.Is(err, ErrCreditGateClosed) {
+			t.Errorf("expected ErrCreditGateClosed, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected waiting producer released when the gate stopped")
+	}
+}
@@ -0,0 +1,226 @@
+package streamz
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// Dedupe removes duplicate items from a stream based on a key function.
+// Keys are remembered for a configurable TTL; an item whose key was seen within
+// the TTL is suppressed, while keys older than the TTL are evicted and treated
+// as new the next time they appear.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Dedupe[T any] struct {
+	name        string
+	keyFn       func(T) string
+	normalize   func(string) string
+	ttl         time.Duration
+	clock       Clock
+	onExpire    func(key string)
+	onDuplicate func(key string)
+
+	uniqueCount    atomic.Uint64
+	duplicateCount atomic.Uint64
+	expiredCount   atomic.Uint64
+}
+
+// DedupeStats reports deduplication counters for monitoring effectiveness.
+type DedupeStats struct {
+	UniqueCount    uint64 // Items forwarded because their key was not cached
+	DuplicateCount uint64 // Items suppressed as duplicates
+	ExpiredCount   uint64 // Keys evicted after their TTL elapsed
+}
+
+// HitRate returns the fraction of successful items suppressed as duplicates.
+// Returns 0 if no items have been seen.
+func (s DedupeStats) HitRate() float64 {
+	total := s.UniqueCount + s.DuplicateCount
+	if total == 0 {
+		return 0
+	}
+	return float64(s.DuplicateCount) / float64(total)
+}
+
+// NewDedupe creates a processor that filters out items with recently seen keys.
+// Keys are remembered for one hour unless configured with WithTTL.
+// Errors are passed through unchanged and never affect the key cache.
+//
+// When to use:
+//   - Handling at-least-once delivery from message queues
+//   - Suppressing duplicate events from unreliable sources
+//   - Preventing repeated expensive work on identical inputs
+//
+// Example:
+//
+//	deduper := streamz.NewDedupe(func(e Event) string {
+//		return e.ID
+//	}, streamz.RealClock).
+//		WithTTL(10 * time.Minute).
+//		OnDuplicate(func(key string) { duplicates.Inc() })
+//
+//	unique := deduper.Process(ctx, events)
+//
+//	// Later: monitor effectiveness
+//	log.Printf("dedupe hit rate: %.2f", deduper.Stats().HitRate())
+//
+// Parameters:
+//   - keyFn: Extracts the deduplication key from an item
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new Dedupe processor.
+func NewDedupe[T any](keyFn func(T) string, clock Clock) *Dedupe[T] {
+	return &Dedupe[T]{
+		name:  "dedupe",
+		keyFn: keyFn,
+		ttl:   time.Hour,
+		clock: clock,
+	}
+}
+
+// WithTTL sets how long a key is remembered after it is first seen.
+// If not set, defaults to one hour.
+func (d *Dedupe[T]) WithTTL(ttl time.Duration) *Dedupe[T] {
+	d.ttl = ttl
+	return d
+}
+
+// WithKeyNormalizer canonicalizes each extracted key before it is compared, so
+// that keys differing only in form, such as "Apple" and "apple" with
+// strings.ToLower, are treated as duplicates. Items are forwarded unchanged, and
+// callbacks receive the normalized key.
+func (d *Dedupe[T]) WithKeyNormalizer(normalize func(string) string) *Dedupe[T] {
+	d.normalize = normalize
+	return d
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "dedupe".
+func (d *Dedupe[T]) WithName(name string) *Dedupe[T] {
+	d.name = name
+	return d
+}
+
+// OnExpire registers a callback invoked when a key's TTL elapses and it is evicted.
+// Callbacks run on the processing goroutine and should return quickly.
+func (d *Dedupe[T]) OnExpire(fn func(key string)) *Dedupe[T] {
+	d.onExpire = fn
+	return d
+}
+
+// OnDuplicate registers a callback invoked each time a duplicate is suppressed.
+// Callbacks run on the processing goroutine and should return quickly.
+func (d *Dedupe[T]) OnDuplicate(fn func(key string)) *Dedupe[T] {
+	d.onDuplicate = fn
+	return d
+}
+
+// Stats returns a snapshot of the deduplication counters.
+// Safe to call concurrently with Process.
+func (d *Dedupe[T]) Stats() DedupeStats {
+	return DedupeStats{
+		UniqueCount:    d.uniqueCount.Load(),
+		DuplicateCount: d.duplicateCount.Load(),
+		ExpiredCount:   d.expiredCount.Load(),
+	}
+}
+
+// Process forwards the first occurrence of each key within the TTL.
+// Expired keys are evicted by a periodic check and on lookup.
+// The output channel closes when the input closes or the context is canceled.
+func (d *Dedupe[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		seen := make(map[string]time.Time)
+
+		checkInterval := d.ttl / 4
+		if checkInterval < 10*time.Millisecond {
+			checkInterval = 10 * time.Millisecond
+		}
+		ticker := d.clock.NewTicker(checkInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case result, ok := <-in:
+				if !ok {
+					return
+				}
+
+				if result.IsError() {
+					select {
+					case out <- result:
+					case <-ctx.Done():
+						return
+					}
+					continue
+				}
+
+				key := d.keyFn(result.Value())
+				if d.normalize != nil {
+					key = d.normalize(key)
+				}
+				now := d.clock.Now()
+
+				if firstSeen, exists := seen[key]; exists {
+					if now.Sub(firstSeen) < d.ttl {
+						d.duplicateCount.Add(1)
+						if d.onDuplicate != nil {
+							d.onDuplicate(key)
+						}
+						continue
+					}
+					// Stale entry not yet swept by the ticker
+					d.expire(seen, key)
+				}
+
+				seen[key] = now
+				d.uniqueCount.Add(1)
+
+				select {
+				case out <- result:
+				case <-ctx.Done():
+					return
+				}
+
+			case <-ticker.C():
+				now := d.clock.Now()
+				expired := make([]string, 0)
+				for key, firstSeen := range seen {
+					if now.Sub(firstSeen) >= d.ttl {
+						expired = append(expired, key)
+					}
+				}
+				// Report evictions in a stable order
+				sort.Strings(expired)
+				for _, key := range expired {
+					d.expire(seen, key)
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// expire evicts a key and reports it to the OnExpire callback if registered.
+func (d *Dedupe[T]) expire(seen map[string]time.Time, key string) {
+	delete(seen, key)
+	d.expiredCount.Add(1)
+	if d.onExpire != nil {
+		d.onExpire(key)
+	}
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (d *Dedupe[T]) Name() string {
+	return d.name
+}
@@ -0,0 +1,197 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+func identityKey(s string) string { return s }
+
+// sendAndReceive sends an item that is expected to be forwarded and returns it.
+func sendAndReceive(t *testing.T, in chan<- Result[string], out <-chan Result[string], item Result[string]) Result[string] {
+	t.Helper()
+	in <- item
+	select {
+	case result := <-out:
+		return result
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for forwarded item")
+		return Result[string]{}
+	}
+}
+
+func TestDedupe_Name(t *testing.T) {
+	dedupe := NewDedupe(identityKey, RealClock)
+	if dedupe.Name() != "dedupe" {
+		t.Errorf("expected name 'dedupe', got %q", dedupe.Name())
+	}
+	if dedupe.WithName("event-deduper").Name() != "event-deduper" {
+		t.Errorf("expected name 'event-deduper', got %q", dedupe.Name())
+	}
+}
+
+func TestDedupe_SuppressesDuplicates(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[string], 6)
+	in <- NewSuccess("a")
+	in <- NewSuccess("a")
+	in <- NewError("x", errors.New("bad"), "upstream")
+	in <- NewSuccess("b")
+	in <- NewSuccess("a")
+	in <- NewSuccess("b")
+	close(in)
+
+	var results []Result[string]
+	for result := range NewDedupe(identityKey, clockz.NewFakeClock()).Process(ctx, in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if results[0].Value() != "a" || !results[1].IsError() || results[2].Value() != "b" {
+		t.Errorf("expected [a error b], got %v", results)
+	}
+}
+
+func TestDedupe_OnDuplicate(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	in := make(chan Result[string])
+
+	duplicates := make(map[string]int)
+	dedupe := NewDedupe(identityKey, clock).OnDuplicate(func(key string) {
+		duplicates[key]++
+	})
+	out := dedupe.Process(ctx, in)
+
+	sendAndReceive(t, in, out, NewSuccess("a"))
+	in <- NewSuccess("a")
+	sendAndReceive(t, in, out, NewSuccess("b"))
+	in <- NewSuccess("a")
+	in <- NewSuccess("b")
+
+	// A forwarded item confirms every earlier duplicate was handled
+	sendAndReceive(t, in, out, NewSuccess("c"))
+	close(in)
+
+	if duplicates["a"] != 2 || duplicates["b"] != 1 || len(duplicates) != 2 {
+		t.Errorf("expected duplicates a=2 b=1, got %v", duplicates)
+	}
+
+	stats := dedupe.Stats()
+	if stats.UniqueCount != 3 || stats.DuplicateCount != 3 {
+		t.Errorf("expected 3 unique and 3 duplicates, got %+v", stats)
+	}
+	if stats.HitRate() != 0.5 {
+		t.Errorf("expected hit rate 0.5, got %f", stats.HitRate())
+	}
+}
+
+func TestDedupe_OnExpire(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	in := make(chan Result[string])
+
+	expired := make(chan string, 10)
+	dedupe := NewDedupe(identityKey, clock).
+		WithTTL(100 * time.Millisecond).
+		OnExpire(func(key string) { expired <- key })
+	out := dedupe.Process(ctx, in)
+
+	sendAndReceive(t, in, out, NewSuccess("a"))
+	clock.Advance(50 * time.Millisecond)
+	clock.BlockUntilReady()
+	sendAndReceive(t, in, out, NewSuccess("b"))
+
+	// Past a's TTL but not b's
+	clock.Advance(50 * time.Millisecond)
+	clock.BlockUntilReady()
+
+	select {
+	case key := <-expired:
+		if key != "a" {
+			t.Errorf("expected 'a' to expire first, got %q", key)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expire callback not fired for 'a'")
+	}
+
+	// Past b's TTL
+	clock.Advance(50 * time.Millisecond)
+	clock.BlockUntilReady()
+
+	select {
+	case key := <-expired:
+		if key != "b" {
+			t.Errorf("expected 'b' to expire, got %q", key)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expire callback not fired for 'b'")
+	}
+
+	// Expired keys are treated as new again
+	if result := sendAndReceive(t, in, out, NewSuccess("a")); result.Value() != "a" {
+		t.Errorf("expected expired key to be forwarded, got %v", result)
+	}
+	close(in)
+
+	select {
+	case key := <-expired:
+		t.Errorf("unexpected extra expiry for %q", key)
+	default:
+	}
+	if stats := dedupe.Stats(); stats.ExpiredCount != 2 || stats.UniqueCount != 3 {
+		t.Errorf("expected 2 expired and 3 unique, got %+v", stats)
+	}
+}
+
+func TestDedupe_WithKeyNormalizer(t *testing.T) {
+	in := make(chan Result[string], 4)
+	in <- NewSuccess("Apple")
+	in <- NewSuccess("apple")
+	in <- NewSuccess("APPLE")
+	in <- NewSuccess("Banana")
+	close(in)
+
+	var duplicates []string
+	dedupe := NewDedupe(identityKey, clockz.NewFakeClock()).
+		WithKeyNormalizer(strings.ToLower).
+		OnDuplicate(func(key string) { duplicates = append(duplicates, key) })
+
+	var got []string
+	for result := range dedupe.Process(context.Background(), in) {
+		got = append(got, result.Value())
+	}
+
+	// The first variant is forwarded as it arrived; only the key is normalized
+	if len(got) != 2 || got[0] != "Apple" || got[1] != "Banana" {
+		t.Errorf("expected [Apple Banana], got %v", got)
+	}
+	if len(duplicates) != 2 || duplicates[0] != "apple" || duplicates[1] != "apple" {
+		t.Errorf("expected normalized duplicate keys [apple apple], got %v", duplicates)
+	}
+}
+
+func TestDedupe_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[string])
+	out := NewDedupe(identityKey, clockz.NewFakeClock()).Process(ctx, in)
+
+	cancel()
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Error("expected output to be closed after cancellation")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("output didn't close after cancellation")
+	}
+	close(in)
+}
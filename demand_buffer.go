@@ -0,0 +1,146 @@
+package streamz
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// DemandBuffer bridges streamz's push model to pull-based consumers such as
+// reactive-streams subscribers. Items are forwarded only against demand signalled
+// through Request; upstream items are held in a bounded internal buffer while the
+// consumer has no outstanding demand, and upstream is paused once it fills.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type DemandBuffer[T any] struct {
+	name     string
+	capacity int
+	demand   atomic.Int64
+	signal   chan struct{}
+}
+
+// NewDemandBuffer creates a processor that forwards items only when requested.
+// Each Request(n) allows up to n more items, successes and errors alike, to be
+// emitted; once the demand is used up the processor pauses until more arrives.
+// Demand may be requested before or during Process and accumulates across calls.
+//
+// When the input closes, buffered items are still emitted as demand allows and the
+// output closes once the buffer is empty. Cancelling the context closes the output
+// immediately, discarding anything buffered.
+//
+// When to use:
+//   - Adapting a pipeline to a reactive-streams or other pull-based consumer
+//   - Letting a consumer pace delivery explicitly (e.g., credit-based protocols)
+//   - Applying backpressure driven by downstream acknowledgements
+//
+// Example:
+//
+//	demand := streamz.NewDemandBuffer[Event]().WithBufferSize(256)
+//	events := demand.Process(ctx, source)
+//
+//	// Subscriber signals demand as it becomes ready
+//	demand.Request(10)
+//	for event := range events {
+//		deliver(event)
+//		demand.Request(1)
+//	}
+//
+// Returns a new DemandBuffer processor.
+func NewDemandBuffer[T any]() *DemandBuffer[T] {
+	return &DemandBuffer[T]{
+		name:     "demand-buffer",
+		capacity: 1000,
+		signal:   make(chan struct{}, 1),
+	}
+}
+
+// WithBufferSize sets how many upstream items are held while there is no demand.
+// Values below 1 are ignored.
+// If not set, defaults to 1000.
+func (d *DemandBuffer[T]) WithBufferSize(size int) *DemandBuffer[T] {
+	if size > 0 {
+		d.capacity = size
+	}
+	return d
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "demand-buffer".
+func (d *DemandBuffer[T]) WithName(name string) *DemandBuffer[T] {
+	d.name = name
+	return d
+}
+
+// Request signals demand for n more items. Non-positive values are ignored.
+// Safe to call concurrently with Process.
+func (d *DemandBuffer[T]) Request(n int) {
+	if n <= 0 {
+		return
+	}
+	d.demand.Add(int64(n))
+
+	// Wake the processing goroutine if it is waiting for demand
+	select {
+	case d.signal <- struct{}{}:
+	default:
+	}
+}
+
+// Demand returns the outstanding demand not yet satisfied.
+func (d *DemandBuffer[T]) Demand() int64 {
+	return d.demand.Load()
+}
+
+// Process forwards items from in as demand permits.
+func (d *DemandBuffer[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		buffer := make([]Result[T], 0, d.capacity)
+
+		for {
+			// Stop reading while full; stop sending while there is no demand
+			input := in
+			if len(buffer) >= d.capacity {
+				input = nil
+			}
+
+			var output chan<- Result[T]
+			var next Result[T]
+			if len(buffer) > 0 && d.demand.Load() > 0 {
+				output = out
+				next = buffer[0]
+			}
+
+			if input == nil && len(buffer) == 0 {
+				// Input closed and everything has been delivered
+				return
+			}
+
+			select {
+			case item, ok := <-input:
+				if !ok {
+					in = nil
+					continue
+				}
+				buffer = append(buffer, item)
+			case output <- next:
+				d.demand.Add(-1)
+				buffer[0] = Result[T]{}
+				buffer = buffer[1:]
+			case <-d.signal:
+				// Demand changed; re-evaluate on the next iteration
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (d *DemandBuffer[T]) Name() string {
+	return d.name
+}
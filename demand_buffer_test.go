@@ -0,0 +1,146 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// expectNoItem fails if out yields anything within a short grace period.
+func expectNoItem(t *testing.T, out <-chan Result[int]) {
+	t.Helper()
+	select {
+	case result, ok := <-out:
+		if ok {
+			t.Fatalf("expected no item without demand, got %v", result)
+		}
+		t.Fatal("expected output to stay open")
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func receiveN(t *testing.T, out <-chan Result[int], n int) []int {
+	t.Helper()
+	values := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		select {
+		case result := <-out:
+			values = append(values, result.Value())
+		case <-time.After(time.Second):
+			t.Fatalf("timed out after %d of %d items", i, n)
+		}
+	}
+	return values
+}
+
+func TestDemandBuffer_Name(t *testing.T) {
+	demand := NewDemandBuffer[int]()
+	if demand.Name() != "demand-buffer" {
+		t.Errorf("expected name 'demand-buffer', got %q", demand.Name())
+	}
+	if demand.WithName("subscriber").Name() != "subscriber" {
+		t.Errorf("expected name 'subscriber', got %q", demand.Name())
+	}
+}
+
+func TestDemandBuffer_ForwardsOnlyRequested(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int], 10)
+	for i := 1; i <= 6; i++ {
+		in <- NewSuccess(i)
+	}
+	close(in)
+
+	demand := NewDemandBuffer[int]()
+	out := demand.Process(ctx, in)
+
+	// Zero demand: nothing flows
+	expectNoItem(t, out)
+
+	demand.Request(3)
+	if values := receiveN(t, out, 3); values[0] != 1 || values[2] != 3 {
+		t.Errorf("expected items 1-3, got %v", values)
+	}
+	expectNoItem(t, out)
+	if demand.Demand() != 0 {
+		t.Errorf("expected demand to be used up, got %d", demand.Demand())
+	}
+
+	demand.Request(2)
+	if values := receiveN(t, out, 2); values[0] != 4 || values[1] != 5 {
+		t.Errorf("expected items 4-5, got %v", values)
+	}
+	expectNoItem(t, out)
+
+	// Excess demand drains the buffer and closes the output
+	demand.Request(10)
+	if values := receiveN(t, out, 1); values[0] != 6 {
+		t.Errorf("expected item 6, got %v", values)
+	}
+	if _, ok := <-out; ok {
+		t.Error("expected output to be closed")
+	}
+	if demand.Demand() != 9 {
+		t.Errorf("expected 9 outstanding demand, got %d", demand.Demand())
+	}
+}
+
+func TestDemandBuffer_DemandBeforeProcess(t *testing.T) {
+	in := make(chan Result[int], 3)
+	in <- NewSuccess(1)
+	in <- NewError(2, errors.New("bad"), "parser")
+	in <- NewSuccess(3)
+	close(in)
+
+	demand := NewDemandBuffer[int]()
+	demand.Request(1)
+	demand.Request(0)
+	demand.Request(-5)
+	demand.Request(1)
+
+	out := demand.Process(context.Background(), in)
+	first := <-out
+	second := <-out
+	if first.Value() != 1 || !second.IsError() {
+		t.Errorf("expected success then error, got %v and %v", first, second)
+	}
+	expectNoItem(t, out)
+}
+
+func TestDemandBuffer_CancelUnblocksProducer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[int])
+	demand := NewDemandBuffer[int]().WithBufferSize(1)
+	out := demand.Process(ctx, in)
+
+	producerDone := make(chan struct{})
+	go func() {
+		defer close(producerDone)
+		for i := 0; ; i++ {
+			select {
+			case in <- NewSuccess(i):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// The buffer fills and the producer blocks waiting for demand
+	expectNoItem(t, out)
+	cancel()
+
+	select {
+	case <-producerDone:
+	case <-time.After(time.Second):
+		t.Fatal("expected producer to be unblocked by cancellation")
+	}
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Error("expected no items after cancellation")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected output to close after cancellation")
+	}
+}
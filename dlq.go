@@ -46,9 +46,12 @@ import (
 //	successes, _ := dlq.Process(ctx, orders)
 //	// failures channel ignored - items will be dropped and logged
 type DeadLetterQueue[T any] struct {
-	clock        Clock         // 8 bytes (pointer)
-	name         string        // 16 bytes (pointer + len)
-	droppedCount atomic.Uint64 // 8 bytes
+	clock           Clock                        // 8 bytes (pointer)
+	name            string                       // 16 bytes (pointer + len)
+	dedupKey        func(*StreamError[T]) string // 8 bytes (pointer)
+	dedupTTL        time.Duration                // 8 bytes
+	droppedCount    atomic.Uint64                // 8 bytes
+	suppressedCount atomic.Uint64                // 8 bytes
 }
 
 // NewDeadLetterQueue creates a new DeadLetterQueue processor.
@@ -67,6 +70,25 @@ func (dlq *DeadLetterQueue[T]) WithName(name string) *DeadLetterQueue[T] {
 	return dlq
 }
 
+// WithErrorDedup collapses identical errors to protect the failure channel from
+// error storms. The first error for a key is forwarded and any further errors with
+// the same key are suppressed until ttl has elapsed, after which the next one is
+// forwarded and starts a new suppression window. Suppressed errors are counted
+// by SuppressedCount. Successes are unaffected.
+//
+// Example:
+//
+//	// Forward each distinct failure at most once per minute
+//	dlq := streamz.NewDeadLetterQueue[Order](streamz.RealClock).
+//		WithErrorDedup(func(err *streamz.StreamError[Order]) string {
+//			return err.ProcessorName + ": " + err.Err.Error()
+//		}, time.Minute)
+func (dlq *DeadLetterQueue[T]) WithErrorDedup(keyFn func(*StreamError[T]) string, ttl time.Duration) *DeadLetterQueue[T] {
+	dlq.dedupKey = keyFn
+	dlq.dedupTTL = ttl
+	return dlq
+}
+
 // Name returns the processor name.
 func (dlq *DeadLetterQueue[T]) Name() string {
 	return dlq.name
@@ -77,6 +99,11 @@ func (dlq *DeadLetterQueue[T]) DroppedCount() uint64 {
 	return dlq.droppedCount.Load()
 }
 
+// SuppressedCount returns the number of errors collapsed by WithErrorDedup.
+func (dlq *DeadLetterQueue[T]) SuppressedCount() uint64 {
+	return dlq.suppressedCount.Load()
+}
+
 // Process separates the input stream into success and failure channels.
 // Returns two channels: (successes, failures).
 //
@@ -101,6 +128,12 @@ func (dlq *DeadLetterQueue[T]) distribute(ctx context.Context, in <-chan Result[
 	defer close(successCh)
 	defer close(failureCh)
 
+	// Forwarding time of the last error per dedup key, owned by this goroutine
+	var dedup *errorDedup
+	if dlq.dedupKey != nil {
+		dedup = &errorDedup{ttl: dlq.dedupTTL, forwarded: make(map[string]time.Time)}
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -111,6 +144,10 @@ func (dlq *DeadLetterQueue[T]) distribute(ctx context.Context, in <-chan Result[
 			}
 
 			if result.IsError() {
+				if dedup != nil && dedup.suppress(dlq.dedupKey(result.Error()), dlq.clock.Now()) {
+					dlq.suppressedCount.Add(1)
+					continue
+				}
 				dlq.sendToFailures(ctx, result, failureCh)
 			} else {
 				dlq.sendToSuccesses(ctx, result, successCh)
@@ -160,3 +197,30 @@ func (dlq *DeadLetterQueue[T]) handleDroppedItem(result Result[T], channelType s
 		log.Printf("DLQ[%s]: Dropped item from %s channel - value: %+v", dlq.name, channelType, result.Value())
 	}
 }
+
+// errorDedup tracks recently forwarded error keys for WithErrorDedup.
+type errorDedup struct {
+	ttl       time.Duration
+	forwarded map[string]time.Time
+	lastSweep time.Time
+}
+
+// suppress reports whether an error with key should be suppressed at now,
+// recording it as forwarded otherwise. Expired keys are swept at most once
+// per ttl to keep memory bounded by the number of keys active within a ttl.
+func (d *errorDedup) suppress(key string, now time.Time) bool {
+	if now.Sub(d.lastSweep) >= d.ttl {
+		for k, at := range d.forwarded {
+			if now.Sub(at) >= d.ttl {
+				delete(d.forwarded, k)
+			}
+		}
+		d.lastSweep = now
+	}
+
+	if at, ok := d.forwarded[key]; ok && now.Sub(at) < d.ttl {
+		return true
+	}
+	d.forwarded[key] = now
+	return false
+}
@@ -831,3 +831,99 @@ func TestDeadLetterQueue_ContextCancellationDuringTimeout(t *testing.T) {
 	droppedCount := dlq.DroppedCount()
 	t.Logf("Drops during context cancellation: %d (timing dependent)", droppedCount)
 }
+
+func TestDeadLetterQueue_ErrorDedup(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	dlq := NewDeadLetterQueue[int](clock).WithErrorDedup(func(err *StreamError[int]) string {
+		return err.ProcessorName + ": " + err.Err.Error()
+	}, time.Minute)
+
+	input := make(chan Result[int])
+	successes, failures := dlq.Process(ctx, input)
+
+	refused := errors.New("connection refused")
+	flood := func(n int) {
+		for i := 0; i < n; i++ {
+			input <- NewError(i, refused, "db-writer")
+		}
+	}
+	// forwarded sends items in the background, followed by a success sentinel, and
+	// counts the errors forwarded. Items are handled in order, so every forward for
+	// the sent items is received before the sentinel.
+	forwarded := func(send func()) int {
+		go func() {
+			send()
+			input <- NewSuccess(-1)
+		}()
+		count := 0
+		for {
+			select {
+			case <-failures:
+				count++
+			case <-successes:
+				return count
+			}
+		}
+	}
+
+	if got := forwarded(func() {
+		flood(50)
+		input <- NewError(0, errors.New("timeout"), "db-writer") // distinct message
+		input <- NewError(0, refused, "cache-writer")            // distinct processor
+		flood(50)
+	}); got != 3 {
+		t.Errorf("expected 3 distinct errors forwarded, got %d", got)
+	}
+	if got := dlq.SuppressedCount(); got != 99 {
+		t.Errorf("expected 99 suppressed, got %d", got)
+	}
+
+	// Still within the ttl of the first forward
+	clock.Advance(time.Minute - time.Second)
+	if got := forwarded(func() { flood(10) }); got != 0 {
+		t.Errorf("expected no errors forwarded within ttl, got %d", got)
+	}
+
+	// A new ttl window forwards one more
+	clock.Advance(time.Second)
+	if got := forwarded(func() { flood(10) }); got != 1 {
+		t.Errorf("expected 1 error forwarded in new window, got %d", got)
+	}
+	if got := dlq.SuppressedCount(); got != 99+10+9 {
+		t.Errorf("expected %d suppressed, got %d", 99+10+9, got)
+	}
+
+	close(input)
+	//nolint:revive // empty-block: intentional channel draining
+	for range successes {
+	}
+	if got := dlq.DroppedCount(); got != 0 {
+		t.Errorf("expected suppressed errors not counted as dropped, got %d", got)
+	}
+}
+
+func TestDeadLetterQueue_NoErrorDedupByDefault(t *testing.T) {
+	ctx := context.Background()
+	dlq := NewDeadLetterQueue[int](RealClock)
+
+	input := make(chan Result[int], 5)
+	for i := 0; i < 5; i++ {
+		input <- NewError(i, errors.New("same"), "writer")
+	}
+	close(input)
+
+	successes, failures := dlq.Process(ctx, input)
+	go func() {
+		for range successes {
+		}
+	}()
+
+	count := 0
+	for range failures {
+		count++
+	}
+	if count != 5 || dlq.SuppressedCount() != 0 {
+		t.Errorf("expected all 5 identical errors forwarded, got %d (suppressed %d)", count, dlq.SuppressedCount())
+	}
+}
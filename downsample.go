@@ -0,0 +1,162 @@
+package streamz
+
+import (
+	"context"
+	"time"
+)
+
+// DownsampleSet holds the representative items of one Downsample window.
+// A single item can fill several roles, for example when it is both the first
+// and the minimum of its window.
+type DownsampleSet[T any] struct {
+	First T   // Earliest item in the window
+	Last  T   // Latest item in the window
+	Min   T   // Item with the lowest value; the earliest wins ties
+	Max   T   // Item with the highest value; the earliest wins ties
+	Count int // Number of items the window held
+}
+
+// Downsample reduces a high-frequency stream to a fixed set of items per time
+// window — the first, last, minimum, and maximum — in the style of OHLC bars.
+// The reduction keeps the shape and extremes of a series at a fraction of its
+// volume, which suits long-term time-series storage.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Downsample[T any] struct {
+	name    string
+	valueFn func(T) float64
+	window  time.Duration
+	clock   Clock
+}
+
+// NewDownsample creates a processor that emits one DownsampleSet per window.
+// Windows are consecutive and non-overlapping, starting when Process is called.
+// Each set is emitted when its window closes, carrying the window metadata
+// (see GetWindowMetadata). Windows with no items emit nothing, and a partial
+// window is flushed when the input closes. Only four items are held per window.
+//
+// Errors pass through immediately as error Results and are not sampled.
+//
+// When to use:
+//   - Storing sensor or price series at reduced resolution
+//   - Rendering long time ranges without losing spikes
+//   - Building candlestick-style summaries of metrics
+//
+// Example:
+//
+//	// One OHLC-style bar per minute of price ticks
+//	bars := streamz.NewDownsample(func(t Tick) float64 {
+//		return t.Price
+//	}, time.Minute, streamz.RealClock)
+//
+//	for result := range bars.Process(ctx, ticks) {
+//		if result.IsSuccess() {
+//			meta, _ := streamz.GetWindowMetadata(result)
+//			bar := result.Value()
+//			store.Write(meta.Start, bar.First.Price, bar.Max.Price, bar.Min.Price, bar.Last.Price)
+//		}
+//	}
+//
+// Parameters:
+//   - valueFn: Extracts the value used to pick the minimum and maximum
+//   - window: Duration of each window
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new Downsample processor.
+func NewDownsample[T any](valueFn func(T) float64, window time.Duration, clock Clock) *Downsample[T] {
+	return &Downsample[T]{
+		name:    "downsample",
+		valueFn: valueFn,
+		window:  window,
+		clock:   clock,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "downsample".
+func (d *Downsample[T]) WithName(name string) *Downsample[T] {
+	d.name = name
+	return d
+}
+
+// Process reduces each window of items to its first, last, minimum, and maximum.
+func (d *Downsample[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[DownsampleSet[T]] {
+	out := make(chan Result[DownsampleSet[T]])
+
+	go func() {
+		defer close(out)
+
+		ticker := d.clock.NewTicker(d.window)
+		defer ticker.Stop()
+
+		start := d.clock.Now()
+		current := WindowMetadata{Start: start, End: start.Add(d.window), Type: "tumbling", Size: d.window}
+		var set DownsampleSet[T]
+		var minValue, maxValue float64
+
+		emit := func() bool {
+			if set.Count == 0 {
+				return true
+			}
+			result := AddWindowMetadata(NewSuccess(set), current)
+			set = DownsampleSet[T]{}
+
+			select {
+			case out <- result:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					emit()
+					return
+				}
+
+				if item.IsError() {
+					select {
+					case out <- NewError(DownsampleSet[T]{}, item.Error().Err, item.Error().ProcessorName):
+					case <-ctx.Done():
+						return
+					}
+					continue
+				}
+
+				value := item.Value()
+				v := d.valueFn(value)
+				if set.Count == 0 {
+					set.First, set.Min, set.Max = value, value, value
+					minValue, maxValue = v, v
+				}
+				if v < minValue {
+					set.Min, minValue = value, v
+				}
+				if v > maxValue {
+					set.Max, maxValue = value, v
+				}
+				set.Last = value
+				set.Count++
+
+			case <-ticker.C():
+				if !emit() {
+					return
+				}
+				current = WindowMetadata{Start: current.End, End: current.End.Add(d.window), Type: "tumbling", Size: d.window}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (d *Downsample[T]) Name() string {
+	return d.name
+}
@@ -0,0 +1,109 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+type tick struct {
+	Seq   int
+	Price float64
+}
+
+func tickPrice(t tick) float64 { return t.Price }
+
+func TestDownsample_Name(t *testing.T) {
+	downsample := NewDownsample(tickPrice, time.Minute, RealClock)
+	if downsample.Name() != "downsample" {
+		t.Errorf("expected name 'downsample', got %q", downsample.Name())
+	}
+	if downsample.WithName("bars").Name() != "bars" {
+		t.Errorf("expected name 'bars', got %q", downsample.Name())
+	}
+}
+
+func TestDownsample_EmitsFirstLastMinMax(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	start := clock.Now()
+	downsample := NewDownsample(tickPrice, time.Minute, clock)
+
+	in := make(chan Result[tick])
+	out := downsample.Process(context.Background(), in)
+
+	for i, price := range []float64{10, 7, 12, 7, 12, 9} {
+		in <- NewSuccess(tick{Seq: i + 1, Price: price})
+	}
+	// The unbuffered send above only returns once the previous item is sampled
+	in <- NewError(tick{Seq: 7}, errors.New("stale quote"), "feed")
+	if result := <-out; !result.IsError() || result.Error().ProcessorName != "feed" {
+		t.Fatalf("expected error passed through immediately, got %+v", result)
+	}
+
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+
+	result := <-out
+	if result.IsError() {
+		t.Fatalf("unexpected error: %v", result.Error())
+	}
+	set := result.Value()
+	if set.First.Seq != 1 || set.Last.Seq != 6 {
+		t.Errorf("expected first 1 and last 6, got %d and %d", set.First.Seq, set.Last.Seq)
+	}
+	// Equal extremes keep the earliest item
+	if set.Min.Seq != 2 || set.Max.Seq != 3 {
+		t.Errorf("expected min 2 and max 3, got %d and %d", set.Min.Seq, set.Max.Seq)
+	}
+	if set.Count != 6 {
+		t.Errorf("expected count 6, got %d", set.Count)
+	}
+
+	meta, err := GetWindowMetadata(result)
+	if err != nil {
+		t.Fatalf("expected window metadata: %v", err)
+	}
+	if !meta.Start.Equal(start) || !meta.End.Equal(start.Add(time.Minute)) {
+		t.Errorf("expected window [%v, %v), got [%v, %v)", start, start.Add(time.Minute), meta.Start, meta.End)
+	}
+	if meta.Type != "tumbling" || meta.Size != time.Minute {
+		t.Errorf("expected tumbling window of 1m, got %s of %v", meta.Type, meta.Size)
+	}
+
+	// A lone item fills every role of the flushed partial window
+	in <- NewSuccess(tick{Seq: 8, Price: 11})
+	close(in)
+
+	result = <-out
+	set = result.Value()
+	if set.First.Seq != 8 || set.Last.Seq != 8 || set.Min.Seq != 8 || set.Max.Seq != 8 || set.Count != 1 {
+		t.Errorf("expected item 8 in every role, got %+v", set)
+	}
+	meta, _ = GetWindowMetadata(result)
+	if !meta.Start.Equal(start.Add(time.Minute)) {
+		t.Errorf("expected second window to start at %v, got %v", start.Add(time.Minute), meta.Start)
+	}
+	if _, ok := <-out; ok {
+		t.Error("expected output closed")
+	}
+}
+
+func TestDownsample_EmptyWindowEmitsNothing(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	downsample := NewDownsample(tickPrice, time.Minute, clock)
+
+	in := make(chan Result[tick])
+	out := downsample.Process(context.Background(), in)
+
+	waitForTimer(t, clock)
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+	close(in)
+
+	if result, ok := <-out; ok {
+		t.Errorf("expected no output for empty window, got %v", result)
+	}
+}
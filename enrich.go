@@ -0,0 +1,214 @@
+package streamz
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// Enrich augments items with data fetched by key from an external source, such
+// as user profiles or geo data, caching lookups so that repeated keys do not
+// re-query the source.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Enrich[T, E any] struct {
+	name        string
+	lookup      func(context.Context, string) (E, error)
+	keyFn       func(T) string
+	merge       func(T, E) T
+	clock       Clock
+	ttl         time.Duration
+	workers     int
+	passThrough bool
+
+	mu        sync.Mutex
+	cache     map[string]enrichEntry[E]
+	inflight  map[string]*enrichCall[E]
+	lastSweep time.Time
+
+	joined func() // called when a lookup waits on one already in flight; set by tests
+}
+
+// enrichEntry is a cached lookup result and when it was fetched.
+type enrichEntry[E any] struct {
+	value   E
+	fetched time.Time
+}
+
+// enrichCall is a lookup in progress that concurrent requests for the same key wait on.
+type enrichCall[E any] struct {
+	done  chan struct{}
+	value E
+	err   error
+}
+
+// NewEnrich creates a processor that merges looked-up data into each item.
+// For every successful item, the key from keyFn is looked up and the item is
+// replaced by merge(item, data). Successful lookups are cached for five minutes
+// unless configured with WithCacheTTL; concurrent requests for a key that is
+// already being looked up share that lookup. Failed lookups are not cached.
+//
+// Lookups run on up to runtime.NumCPU() workers unless configured with
+// WithWorkers, and output keeps input order. As with AsyncMapper, metadata is
+// not carried over. Errors pass through without a lookup.
+//
+// When a lookup fails, the item becomes an error Result carrying the original
+// item, or with WithPassThroughOnError is forwarded un-enriched.
+//
+// When to use:
+//   - Attaching user, account, or device details to events
+//   - Resolving IDs to names or IPs to locations
+//   - Joining a stream against a slowly changing remote table
+//
+// Example:
+//
+//	enrich := streamz.NewEnrich(
+//		func(ctx context.Context, userID string) (User, error) {
+//			return users.Get(ctx, userID)
+//		},
+//		func(e LogEntry) string { return e.UserID },
+//		func(e LogEntry, u User) LogEntry {
+//			e.UserName = u.Name
+//			return e
+//		},
+//		streamz.RealClock,
+//	).WithCacheTTL(10 * time.Minute).WithWorkers(8).WithPassThroughOnError(true)
+//
+//	entries = enrich.Process(ctx, entries)
+//
+// Parameters:
+//   - lookup: Fetches enrichment data for a key; must be safe for concurrent use
+//   - keyFn: Extracts the lookup key from an item
+//   - merge: Returns the item combined with its enrichment data
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new Enrich processor.
+func NewEnrich[T, E any](
+	lookup func(ctx context.Context, key string) (E, error),
+	keyFn func(T) string,
+	merge func(T, E) T,
+	clock Clock,
+) *Enrich[T, E] {
+	return &Enrich[T, E]{
+		name:     "enrich",
+		lookup:   lookup,
+		keyFn:    keyFn,
+		merge:    merge,
+		clock:    clock,
+		ttl:      5 * time.Minute,
+		workers:  runtime.NumCPU(),
+		cache:    make(map[string]enrichEntry[E]),
+		inflight: make(map[string]*enrichCall[E]),
+	}
+}
+
+// WithCacheTTL sets how long a successful lookup is reused.
+// A non-positive TTL disables caching, so every item triggers a lookup.
+// If not set, defaults to five minutes.
+func (e *Enrich[T, E]) WithCacheTTL(ttl time.Duration) *Enrich[T, E] {
+	e.ttl = ttl
+	return e
+}
+
+// WithWorkers sets the maximum number of concurrent lookups.
+// If not set, defaults to runtime.NumCPU().
+func (e *Enrich[T, E]) WithWorkers(workers int) *Enrich[T, E] {
+	if workers > 0 {
+		e.workers = workers
+	}
+	return e
+}
+
+// WithPassThroughOnError forwards items whose lookup failed unchanged instead of
+// as error Results. If not set, lookup failures become errors.
+func (e *Enrich[T, E]) WithPassThroughOnError(passThrough bool) *Enrich[T, E] {
+	e.passThrough = passThrough
+	return e
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "enrich".
+func (e *Enrich[T, E]) WithName(name string) *Enrich[T, E] {
+	e.name = name
+	return e
+}
+
+// Process enriches each successful item, preserving input order.
+func (e *Enrich[T, E]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	mapper := NewAsyncMapper(e.enrich).
+		WithWorkers(e.workers).
+		WithName(e.name)
+	return mapper.Process(ctx, in)
+}
+
+// enrich looks up and merges the data for one item.
+func (e *Enrich[T, E]) enrich(ctx context.Context, item T) (T, error) {
+	data, err := e.get(ctx, e.keyFn(item))
+	if err != nil {
+		if e.passThrough {
+			return item, nil
+		}
+		return item, err
+	}
+	return e.merge(item, data), nil
+}
+
+// get returns the data for key from the cache, a lookup already in flight, or a new lookup.
+func (e *Enrich[T, E]) get(ctx context.Context, key string) (E, error) {
+	e.mu.Lock()
+	if entry, ok := e.cache[key]; ok && e.clock.Now().Sub(entry.fetched) < e.ttl {
+		e.mu.Unlock()
+		return entry.value, nil
+	}
+	if call, ok := e.inflight[key]; ok {
+		e.mu.Unlock()
+		if e.joined != nil {
+			e.joined()
+		}
+		select {
+		case <-call.done:
+			return call.value, call.err
+		case <-ctx.Done():
+			var zero E
+			return zero, ctx.Err()
+		}
+	}
+	call := &enrichCall[E]{done: make(chan struct{})}
+	e.inflight[key] = call
+	e.mu.Unlock()
+
+	call.value, call.err = e.lookup(ctx, key)
+
+	e.mu.Lock()
+	delete(e.inflight, key)
+	if call.err == nil && e.ttl > 0 {
+		e.store(key, call.value)
+	}
+	e.mu.Unlock()
+	close(call.done)
+
+	return call.value, call.err
+}
+
+// store caches value under key, sweeping expired entries at most once per TTL.
+// Caller must hold e.mu.
+func (e *Enrich[T, E]) store(key string, value E) {
+	now := e.clock.Now()
+	e.cache[key] = enrichEntry[E]{value: value, fetched: now}
+
+	if now.Sub(e.lastSweep) < e.ttl {
+		return
+	}
+	e.lastSweep = now
+	for k, entry := range e.cache {
+		if now.Sub(entry.fetched) >= e.ttl {
+			delete(e.cache, k)
+		}
+	}
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (e *Enrich[T, E]) Name() string {
+	return e.name
+}
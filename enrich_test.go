@@ -0,0 +1,201 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+type visit struct {
+	UserID string
+	Name   string
+}
+
+// countingLookup resolves user IDs to names and counts calls per key.
+type countingLookup struct {
+	mu    sync.Mutex
+	calls map[string]int
+	fail  map[string]bool
+}
+
+func newCountingLookup() *countingLookup {
+	return &countingLookup{calls: make(map[string]int), fail: make(map[string]bool)}
+}
+
+func (l *countingLookup) lookup(_ context.Context, id string) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.calls[id]++
+	if l.fail[id] {
+		return "", errors.New("user service unavailable")
+	}
+	return "name-" + id, nil
+}
+
+func (l *countingLookup) count(id string) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.calls[id]
+}
+
+func visitUser(v visit) string { return v.UserID }
+
+func mergeName(v visit, name string) visit {
+	v.Name = name
+	return v
+}
+
+// enrichOne sends a single visit through the running processor and returns the result.
+func enrichOne(in chan<- Result[visit], out <-chan Result[visit], id string) Result[visit] {
+	in <- NewSuccess(visit{UserID: id})
+	return <-out
+}
+
+func TestEnrich_Name(t *testing.T) {
+	enrich := NewEnrich(newCountingLookup().lookup, visitUser, mergeName, RealClock)
+	if enrich.Name() != "enrich" {
+		t.Errorf("expected name 'enrich', got %q", enrich.Name())
+	}
+	if enrich.WithName("user-names").Name() != "user-names" {
+		t.Errorf("expected name 'user-names', got %q", enrich.Name())
+	}
+}
+
+func TestEnrich_CacheHitAndMiss(t *testing.T) {
+	lookup := newCountingLookup()
+	enrich := NewEnrich(lookup.lookup, visitUser, mergeName, clockz.NewFakeClock()).WithWorkers(1)
+
+	in := make(chan Result[visit])
+	out := enrich.Process(context.Background(), in)
+	defer close(in)
+
+	// A miss looks the key up and merges the result
+	if result := enrichOne(in, out, "u1"); result.Value().Name != "name-u1" {
+		t.Errorf("expected merged name 'name-u1', got %q", result.Value().Name)
+	}
+	// A hit reuses the cached result
+	if result := enrichOne(in, out, "u1"); result.Value().Name != "name-u1" {
+		t.Errorf("expected cached name 'name-u1', got %q", result.Value().Name)
+	}
+	enrichOne(in, out, "u2")
+
+	if lookup.count("u1") != 1 {
+		t.Errorf("expected one lookup for u1, got %d", lookup.count("u1"))
+	}
+	if lookup.count("u2") != 1 {
+		t.Errorf("expected one lookup for u2, got %d", lookup.count("u2"))
+	}
+}
+
+func TestEnrich_TTLExpiryTriggersLookup(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	lookup := newCountingLookup()
+	enrich := NewEnrich(lookup.lookup, visitUser, mergeName, clock).WithCacheTTL(time.Minute).WithWorkers(1)
+
+	in := make(chan Result[visit])
+	out := enrich.Process(context.Background(), in)
+	defer close(in)
+
+	enrichOne(in, out, "u1")
+	clock.Advance(59 * time.Second)
+	enrichOne(in, out, "u1")
+	if lookup.count("u1") != 1 {
+		t.Errorf("expected cache hit within TTL, got %d lookups", lookup.count("u1"))
+	}
+
+	clock.Advance(time.Second)
+	enrichOne(in, out, "u1")
+	if lookup.count("u1") != 2 {
+		t.Errorf("expected lookup after TTL expiry, got %d lookups", lookup.count("u1"))
+	}
+}
+
+func TestEnrich_LookupFailurePolicy(t *testing.T) {
+	for _, passThrough := range []bool{false, true} {
+		lookup := newCountingLookup()
+		lookup.fail["u1"] = true
+		enrich := NewEnrich(lookup.lookup, visitUser, mergeName, clockz.NewFakeClock()).
+			WithPassThroughOnError(passThrough)
+
+		in := make(chan Result[visit], 2)
+		in <- NewSuccess(visit{UserID: "u1"})
+		in <- NewSuccess(visit{UserID: "u1"})
+		close(in)
+
+		var results []Result[visit]
+		for result := range enrich.Process(context.Background(), in) {
+			results = append(results, result)
+		}
+
+		for _, result := range results {
+			switch {
+			case passThrough && (result.IsError() || result.Value() != visit{UserID: "u1"}):
+				t.Errorf("expected un-enriched item passed through, got %v", result)
+			case !passThrough && !result.IsError():
+				t.Errorf("expected error result, got %v", result.Value())
+			case !passThrough && result.Error().Item.UserID != "u1":
+				t.Errorf("expected error to carry the original item, got %v", result.Error().Item)
+			}
+		}
+		// Failures are not cached
+		if lookup.count("u1") != 2 {
+			t.Errorf("expected failed lookups retried, got %d lookups", lookup.count("u1"))
+		}
+	}
+}
+
+func TestEnrich_ConcurrentMissesShareLookup(t *testing.T) {
+	entered := make(chan struct{}, 4)
+	release := make(chan struct{})
+	var calls atomic.Int32
+	enrich := NewEnrich(func(_ context.Context, id string) (string, error) {
+		calls.Add(1)
+		entered <- struct{}{}
+		<-release
+		return "name-" + id, nil
+	}, visitUser, mergeName, clockz.NewFakeClock()).WithWorkers(4)
+
+	joined := make(chan struct{}, 4)
+	enrich.joined = func() { joined <- struct{}{} }
+
+	in := make(chan Result[visit], 4)
+	for i := 0; i < 4; i++ {
+		in <- NewSuccess(visit{UserID: "u1"})
+	}
+	close(in)
+	out := enrich.Process(context.Background(), in)
+
+	// Hold the lookup until the other three workers are waiting on it
+	select {
+	case <-entered:
+	case <-time.After(time.Second):
+		t.Fatal("expected the lookup to start")
+	}
+	for i := 0; i < 3; i++ {
+		select {
+		case <-joined:
+		case <-time.After(time.Second):
+			t.Fatalf("expected 3 workers waiting on the lookup, got %d", i)
+		}
+	}
+	close(release)
+
+	count := 0
+	for result := range out {
+		if result.Value().Name != "name-u1" {
+			t.Errorf("expected merged name, got %q", result.Value().Name)
+		}
+		count++
+	}
+	if count != 4 {
+		t.Errorf("expected 4 results, got %d", count)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected concurrent misses to share one lookup, got %d", calls.Load())
+	}
+}
@@ -0,0 +1,116 @@
+package streamz
+
+import (
+	"context"
+)
+
+// Envelope describes the outcome of one Result as a plain value: exactly one of
+// Value and Err is set. It lets stages that only handle successes, such as a
+// Batcher, carry errors along instead of passing them around or dropping them.
+type Envelope[T any] struct {
+	Value *T              // Successful value, nil for errors
+	Err   *StreamError[T] // Error, nil for successes
+}
+
+// IsError reports whether the envelope holds an error.
+func (e Envelope[T]) IsError() bool {
+	return e.Err != nil
+}
+
+// Result converts the envelope back into the Result it was made from, without
+// its metadata, which stays on the Result carrying the envelope.
+func (e Envelope[T]) Result() Result[T] {
+	if e.Err != nil {
+		return Result[T]{err: e.Err}
+	}
+	if e.Value == nil {
+		var zero T
+		return NewSuccess(zero)
+	}
+	return NewSuccess(*e.Value)
+}
+
+// Enveloper turns every Result into a successful Result carrying an Envelope,
+// so downstream code handles successes and errors through one explicit value
+// rather than branching on IsError.
+type Enveloper[T any] struct {
+	name string
+}
+
+// NewEnvelope creates a processor that maps each Result[T] to a successful Result[Envelope[T]].
+// Successes become envelopes with Value set, and errors become envelopes with
+// Err set to the original StreamError. Metadata is kept on the outer Result.
+// Call Envelope.Result to recover the original Result.
+//
+// When to use:
+//   - Batching successes and errors together without losing either
+//   - Feeding consumers that want an Either-style value
+//   - Serializing outcomes of both kinds to the same sink
+//
+// Example:
+//
+//	// Batch every outcome, errors included
+//	enveloped := streamz.NewEnvelope[Order]().Process(ctx, orders)
+//	batches := streamz.NewBatcher[streamz.Envelope[Order]](config, streamz.RealClock).Process(ctx, enveloped)
+//
+//	for batch := range batches {
+//		for _, envelope := range batch.Value() {
+//			if envelope.IsError() {
+//				failed = append(failed, envelope.Err)
+//				continue
+//			}
+//			written = append(written, *envelope.Value)
+//		}
+//	}
+//
+// Returns a new Enveloper processor.
+func NewEnvelope[T any]() *Enveloper[T] {
+	return &Enveloper[T]{
+		name: "envelope",
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "envelope".
+func (e *Enveloper[T]) WithName(name string) *Enveloper[T] {
+	e.name = name
+	return e
+}
+
+// Process wraps each Result in a successful Result carrying its Envelope.
+// The output channel closes when the input closes or the context is canceled.
+func (*Enveloper[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[Envelope[T]] {
+	out := make(chan Result[Envelope[T]])
+
+	go func() {
+		defer close(out)
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			var envelope Envelope[T]
+			if item.IsError() {
+				envelope.Err = item.Error()
+			} else {
+				value := item.Value()
+				envelope.Value = &value
+			}
+
+			select {
+			case out <- Result[Envelope[T]]{value: envelope, metadata: item.metadata}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (e *Enveloper[T]) Name() string {
+	return e.name
+}
@@ -0,0 +1,94 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// envelopeAll runs items through an Enveloper and collects the output.
+func envelopeAll(items ...Result[int]) []Result[Envelope[int]] {
+	in := make(chan Result[int], len(items))
+	for _, item := range items {
+		in <- item
+	}
+	close(in)
+
+	var results []Result[Envelope[int]]
+	for result := range NewEnvelope[int]().Process(context.Background(), in) {
+		results = append(results, result)
+	}
+	return results
+}
+
+func TestEnvelope_Name(t *testing.T) {
+	envelope := NewEnvelope[int]()
+	if envelope.Name() != "envelope" {
+		t.Errorf("expected name 'envelope', got %q", envelope.Name())
+	}
+	if envelope.WithName("outcomes").Name() != "outcomes" {
+		t.Errorf("expected name 'outcomes', got %q", envelope.Name())
+	}
+}
+
+func TestEnvelope_Success(t *testing.T) {
+	results := envelopeAll(NewSuccess(42).WithMetadata(MetadataSource, "api"))
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+
+	result := results[0]
+	if result.IsError() {
+		t.Fatalf("expected a successful envelope Result, got %v", result.Error())
+	}
+	envelope := result.Value()
+	if envelope.Value == nil || *envelope.Value != 42 || envelope.Err != nil || envelope.IsError() {
+		t.Errorf("expected Value 42 and nil Err, got %+v", envelope)
+	}
+	if source, _, _ := result.GetStringMetadata(MetadataSource); source != "api" {
+		t.Errorf("expected metadata preserved, got source %q", source)
+	}
+}
+
+func TestEnvelope_Error(t *testing.T) {
+	failure := errors.New("invalid order")
+	results := envelopeAll(NewError(7, failure, "validator"))
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+
+	if results[0].IsError() {
+		t.Fatal("expected errors to arrive as successful envelope Results")
+	}
+	envelope := results[0].Value()
+	if envelope.Value != nil || !envelope.IsError() {
+		t.Fatalf("expected Err set and nil Value, got %+v", envelope)
+	}
+	if !errors.Is(envelope.Err, failure) || envelope.Err.Item != 7 || envelope.Err.ProcessorName != "validator" {
+		t.Errorf("expected the original StreamError, got %v", envelope.Err)
+	}
+}
+
+func TestEnvelope_RoundTrip(t *testing.T) {
+	failure := errors.New("invalid order")
+	originals := []Result[int]{NewSuccess(1), NewError(2, failure, "validator"), NewSuccess(3)}
+	results := envelopeAll(originals...)
+	if len(results) != len(originals) {
+		t.Fatalf("expected %d results, got %d", len(originals), len(results))
+	}
+
+	for i, result := range results {
+		restored := result.Value().Result()
+		original := originals[i]
+		if restored.IsError() != original.IsError() {
+			t.Fatalf("item %d: expected error %v, got %v", i, original.IsError(), restored.IsError())
+		}
+		if original.IsError() {
+			if restored.Error() != original.Error() {
+				t.Errorf("item %d: expected the same StreamError, got %v", i, restored.Error())
+			}
+		} else if restored.Value() != original.Value() {
+			t.Errorf("item %d: expected value %d, got %d", i, original.Value(), restored.Value())
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// MetadataErrorCount records how many errors were folded into an aggregate error.
+const MetadataErrorCount = "error_count" // int - number of errors combined into this Result
+
+// ErrorAggregate coalesces the errors in each time window into a single summary
+// error, so a batch operation that fails item by item reports one error instead
+// of flooding downstream error handling.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type ErrorAggregate[T any] struct {
+	name   string
+	window time.Duration
+	clock  Clock
+}
+
+// NewErrorAggregate creates a processor that folds errors into one Result per window.
+// Successes pass through immediately and unchanged. Errors are held until the end
+// of the window, then emitted as a single error Result whose error joins every
+// individual cause with errors.Join and whose MetadataErrorCount holds the count.
+// The aggregate's item is that of the first error in the window. Windows with no
+// errors emit nothing. Pending errors are flushed when the input closes.
+//
+// When to use:
+//   - Summarizing per-item failures of a bulk write or batch call
+//   - Rate-limiting error alerts without losing the individual causes
+//   - Reducing load on error sinks during incident bursts
+//
+// Example:
+//
+//	// One error report per minute, however many items failed
+//	aggregate := streamz.NewErrorAggregate[Record](time.Minute, streamz.RealClock)
+//
+//	for result := range aggregate.Process(ctx, written) {
+//		if result.IsError() {
+//			count, _, _ := result.GetIntMetadata(streamz.MetadataErrorCount)
+//			alert.Send(fmt.Sprintf("%d writes failed: %v", count, result.Error().Err))
+//		}
+//	}
+//
+// Parameters:
+//   - window: Duration of each aggregation window
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new ErrorAggregate processor.
+func NewErrorAggregate[T any](window time.Duration, clock Clock) *ErrorAggregate[T] {
+	return &ErrorAggregate[T]{
+		name:   "error-aggregate",
+		window: window,
+		clock:  clock,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "error-aggregate".
+func (a *ErrorAggregate[T]) WithName(name string) *ErrorAggregate[T] {
+	a.name = name
+	return a
+}
+
+// Process forwards successes and emits one aggregate error per window.
+func (a *ErrorAggregate[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		ticker := a.clock.NewTicker(a.window)
+		defer ticker.Stop()
+
+		var pending []error
+		var first T
+
+		flush := func() bool {
+			if len(pending) == 0 {
+				return true
+			}
+			aggregate := NewError(first, errors.Join(pending...), a.name).
+				WithMetadata(MetadataErrorCount, len(pending))
+			pending = nil
+
+			select {
+			case out <- aggregate:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+
+				if item.IsError() {
+					if len(pending) == 0 {
+						first = item.Error().Item
+					}
+					pending = append(pending, item.Error())
+					continue
+				}
+
+				select {
+				case out <- item:
+				case <-ctx.Done():
+					return
+				}
+
+			case <-ticker.C():
+				if !flush() {
+					return
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (a *ErrorAggregate[T]) Name() string {
+	return a.name
+}
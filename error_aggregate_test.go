@@ -0,0 +1,94 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+var errWriteFailed = errors.New("write failed")
+
+func TestErrorAggregate_Name(t *testing.T) {
+	aggregate := NewErrorAggregate[int](time.Second, RealClock)
+	if aggregate.Name() != "error-aggregate" {
+		t.Errorf("expected name 'error-aggregate', got %q", aggregate.Name())
+	}
+	if aggregate.WithName("bulk-errors").Name() != "bulk-errors" {
+		t.Errorf("expected name 'bulk-errors', got %q", aggregate.Name())
+	}
+}
+
+func TestErrorAggregate_JoinsErrorsPerWindow(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	in := make(chan Result[int])
+	out := NewErrorAggregate[int](time.Minute, clock).Process(ctx, in)
+
+	in <- NewError(1, errWriteFailed, "writer")
+	in <- NewSuccess(2)
+	if result := <-out; !result.IsSuccess() || result.Value() != 2 {
+		t.Fatalf("expected success to pass through immediately, got %v", result)
+	}
+	in <- NewError(3, errors.New("timeout"), "writer")
+	in <- NewError(4, errWriteFailed, "writer")
+
+	// A success confirms the errors have been collected
+	in <- NewSuccess(5)
+	<-out
+
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+
+	result := <-out
+	if !result.IsError() {
+		t.Fatalf("expected aggregate error, got %v", result)
+	}
+	if count, _, _ := result.GetIntMetadata(MetadataErrorCount); count != 3 {
+		t.Errorf("expected error count 3, got %d", count)
+	}
+	if result.Error().ProcessorName != "error-aggregate" {
+		t.Errorf("expected processor name 'error-aggregate', got %q", result.Error().ProcessorName)
+	}
+	if result.Error().Item != 1 {
+		t.Errorf("expected first error's item, got %d", result.Error().Item)
+	}
+	if !errors.Is(result.Error(), errWriteFailed) {
+		t.Error("expected aggregate to wrap the individual causes")
+	}
+
+	// A window with only successes produces no error
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+	in <- NewSuccess(6)
+	if result := <-out; !result.IsSuccess() || result.Value() != 6 {
+		t.Errorf("expected only the success, got %v", result)
+	}
+	close(in)
+
+	if result, ok := <-out; ok {
+		t.Errorf("expected output to close without an aggregate, got %v", result)
+	}
+}
+
+func TestErrorAggregate_FlushesOnClose(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[int], 2)
+	in <- NewError(1, errWriteFailed, "writer")
+	in <- NewError(2, errWriteFailed, "writer")
+	close(in)
+
+	var results []Result[int]
+	for result := range NewErrorAggregate[int](time.Minute, clock).Process(context.Background(), in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 1 || !results[0].IsError() {
+		t.Fatalf("expected a single aggregate error, got %v", results)
+	}
+	if count, _, _ := results[0].GetIntMetadata(MetadataErrorCount); count != 2 {
+		t.Errorf("expected error count 2, got %d", count)
+	}
+}
@@ -0,0 +1,141 @@
+package streamz
+
+import (
+	"context"
+	"sync"
+)
+
+// FailFast forwards items until it sees an unrecoverable error, then emits that
+// error, closes its output, and cancels a context it owns so upstream stages stop
+// producing. Non-fatal errors flow through like any other item.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type FailFast[T any] struct {
+	name    string
+	isFatal func(*StreamError[T]) bool
+	mu      sync.Mutex
+	cancel  context.CancelFunc
+	fatal   *StreamError[T]
+}
+
+// NewFailFast creates a processor that aborts the pipeline on the first fatal error.
+// Pass the context returned by Context to upstream processors so they are
+// canceled when a fatal error is seen. Without Context, only FailFast itself
+// stops on a fatal error: it stops reading, and upstream stages keep running
+// until their own context is canceled or their input ends.
+//
+// When to use:
+//   - Aborting a batch job on corrupt input or lost credentials
+//   - Halting writes when a downstream system rejects the schema
+//   - Any pipeline where continuing after certain errors is unsafe
+//
+// Example:
+//
+//	failFast := streamz.NewFailFast(func(err *streamz.StreamError[Record]) bool {
+//		return errors.Is(err, ErrSchemaMismatch)
+//	})
+//
+//	// Upstream stages stop when a fatal error is seen
+//	ctx = failFast.Context(ctx)
+//	parsed := parser.Process(ctx, source)
+//
+//	for result := range failFast.Process(ctx, parsed) {
+//		...
+//	}
+//	if err := failFast.FatalError(); err != nil {
+//		log.Fatalf("pipeline aborted: %v", err)
+//	}
+//
+// Parameters:
+//   - isFatal: Reports whether an error should halt the pipeline
+//
+// Returns a new FailFast processor.
+func NewFailFast[T any](isFatal func(*StreamError[T]) bool) *FailFast[T] {
+	return &FailFast[T]{
+		name:    "fail-fast",
+		isFatal: isFatal,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "fail-fast".
+func (f *FailFast[T]) WithName(name string) *FailFast[T] {
+	f.name = name
+	return f
+}
+
+// Context derives a context from parent that is canceled when a fatal error is seen.
+// Pass it to upstream processors (and to Process) so the whole pipeline stops.
+// The context is also canceled once Process finishes for any other reason, so it
+// should not outlive the pipeline. If Context is never called, Process derives a
+// private context from the one it is given, which no upstream stage can observe.
+func (f *FailFast[T]) Context(parent context.Context) context.Context {
+	ctx, cancel := context.WithCancel(parent)
+	f.mu.Lock()
+	f.cancel = cancel
+	f.mu.Unlock()
+	return ctx
+}
+
+// FatalError returns the error that halted processing, or nil if none has been seen.
+func (f *FailFast[T]) FatalError() *StreamError[T] {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.fatal
+}
+
+// Process forwards items until a fatal error is seen.
+// The fatal error is emitted, the owned context is canceled, and the output closes
+// without reading further input. The owned context is canceled as well when the
+// input closes or ctx is canceled, releasing its resources.
+func (f *FailFast[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	f.mu.Lock()
+	if f.cancel == nil {
+		ctx, f.cancel = context.WithCancel(ctx)
+	}
+	cancel := f.cancel
+	f.mu.Unlock()
+
+	go func() {
+		defer close(out)
+		defer cancel()
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+
+				fatal := item.IsError() && f.isFatal(item.Error())
+				if fatal {
+					f.mu.Lock()
+					f.fatal = item.Error()
+					f.mu.Unlock()
+				}
+
+				select {
+				case out <- item:
+				case <-ctx.Done():
+					return
+				}
+
+				if fatal {
+					cancel()
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (f *FailFast[T]) Name() string {
+	return f.name
+}
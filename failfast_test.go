@@ -0,0 +1,175 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var errFatal = errors.New("fatal")
+
+func isFatalError(err *StreamError[int]) bool {
+	return errors.Is(err, errFatal)
+}
+
+func TestFailFast_Name(t *testing.T) {
+	failFast := NewFailFast(isFatalError)
+	if failFast.Name() != "fail-fast" {
+		t.Errorf("expected name 'fail-fast', got %q", failFast.Name())
+	}
+	if failFast.WithName("abort-on-schema").Name() != "abort-on-schema" {
+		t.Errorf("expected name 'abort-on-schema', got %q", failFast.Name())
+	}
+}
+
+func TestFailFast_NonFatalErrorsPassThrough(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int], 3)
+	in <- NewSuccess(1)
+	in <- NewError(2, errors.New("retryable"), "fetcher")
+	in <- NewSuccess(3)
+	close(in)
+
+	failFast := NewFailFast(isFatalError)
+	var results []Result[int]
+	for result := range failFast.Process(ctx, in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if !results[1].IsError() || results[2].Value() != 3 {
+		t.Errorf("expected processing to continue after non-fatal error, got %v", results)
+	}
+	if failFast.FatalError() != nil {
+		t.Errorf("expected no fatal error, got %v", failFast.FatalError())
+	}
+}
+
+func TestFailFast_FatalErrorClosesOutput(t *testing.T) {
+	in := make(chan Result[int], 4)
+	in <- NewSuccess(1)
+	in <- NewError(2, errFatal, "parser")
+	in <- NewSuccess(3)
+	in <- NewSuccess(4)
+
+	failFast := NewFailFast(isFatalError)
+	ctx := failFast.Context(context.Background())
+
+	var results []Result[int]
+	for result := range failFast.Process(ctx, in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("expected output to close after fatal error, got %d results", len(results))
+	}
+	if !errors.Is(results[1].Error(), errFatal) {
+		t.Errorf("expected fatal error to be emitted last, got %v", results[1])
+	}
+	if fatal := failFast.FatalError(); fatal == nil || fatal.Item != 2 {
+		t.Errorf("expected fatal error for item 2, got %v", fatal)
+	}
+	if len(in) != 2 {
+		t.Errorf("expected remaining input to be left unread, got %d left", len(in))
+	}
+}
+
+func TestFailFast_CancelsDerivedContext(t *testing.T) {
+	failFast := NewFailFast(isFatalError)
+	ctx := failFast.Context(context.Background())
+
+	// Upstream stage uses the derived context and never closes its output
+	source := make(chan Result[int])
+	upstream := NewMapper(func(_ context.Context, n int) (int, error) {
+		if n == 3 {
+			return n, errFatal
+		}
+		return n, nil
+	}).Process(ctx, source)
+
+	go func() {
+		for i := 1; ; i++ {
+			select {
+			case source <- NewSuccess(i):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	count := 0
+	for range failFast.Process(ctx, upstream) {
+		count++
+	}
+
+	if count != 3 {
+		t.Errorf("expected 3 results up to the fatal error, got %d", count)
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected derived context to be canceled")
+	}
+}
+
+func TestFailFast_ReleasesDerivedContextOnClose(t *testing.T) {
+	in := make(chan Result[int], 2)
+	in <- NewSuccess(1)
+	in <- NewError(2, errors.New("retryable"), "fetcher")
+	close(in)
+
+	failFast := NewFailFast(isFatalError)
+	ctx := failFast.Context(context.Background())
+	//nolint:revive // empty-block: intentional channel draining
+	for range failFast.Process(ctx, in) {
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected derived context to be released after input closed")
+	}
+	if failFast.FatalError() != nil {
+		t.Errorf("expected no fatal error, got %v", failFast.FatalError())
+	}
+}
+
+func TestFailFast_ProcessOwnsContextWithoutContextCall(t *testing.T) {
+	in := make(chan Result[int], 1)
+	in <- NewError(1, errFatal, "parser")
+
+	failFast := NewFailFast(isFatalError)
+	out := failFast.Process(context.Background(), in)
+
+	<-out
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Error("expected output to close after fatal error")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("output didn't close after fatal error")
+	}
+}
+
+func TestFailFast_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[int])
+	out := NewFailFast(isFatalError).Process(ctx, in)
+
+	cancel()
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Error("expected output to be closed after cancellation")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("output didn't close after cancellation")
+	}
+	close(in)
+}
@@ -70,7 +70,11 @@ func (*FanIn[T]) Process(ctx context.Context, ins ...<-chan Result[T]) <-chan Re
 		wg.Add(1)
 		go func(ch <-chan Result[T]) {
 			defer wg.Done()
-			for result := range ch {
+			for {
+				result, ok := receive(ctx, ch)
+				if !ok {
+					return
+				}
 				select {
 				case out <- result:
 				case <-ctx.Done():
@@ -87,3 +91,81 @@ func (*FanIn[T]) Process(ctx context.Context, ins ...<-chan Result[T]) <-chan Re
 
 	return out
 }
+
+// FanInTagged merges multiple Result[T] input channels like FanIn, but stamps each
+// forwarded Result with the index of the input it came from. The index is stored
+// in MetadataSource as an int, so downstream processors such as Switch can route
+// on origin.
+type FanInTagged[T any] struct {
+	name string
+}
+
+// NewFanInTagged creates a processor that merges channels and records each item's source.
+// Both successful values and errors are tagged with their input index.
+//
+// When to use:
+//   - Merging streams while keeping track of where each item came from
+//   - Routing merged items by origin with Switch
+//   - Attributing errors to a specific upstream source
+//
+// Example:
+//
+//	fanin := streamz.NewFanInTagged[Event]()
+//	merged := fanin.Process(ctx, primary, replica, backfill)
+//
+//	for result := range merged {
+//		source, _, _ := result.GetIntMetadata(streamz.MetadataSource)
+//		log.Printf("event from input %d", source)
+//	}
+//
+// Returns a new FanInTagged processor.
+func NewFanInTagged[T any]() *FanInTagged[T] {
+	return &FanInTagged[T]{
+		name: "fanin-tagged",
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "fanin-tagged".
+func (f *FanInTagged[T]) WithName(name string) *FanInTagged[T] {
+	f.name = name
+	return f
+}
+
+// Process merges the inputs into a single channel, tagging each Result with
+// MetadataSource set to the index of its input in ins.
+// Existing MetadataSource values are overwritten.
+func (*FanInTagged[T]) Process(ctx context.Context, ins ...<-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+	var wg sync.WaitGroup
+
+	for i, in := range ins {
+		wg.Add(1)
+		go func(index int, ch <-chan Result[T]) {
+			defer wg.Done()
+			for {
+				result, ok := receive(ctx, ch)
+				if !ok {
+					return
+				}
+				select {
+				case out <- result.WithMetadata(MetadataSource, index):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(i, in)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (f *FanInTagged[T]) Name() string {
+	return f.name
+}
@@ -679,3 +679,94 @@ func TestFanIn_Result_HelperFunctionUsage(t *testing.T) {
 		t.Errorf("Expected 3 errors, got %d", errorCount)
 	}
 }
+
+func TestFanInTagged_Name(t *testing.T) {
+	fanin := NewFanInTagged[int]()
+	if fanin.Name() != "fanin-tagged" {
+		t.Errorf("expected name 'fanin-tagged', got %q", fanin.Name())
+	}
+	if fanin.WithName("merge-sources").Name() != "merge-sources" {
+		t.Errorf("expected name 'merge-sources', got %q", fanin.Name())
+	}
+}
+
+func TestFanInTagged_TagsSourceIndex(t *testing.T) {
+	ctx := context.Background()
+
+	// Values encode their source: 0-9 from input 0, 10-19 from input 1, 20-29 from input 2
+	ch0 := make(chan Result[int], 2)
+	ch0 <- NewSuccess(1)
+	ch0 <- NewSuccess(2)
+	close(ch0)
+
+	ch1 := make(chan Result[int], 2)
+	ch1 <- NewSuccess(11)
+	ch1 <- NewError(12, errors.New("source 1 failed"), "reader")
+	close(ch1)
+
+	ch2 := make(chan Result[int], 2)
+	ch2 <- NewError(21, errors.New("source 2 failed"), "reader")
+	ch2 <- NewSuccess(22).WithMetadata(MetadataSource, "overwritten")
+	close(ch2)
+
+	var results []Result[int]
+	for result := range NewFanInTagged[int]().Process(ctx, ch0, ch1, ch2) {
+		results = append(results, result)
+	}
+
+	if len(results) != 6 {
+		t.Fatalf("expected 6 results, got %d", len(results))
+	}
+
+	errorCount := 0
+	for _, result := range results {
+		var value int
+		if result.IsError() {
+			errorCount++
+			value = result.Error().Item
+		} else {
+			value = result.Value()
+		}
+
+		source, found, err := result.GetIntMetadata(MetadataSource)
+		if err != nil || !found {
+			t.Errorf("expected int source metadata for %d, got found=%v err=%v", value, found, err)
+			continue
+		}
+		if source != value/10 {
+			t.Errorf("expected source %d for %d, got %d", value/10, value, source)
+		}
+	}
+
+	if errorCount != 2 {
+		t.Errorf("expected 2 tagged errors, got %d", errorCount)
+	}
+}
+
+func TestFanInTagged_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan Result[int])
+
+	out := NewFanInTagged[int]().Process(ctx, ch)
+
+	// Producer is blocked on an unread output when the context is canceled
+	go func() {
+		ch <- NewSuccess(1)
+		close(ch)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		for range out {
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("output didn't close after cancellation")
+	}
+}
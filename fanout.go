@@ -2,15 +2,31 @@ package streamz
 
 import (
 	"context"
+	"slices"
+	"sync"
 )
 
+// MetadataDeliveryID identifies an item delivered by a FanOut with acknowledgments
+// enabled. Pass the Result back to FanOut.Ack to acknowledge it.
+const MetadataDeliveryID = "delivery_id" // uint64 - per-item delivery sequence shared by all branches
+
 // FanOut distributes Result[T] items from a single input channel to multiple output channels.
 // It implements the fan-out concurrency pattern using the Result[T] pattern for unified
 // error handling, duplicating each Result to all outputs, enabling parallel processing
 // of both successful values and errors.
+//
+// With acknowledgments enabled (WithAck), FanOut also tracks which items each
+// branch has yet to acknowledge, so a consumer recovering from a crash can find
+// the items it must reprocess.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
 type FanOut[T any] struct {
-	name  string
-	count int
+	name    string
+	count   int
+	ack     bool
+	mu      sync.Mutex
+	nextID  uint64
+	pending []map[uint64]Result[T] // per branch, keyed by delivery ID
 }
 
 // NewFanOut creates a processor that distributes Result[T] items to multiple output channels.
@@ -65,6 +81,112 @@ func NewFanOut[T any](count int) *FanOut[T] {
 	}
 }
 
+// WithAck enables per-branch acknowledgment tracking for at-least-once delivery.
+//
+// Delivery semantics with acknowledgments enabled:
+//   - Each input item is stamped with a MetadataDeliveryID shared by all branches
+//   - An item is pending for a branch from the moment FanOut starts sending it
+//     to that branch until the branch calls Ack with it
+//   - FanOut never re-sends an item by itself; Unacked returns a branch's pending
+//     items so a replacement consumer can reprocess them
+//   - Items can therefore be processed more than once (at-least-once), and
+//     consumers should be idempotent
+//
+// Pending items are held until acknowledged, so memory grows with the number of
+// unacknowledged items. Branches that never call Ack should not use this option.
+func (f *FanOut[T]) WithAck() *FanOut[T] {
+	f.ack = true
+	f.pending = make([]map[uint64]Result[T], f.count)
+	for i := range f.pending {
+		f.pending[i] = make(map[uint64]Result[T])
+	}
+	return f
+}
+
+// Ack acknowledges that branch has finished processing item, which must be a
+// Result received from that branch's output. It reports whether the item was
+// pending; acknowledging an unknown or already acknowledged item is a no-op.
+// Safe to call concurrently from every branch.
+func (f *FanOut[T]) Ack(branch int, item Result[T]) bool {
+	id, found := item.GetMetadata(MetadataDeliveryID)
+	if !found || !f.validBranch(branch) {
+		return false
+	}
+	deliveryID, ok := id.(uint64)
+	if !ok {
+		return false
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, pending := f.pending[branch][deliveryID]; !pending {
+		return false
+	}
+	delete(f.pending[branch], deliveryID)
+	return true
+}
+
+// PendingAcks returns the number of items delivered to branch and not yet acknowledged.
+// Returns 0 if acknowledgments are not enabled or branch is out of range.
+func (f *FanOut[T]) PendingAcks(branch int) int {
+	if !f.validBranch(branch) {
+		return 0
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.pending[branch])
+}
+
+// Unacked returns the items pending acknowledgment on branch in delivery order,
+// for redelivery to a replacement consumer. The items stay pending until acknowledged.
+func (f *FanOut[T]) Unacked(branch int) []Result[T] {
+	if !f.validBranch(branch) {
+		return nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ids := make([]uint64, 0, len(f.pending[branch]))
+	for id := range f.pending[branch] {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	items := make([]Result[T], len(ids))
+	for i, id := range ids {
+		items[i] = f.pending[branch][id]
+	}
+	return items
+}
+
+// validBranch reports whether acknowledgments are enabled and branch exists.
+func (f *FanOut[T]) validBranch(branch int) bool {
+	return f.ack && branch >= 0 && branch < len(f.pending)
+}
+
+// track stamps a delivery ID on result when acknowledgments are enabled.
+func (f *FanOut[T]) track(result Result[T]) Result[T] {
+	if !f.ack {
+		return result
+	}
+	f.mu.Lock()
+	f.nextID++
+	id := f.nextID
+	f.mu.Unlock()
+	return result.WithMetadata(MetadataDeliveryID, id)
+}
+
+// markPending records result as awaiting acknowledgment from branch.
+func (f *FanOut[T]) markPending(branch int, result Result[T]) {
+	if !f.ack {
+		return
+	}
+	id, _ := result.GetMetadata(MetadataDeliveryID)
+	f.mu.Lock()
+	f.pending[branch][id.(uint64)] = result //nolint:errcheck // stamped by track
+	f.mu.Unlock()
+}
+
 // Process distributes Result[T] items from input to multiple output channels.
 // Each Result (success or error) is duplicated to all output channels.
 // The processor respects context cancellation and properly closes all output channels.
@@ -84,8 +206,14 @@ func (f *FanOut[T]) Process(ctx context.Context, in <-chan Result[T]) []<-chan R
 			}
 		}()
 
-		for result := range in {
-			for _, ch := range channels {
+		for {
+			result, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+			result = f.track(result)
+			for i, ch := range channels {
+				f.markPending(i, result)
 				select {
 				case ch <- result:
 				case <-ctx.Done():
@@ -470,3 +470,80 @@ func BenchmarkFanOut_MultipleItems(b *testing.B) {
 		wg.Wait()
 	}
 }
+
+func TestFanOut_AckTracking(t *testing.T) {
+	ctx := context.Background()
+	fanout := NewFanOut[int](2).WithAck()
+
+	in := make(chan Result[int])
+	outputs := fanout.Process(ctx, in)
+
+	// Branch 0 acks everything; branch 1 receives but never acks
+	var branch0, branch1 []Result[int]
+	for i := 1; i <= 3; i++ {
+		in <- NewSuccess(i)
+		r0 := <-outputs[0]
+		r1 := <-outputs[1]
+		branch0 = append(branch0, r0)
+		branch1 = append(branch1, r1)
+
+		if !fanout.Ack(0, r0) {
+			t.Errorf("expected item %d pending on branch 0", i)
+		}
+		if pending := fanout.PendingAcks(1); pending != i {
+			t.Errorf("expected %d pending on branch 1, got %d", i, pending)
+		}
+	}
+
+	if pending := fanout.PendingAcks(0); pending != 0 {
+		t.Errorf("expected no pending acks on branch 0, got %d", pending)
+	}
+
+	// Both branches see the same delivery ID for an item
+	id0, _ := branch0[0].GetMetadata(MetadataDeliveryID)
+	id1, _ := branch1[0].GetMetadata(MetadataDeliveryID)
+	if id0 == nil || id0 != id1 {
+		t.Errorf("expected shared delivery ID, got %v and %v", id0, id1)
+	}
+
+	// A replacement consumer reprocesses the unacknowledged items in order
+	unacked := fanout.Unacked(1)
+	if len(unacked) != 3 {
+		t.Fatalf("expected 3 unacked items, got %d", len(unacked))
+	}
+	for i, r := range unacked {
+		if r.Value() != i+1 {
+			t.Errorf("expected unacked item %d at %d, got %d", i+1, i, r.Value())
+		}
+		fanout.Ack(1, r)
+	}
+	if pending := fanout.PendingAcks(1); pending != 0 {
+		t.Errorf("expected acking to clear branch 1, got %d pending", pending)
+	}
+
+	if fanout.Ack(1, branch1[0]) {
+		t.Error("expected duplicate ack to report not pending")
+	}
+	if fanout.Ack(1, NewSuccess(9)) {
+		t.Error("expected ack without a delivery ID to be rejected")
+	}
+	if fanout.Ack(5, branch1[0]) || fanout.PendingAcks(-1) != 0 {
+		t.Error("expected out-of-range branches to be ignored")
+	}
+	close(in)
+}
+
+func TestFanOut_WithoutAck(t *testing.T) {
+	fanout := NewFanOut[int](1)
+	in := make(chan Result[int], 1)
+	in <- NewSuccess(1)
+	close(in)
+
+	result := <-fanout.Process(context.Background(), in)[0]
+	if _, found := result.GetMetadata(MetadataDeliveryID); found {
+		t.Error("expected no delivery ID without WithAck")
+	}
+	if fanout.Ack(0, result) || fanout.PendingAcks(0) != 0 {
+		t.Error("expected acknowledgment tracking to be disabled")
+	}
+}
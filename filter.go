@@ -2,6 +2,7 @@ package streamz
 
 import (
 	"context"
+	"sync/atomic"
 )
 
 // Filter selectively passes items through a stream based on a predicate function.
@@ -18,7 +19,7 @@ import (
 //nolint:govet // fieldalignment: struct layout optimized for readability
 type Filter[T any] struct {
 	name      string
-	predicate func(T) bool
+	predicate atomic.Pointer[func(T) bool]
 }
 
 // NewFilter creates a processor that selectively passes items based on a predicate.
@@ -66,10 +67,9 @@ type Filter[T any] struct {
 //
 // Returns a new Filter processor.
 func NewFilter[T any](predicate func(T) bool) *Filter[T] {
-	return &Filter[T]{
-		name:      "filter",
-		predicate: predicate,
-	}
+	f := &Filter[T]{name: "filter"}
+	f.predicate.Store(&predicate)
+	return f
 }
 
 // WithName sets a custom name for this processor.
@@ -80,6 +80,15 @@ func (f *Filter[T]) WithName(name string) *Filter[T] {
 	return f
 }
 
+// UpdatePredicate atomically replaces the predicate, for live reconfiguration
+// such as feature flags or tightening a spam filter during an attack. Items
+// received after the swap are evaluated with the new predicate; an item already
+// being evaluated finishes with the old one.
+// Safe to call concurrently with Process.
+func (f *Filter[T]) UpdatePredicate(predicate func(T) bool) {
+	f.predicate.Store(&predicate)
+}
+
 // Process filters input items based on the predicate function.
 // Items that match the predicate (return true) are forwarded unchanged.
 // Items that don't match the predicate are discarded.
@@ -90,11 +99,10 @@ func (f *Filter[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Res
 	go func() {
 		defer close(out)
 
-		for item := range in {
-			select {
-			case <-ctx.Done():
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
 				return
-			default:
 			}
 
 			if item.IsError() {
@@ -113,7 +121,7 @@ func (f *Filter[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Res
 			}
 
 			// Apply predicate to success values
-			if f.predicate(item.Value()) {
+			if (*f.predicate.Load())(item.Value()) {
 				// Keep the item - forward unchanged
 				select {
 				case out <- item:
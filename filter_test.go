@@ -408,6 +408,75 @@ func TestFilter_EvenOddFiltering(t *testing.T) {
 	}
 }
 
+func TestFilter_UpdatePredicate(t *testing.T) {
+	filter := NewFilter(func(n int) bool { return n%2 == 0 })
+
+	ctx := context.Background()
+	input := make(chan Result[int])
+	results := filter.Process(ctx, input)
+
+	// Each unbuffered send completes only once the previous item was evaluated
+	var got []int
+	send := func(n int, kept bool) {
+		input <- NewSuccess(n)
+		if kept {
+			got = append(got, (<-results).Value())
+		}
+	}
+
+	send(1, false)
+	send(2, true)
+	send(3, false)
+	send(4, true)
+
+	filter.UpdatePredicate(func(n int) bool { return n%2 != 0 })
+
+	send(5, true)
+	send(6, false)
+	send(7, true)
+	close(input)
+
+	if _, ok := <-results; ok {
+		t.Error("expected output closed")
+	}
+	expected := []int{2, 4, 5, 7}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, got)
+	}
+	for i, exp := range expected {
+		if got[i] != exp {
+			t.Errorf("Expected got[%d] = %d, got %d", i, exp, got[i])
+		}
+	}
+}
+
+func TestFilter_UpdatePredicateConcurrent(t *testing.T) {
+	filter := NewFilter(func(int) bool { return true })
+
+	input := make(chan Result[int])
+	results := filter.Process(context.Background(), input)
+
+	go func() {
+		defer close(input)
+		for i := 0; i < 1000; i++ {
+			input <- NewSuccess(i)
+		}
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			threshold := i
+			filter.UpdatePredicate(func(n int) bool { return n >= threshold })
+		}
+	}()
+
+	for range results {
+	}
+	<-done
+}
+
 // Benchmark to ensure the filter has minimal overhead.
 func BenchmarkFilter(b *testing.B) {
 	filter := NewFilter(func(n int) bool {
@@ -0,0 +1,178 @@
+package streamz
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// MetadataPreviousState records the confirmed state a FlapDamper transition moved away from.
+const MetadataPreviousState = "previous_state" // string - prior confirmed state, absent on the first transition
+
+// FlapDamper reports state changes only once the new state has held steady for
+// a stabilization period, so a service toggling rapidly between up and down
+// produces one alert when it settles rather than one per flip.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type FlapDamper[T any] struct {
+	name       string
+	stateFn    func(T) string
+	stableFor  time.Duration
+	clock      Clock
+	suppressed atomic.Uint64
+}
+
+// NewFlapDamper creates a processor that emits confirmed state transitions.
+// An item whose state differs from the confirmed state starts a candidate
+// transition. If every item for the next stableFor reports that same state, the
+// transition is confirmed when the period ends and the latest item in the new
+// state is emitted, tagged with MetadataPreviousState. An item reporting any
+// other state cancels the candidate: returning to the confirmed state suppresses
+// the flip entirely, while a third state starts a new candidate from scratch.
+//
+// There is no confirmed state at the start, so the first state to hold for
+// stableFor is emitted as the first transition. Items repeating the confirmed
+// state are consumed silently, a candidate still pending when the input closes
+// is discarded, and errors pass through immediately.
+//
+// When to use:
+//   - Alerting on service health without alert storms from flapping checks
+//   - Debouncing noisy binary sensors such as door or link status
+//   - Publishing status changes only after they have settled
+//
+// Example:
+//
+//	damper := streamz.NewFlapDamper(func(c HealthCheck) string {
+//		return c.Status
+//	}, 30*time.Second, streamz.RealClock)
+//
+//	for change := range damper.Process(ctx, checks) {
+//		previous, _, _ := change.GetStringMetadata(streamz.MetadataPreviousState)
+//		notify(previous, change.Value().Status)
+//	}
+//
+// Parameters:
+//   - stateFn: Extracts the state an item reports
+//   - stableFor: How long a new state must hold before it is reported
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new FlapDamper processor.
+func NewFlapDamper[T any](stateFn func(T) string, stableFor time.Duration, clock Clock) *FlapDamper[T] {
+	return &FlapDamper[T]{
+		name:      "flap-damper",
+		stateFn:   stateFn,
+		stableFor: stableFor,
+		clock:     clock,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "flap-damper".
+func (d *FlapDamper[T]) WithName(name string) *FlapDamper[T] {
+	d.name = name
+	return d
+}
+
+// SuppressedCount returns the number of candidate transitions canceled before they stabilized.
+// Safe to call concurrently with Process.
+func (d *FlapDamper[T]) SuppressedCount() uint64 {
+	return d.suppressed.Load()
+}
+
+// Process emits an item for each state transition that holds for the stabilization period.
+func (d *FlapDamper[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		var confirmed string
+		hasConfirmed := false
+
+		var candidate Result[T]
+		var candidateState string
+		pending := false
+
+		var timer Timer
+		var timerC <-chan time.Time
+		stopTimer := func() {
+			if timer != nil {
+				timer.Stop()
+				timer = nil
+				timerC = nil
+			}
+		}
+		defer stopTimer()
+
+		cancelCandidate := func() {
+			if pending {
+				d.suppressed.Add(1)
+				pending = false
+				candidate = Result[T]{}
+				stopTimer()
+			}
+		}
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+
+				if item.IsError() {
+					select {
+					case out <- item:
+					case <-ctx.Done():
+						return
+					}
+					continue
+				}
+
+				state := d.stateFn(item.Value())
+				switch {
+				case hasConfirmed && state == confirmed:
+					cancelCandidate()
+				case pending && state == candidateState:
+					candidate = item
+				default:
+					cancelCandidate()
+					candidate = item
+					candidateState = state
+					pending = true
+					timer = d.clock.NewTimer(d.stableFor)
+					timerC = timer.C()
+				}
+
+			case <-timerC:
+				timer = nil
+				timerC = nil
+
+				transition := candidate
+				if hasConfirmed {
+					transition = transition.WithMetadata(MetadataPreviousState, confirmed)
+				}
+				confirmed = candidateState
+				hasConfirmed = true
+				pending = false
+				candidate = Result[T]{}
+
+				select {
+				case out <- transition:
+				case <-ctx.Done():
+					return
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (d *FlapDamper[T]) Name() string {
+	return d.name
+}
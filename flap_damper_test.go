@@ -0,0 +1,175 @@
+package streamz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+type healthCheck struct {
+	Status string
+	Seq    int
+}
+
+func healthStatus(c healthCheck) string { return c.Status }
+
+func TestFlapDamper_Name(t *testing.T) {
+	damper := NewFlapDamper(healthStatus, time.Second, RealClock)
+	if damper.Name() != "flap-damper" {
+		t.Errorf("expected name 'flap-damper', got %q", damper.Name())
+	}
+	if damper.WithName("health-alerts").Name() != "health-alerts" {
+		t.Errorf("expected name 'health-alerts', got %q", damper.Name())
+	}
+}
+
+func TestFlapDamper_StableTransitionEmits(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[healthCheck])
+	defer close(in)
+	out := NewFlapDamper(healthStatus, 30*time.Second, clock).Process(context.Background(), in)
+
+	sendWithBarrier(t, in, out, NewSuccess(healthCheck{Status: "up", Seq: 1}))
+	clock.Advance(29 * time.Second)
+	clock.BlockUntilReady()
+	select {
+	case got := <-out:
+		t.Fatalf("expected nothing before the state is stable, got %v", got)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	clock.Advance(time.Second)
+	clock.BlockUntilReady()
+	select {
+	case got := <-out:
+		if got.Value().Status != "up" {
+			t.Fatalf("expected initial state up after 30s, got %v", got)
+		}
+		if _, found := got.GetMetadata(MetadataPreviousState); found {
+			t.Error("expected no previous state on the first transition")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected initial state up after 30s")
+	}
+
+	// Repeating the confirmed state is silent
+	sendWithBarrier(t, in, out, NewSuccess(healthCheck{Status: "up", Seq: 2}))
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+	select {
+	case got := <-out:
+		t.Errorf("expected repeated state suppressed, got %v", got)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestFlapDamper_RevertedFlipEmitsNothing(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[healthCheck])
+	defer close(in)
+	damper := NewFlapDamper(healthStatus, 30*time.Second, clock)
+	out := damper.Process(context.Background(), in)
+
+	sendWithBarrier(t, in, out, NewSuccess(healthCheck{Status: "up", Seq: 1}))
+	clock.Advance(30 * time.Second)
+	clock.BlockUntilReady()
+	<-out
+
+	// Each flip reverts within 10s, well inside the stabilization period
+	for seq, status := range []string{"down", "up", "down", "up"} {
+		sendWithBarrier(t, in, out, NewSuccess(healthCheck{Status: status, Seq: seq + 2}))
+		if status == "down" {
+			clock.Advance(10 * time.Second)
+		}
+	}
+
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+	select {
+	case got := <-out:
+		t.Errorf("expected flapping suppressed, got %v", got)
+	case <-time.After(20 * time.Millisecond):
+	}
+	if damper.SuppressedCount() != 2 {
+		t.Errorf("expected 2 suppressed flips, got %d", damper.SuppressedCount())
+	}
+}
+
+func TestFlapDamper_SustainedChangeEmitsOnce(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[healthCheck])
+	defer close(in)
+	out := NewFlapDamper(healthStatus, 30*time.Second, clock).Process(context.Background(), in)
+
+	sendWithBarrier(t, in, out, NewSuccess(healthCheck{Status: "up", Seq: 1}))
+	clock.Advance(30 * time.Second)
+	clock.BlockUntilReady()
+	<-out
+
+	sendWithBarrier(t, in, out, NewSuccess(healthCheck{Status: "down", Seq: 2}))
+	clock.Advance(15 * time.Second)
+	sendWithBarrier(t, in, out, NewSuccess(healthCheck{Status: "down", Seq: 3}))
+	clock.Advance(15 * time.Second)
+	clock.BlockUntilReady()
+
+	select {
+	case got := <-out:
+		// The latest check in the new state is emitted
+		if got.Value() != (healthCheck{Status: "down", Seq: 3}) {
+			t.Errorf("expected latest down check, got %v", got.Value())
+		}
+		if previous, _, _ := got.GetStringMetadata(MetadataPreviousState); previous != "up" {
+			t.Errorf("expected previous state up, got %q", previous)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected transition to down after 30s")
+	}
+
+	sendWithBarrier(t, in, out, NewSuccess(healthCheck{Status: "down", Seq: 4}))
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+	select {
+	case got := <-out:
+		t.Errorf("expected exactly one transition, got another %v", got)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestFlapDamper_ThirdStateRestartsStabilization(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[healthCheck])
+	defer close(in)
+	out := NewFlapDamper(healthStatus, 30*time.Second, clock).Process(context.Background(), in)
+
+	sendWithBarrier(t, in, out, NewSuccess(healthCheck{Status: "up", Seq: 1}))
+	clock.Advance(30 * time.Second)
+	clock.BlockUntilReady()
+	<-out
+
+	sendWithBarrier(t, in, out, NewSuccess(healthCheck{Status: "down", Seq: 2}))
+	clock.Advance(20 * time.Second)
+	sendWithBarrier(t, in, out, NewSuccess(healthCheck{Status: "degraded", Seq: 3}))
+	clock.Advance(10 * time.Second)
+	clock.BlockUntilReady()
+	select {
+	case got := <-out:
+		t.Fatalf("expected the down candidate canceled, got %v", got)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	clock.Advance(20 * time.Second)
+	clock.BlockUntilReady()
+	select {
+	case got := <-out:
+		if got.Value().Status != "degraded" {
+			t.Fatalf("expected degraded 30s after it started, got %v", got)
+		}
+		if previous, _, _ := got.GetStringMetadata(MetadataPreviousState); previous != "up" {
+			t.Errorf("expected previous state up, got %q", previous)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected degraded 30s after it started")
+	}
+}
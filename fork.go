@@ -0,0 +1,127 @@
+package streamz
+
+import (
+	"context"
+)
+
+// Fork sends each input item through two different transformations, producing two
+// differently-typed output streams. Unlike FanOut, which emits identical copies,
+// Fork derives a distinct view of every item for each branch — for example a
+// summary record and a detail record from a single event.
+//
+// Both outputs advance in lockstep: an item is delivered to both branches before
+// the next item is read, so both consumers must be active to avoid blocking.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Fork[T, A, B any] struct {
+	name string
+	fa   func(T) A
+	fb   func(T) B
+}
+
+// NewFork creates a processor that applies a different transform to each copy of an item.
+// Successful items are transformed by fa for the first output and fb for the second.
+// Errors propagate to both outputs as typed errors that wrap the original StreamError.
+//
+// When to use:
+//   - Deriving summary and detail records from the same event
+//   - Feeding differently-shaped sinks from one source
+//   - Projecting one stream into two typed pipelines without re-reading input
+//
+// Example:
+//
+//	fork := streamz.NewFork(
+//		func(o Order) OrderSummary { return OrderSummary{ID: o.ID, Total: o.Total} },
+//		func(o Order) []LineItem { return o.Items },
+//	)
+//
+//	summaries, items := fork.Process(ctx, orders)
+//	go writeSummaries(summaries)
+//	go writeLineItems(items)
+//
+// Parameters:
+//   - fa: Transformation producing values for the first output
+//   - fb: Transformation producing values for the second output
+//
+// Returns a new Fork processor.
+func NewFork[T, A, B any](fa func(T) A, fb func(T) B) *Fork[T, A, B] {
+	return &Fork[T, A, B]{
+		name: "fork",
+		fa:   fa,
+		fb:   fb,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "fork".
+func (f *Fork[T, A, B]) WithName(name string) *Fork[T, A, B] {
+	f.name = name
+	return f
+}
+
+// Process transforms each input into both output streams.
+// Metadata on successful items is preserved on both derived Results.
+// Both output channels are closed when the input closes or the context is canceled.
+func (f *Fork[T, A, B]) Process(ctx context.Context, in <-chan Result[T]) (first <-chan Result[A], second <-chan Result[B]) {
+	outA := make(chan Result[A])
+	outB := make(chan Result[B])
+
+	go func() {
+		defer close(outA)
+		defer close(outB)
+
+		for {
+			var item Result[T]
+			select {
+			case next, ok := <-in:
+				if !ok {
+					return
+				}
+				item = next
+			case <-ctx.Done():
+				return
+			}
+
+			var resultA Result[A]
+			var resultB Result[B]
+
+			if item.IsError() {
+				resultA = Result[A]{err: &StreamError[A]{
+					Item:          *new(A), // zero value for A type
+					Err:           item.Error(),
+					ProcessorName: f.name,
+					Timestamp:     item.Error().Timestamp,
+				}}
+				resultB = Result[B]{err: &StreamError[B]{
+					Item:          *new(B), // zero value for B type
+					Err:           item.Error(),
+					ProcessorName: f.name,
+					Timestamp:     item.Error().Timestamp,
+				}}
+			} else {
+				value := item.Value()
+				resultA = Result[A]{value: f.fa(value), metadata: item.metadata}
+				resultB = Result[B]{value: f.fb(value), metadata: item.metadata}
+			}
+
+			select {
+			case outA <- resultA:
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case outB <- resultB:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return outA, outB
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (f *Fork[T, A, B]) Name() string {
+	return f.name
+}
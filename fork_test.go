@@ -0,0 +1,161 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type forkEvent struct {
+	ID    string
+	Items []int
+}
+
+type forkSummary struct {
+	ID    string
+	Count int
+}
+
+func newEventFork() *Fork[forkEvent, forkSummary, []int] {
+	return NewFork(
+		func(e forkEvent) forkSummary { return forkSummary{ID: e.ID, Count: len(e.Items)} },
+		func(e forkEvent) []int { return e.Items },
+	)
+}
+
+func TestFork_Name(t *testing.T) {
+	fork := newEventFork()
+	if fork.Name() != "fork" {
+		t.Errorf("expected name 'fork', got %q", fork.Name())
+	}
+	if fork.WithName("split-event").Name() != "split-event" {
+		t.Errorf("expected name 'split-event', got %q", fork.Name())
+	}
+}
+
+func TestFork_DerivesBothStreams(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[forkEvent], 3)
+	in <- NewSuccess(forkEvent{ID: "a", Items: []int{1, 2}})
+	in <- NewSuccess(forkEvent{ID: "b", Items: []int{3}}).WithMetadata(MetadataSource, "orders")
+	in <- NewSuccess(forkEvent{ID: "c"})
+	close(in)
+
+	summaries, details := newEventFork().Process(ctx, in)
+
+	expectedIDs := []string{"a", "b", "c"}
+	expectedCounts := []int{2, 1, 0}
+
+	// Read both outputs in lockstep
+	for i := range expectedIDs {
+		summary := <-summaries
+		detail := <-details
+
+		if summary.IsError() || detail.IsError() {
+			t.Fatalf("unexpected error at %d: %v / %v", i, summary.Error(), detail.Error())
+		}
+		if summary.Value().ID != expectedIDs[i] || summary.Value().Count != expectedCounts[i] {
+			t.Errorf("unexpected summary at %d: %+v", i, summary.Value())
+		}
+		if len(detail.Value()) != expectedCounts[i] {
+			t.Errorf("expected %d detail items at %d, got %v", expectedCounts[i], i, detail.Value())
+		}
+	}
+
+	if _, ok := <-summaries; ok {
+		t.Error("expected summary output to be closed")
+	}
+	if _, ok := <-details; ok {
+		t.Error("expected detail output to be closed")
+	}
+}
+
+func TestFork_PreservesMetadata(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[forkEvent], 1)
+	in <- NewSuccess(forkEvent{ID: "a"}).WithMetadata(MetadataSource, "orders")
+	close(in)
+
+	summaries, details := newEventFork().Process(ctx, in)
+	summary := <-summaries
+	detail := <-details
+
+	for name, source := range map[string]func() (interface{}, bool){
+		"summary": func() (interface{}, bool) { return summary.GetMetadata(MetadataSource) },
+		"detail":  func() (interface{}, bool) { return detail.GetMetadata(MetadataSource) },
+	} {
+		value, ok := source()
+		if !ok || value != "orders" {
+			t.Errorf("%s: expected source metadata 'orders', got %v", name, value)
+		}
+	}
+}
+
+func TestFork_ErrorsPropagateToBoth(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[forkEvent], 2)
+	testErr := errors.New("decode failed")
+	in <- NewError(forkEvent{ID: "bad"}, testErr, "decoder")
+	in <- NewSuccess(forkEvent{ID: "good", Items: []int{1}})
+	close(in)
+
+	summaries, details := newEventFork().Process(ctx, in)
+
+	summary := <-summaries
+	detail := <-details
+
+	if !summary.IsError() || !detail.IsError() {
+		t.Fatal("expected error on both outputs")
+	}
+	if !errors.Is(summary.Error(), testErr) || !errors.Is(detail.Error(), testErr) {
+		t.Error("expected both errors to wrap the original error")
+	}
+	if summary.Error().ProcessorName != "fork" || detail.Error().ProcessorName != "fork" {
+		t.Errorf("expected processor name 'fork', got %q and %q",
+			summary.Error().ProcessorName, detail.Error().ProcessorName)
+	}
+
+	if (<-summaries).Value().ID != "good" {
+		t.Error("expected success after error on summary output")
+	}
+	if len((<-details).Value()) != 1 {
+		t.Error("expected success after error on detail output")
+	}
+}
+
+func TestFork_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[forkEvent], 1)
+	in <- NewSuccess(forkEvent{ID: "a"})
+
+	summaries, details := newEventFork().Process(ctx, in)
+	cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for range summaries {
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for range details {
+		}
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("outputs didn't close after cancellation")
+	}
+	close(in)
+}
@@ -0,0 +1,101 @@
+package streamz
+
+import (
+	"context"
+)
+
+// GuardedMap applies a transformation only to items that pass a guard, forwarding
+// all other items unchanged. It combines Filter and Mapper for selectively
+// transforming part of a heterogeneous stream without dropping the rest.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type GuardedMap[T any] struct {
+	name  string
+	guard func(T) bool
+	fn    func(T) (T, error)
+}
+
+// NewGuardedMap creates a processor that transforms only items matching guard.
+// Items failing the guard pass through as-is, metadata included. Transformed items
+// keep their metadata; transformation failures become error Results carrying the
+// original item. Upstream errors pass through unchanged.
+//
+// When to use:
+//   - Normalizing only one kind of record in a mixed stream
+//   - Enriching items that meet a condition while keeping the rest
+//   - Applying fixes to known-bad records without a separate branch
+//
+// Example:
+//
+//	// Mask card numbers only on payment events
+//	masker := streamz.NewGuardedMap(
+//		func(e Event) bool { return e.Type == "payment" },
+//		func(e Event) (Event, error) {
+//			e.Card = mask(e.Card)
+//			return e, nil
+//		},
+//	)
+//
+//	masked := masker.Process(ctx, events)
+//
+// Parameters:
+//   - guard: Predicate selecting items to transform
+//   - fn: Transformation applied to matching items
+//
+// Returns a new GuardedMap processor.
+func NewGuardedMap[T any](guard func(T) bool, fn func(T) (T, error)) *GuardedMap[T] {
+	return &GuardedMap[T]{
+		name:  "guarded-map",
+		guard: guard,
+		fn:    fn,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "guarded-map".
+func (g *GuardedMap[T]) WithName(name string) *GuardedMap[T] {
+	g.name = name
+	return g
+}
+
+// Process transforms matching items and forwards everything else unchanged.
+func (g *GuardedMap[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+
+				if item.IsSuccess() && g.guard(item.Value()) {
+					value, err := g.fn(item.Value())
+					if err != nil {
+						item = Result[T]{err: NewStreamError(item.Value(), err, g.name), metadata: item.metadata}
+					} else {
+						item = Result[T]{value: value, metadata: item.metadata}
+					}
+				}
+
+				select {
+				case out <- item:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (g *GuardedMap[T]) Name() string {
+	return g.name
+}
@@ -0,0 +1,110 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+var errEmptyCommand = errors.New("empty command")
+
+// newCommandMapper uppercases lines starting with "/" and rejects a bare "/".
+func newCommandMapper() *GuardedMap[string] {
+	return NewGuardedMap(
+		func(s string) bool { return strings.HasPrefix(s, "/") },
+		func(s string) (string, error) {
+			if s == "/" {
+				return s, errEmptyCommand
+			}
+			return strings.ToUpper(s), nil
+		},
+	)
+}
+
+func TestGuardedMap_Name(t *testing.T) {
+	mapper := newCommandMapper()
+	if mapper.Name() != "guarded-map" {
+		t.Errorf("expected name 'guarded-map', got %q", mapper.Name())
+	}
+	if mapper.WithName("commands").Name() != "commands" {
+		t.Errorf("expected name 'commands', got %q", mapper.Name())
+	}
+}
+
+func TestGuardedMap_TransformsOnlyMatching(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[string], 4)
+	in <- NewSuccess("/join")
+	in <- NewSuccess("hello")
+	in <- NewSuccess("/quit")
+	in <- NewSuccess("bye")
+	close(in)
+
+	var values []string
+	for result := range newCommandMapper().Process(ctx, in) {
+		values = append(values, result.Value())
+	}
+
+	expected := []string{"/JOIN", "hello", "/QUIT", "bye"}
+	if len(values) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, values)
+	}
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Errorf("expected %q at %d, got %q", expected[i], i, values[i])
+		}
+	}
+}
+
+func TestGuardedMap_SurfacesErrors(t *testing.T) {
+	ctx := context.Background()
+	upstreamErr := errors.New("decode failed")
+	in := make(chan Result[string], 3)
+	in <- NewSuccess("/")
+	in <- NewError("raw", upstreamErr, "decoder")
+	in <- NewSuccess("/ok")
+	close(in)
+
+	var results []Result[string]
+	for result := range newCommandMapper().Process(ctx, in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if !errors.Is(results[0].Error(), errEmptyCommand) || results[0].Error().ProcessorName != "guarded-map" {
+		t.Errorf("expected fn error from guarded-map, got %v", results[0].Error())
+	}
+	if results[0].Error().Item != "/" {
+		t.Errorf("expected original item on error, got %q", results[0].Error().Item)
+	}
+	if !errors.Is(results[1].Error(), upstreamErr) || results[1].Error().ProcessorName != "decoder" {
+		t.Errorf("expected upstream error unchanged, got %v", results[1].Error())
+	}
+	if results[2].Value() != "/OK" {
+		t.Errorf("expected processing to continue after error, got %v", results[2])
+	}
+}
+
+func TestGuardedMap_PreservesMetadata(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[string], 3)
+	in <- NewSuccess("/join").WithMetadata(MetadataSource, "irc")
+	in <- NewSuccess("hello").WithMetadata(MetadataSource, "irc")
+	in <- NewSuccess("/").WithMetadata(MetadataSource, "irc")
+	close(in)
+
+	paths := []string{"transformed", "passed through", "failed"}
+	i := 0
+	for result := range newCommandMapper().Process(ctx, in) {
+		if source, _, _ := result.GetStringMetadata(MetadataSource); source != "irc" {
+			t.Errorf("%s: expected source metadata preserved, got %q", paths[i], source)
+		}
+		i++
+	}
+	if i != 3 {
+		t.Errorf("expected 3 results, got %d", i)
+	}
+}
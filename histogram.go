@@ -0,0 +1,127 @@
+package streamz
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+)
+
+// Histogram counts values into predefined buckets while forwarding items
+// unchanged, giving an exact live view of a distribution that can be scraped
+// by a metrics endpoint at any time.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Histogram[T any] struct {
+	name   string
+	valFn  func(T) float64
+	bounds []float64
+	counts []atomic.Uint64
+	total  atomic.Uint64
+}
+
+// NewHistogram creates a processor that counts successful items' values into buckets.
+// buckets lists ascending upper bounds: bucket i counts values greater than
+// bucket i-1's bound and at most buckets[i], so the first bucket also holds every
+// value below the lowest bound. A final overflow bucket counts values above the
+// highest bound. Counts are per bucket, not cumulative.
+// Errors pass through unchanged and are not counted.
+//
+// When to use:
+//   - Exporting latency or size distributions to Prometheus-style scrapers
+//   - Exact counts against fixed SLO thresholds
+//   - Live dashboards of value distributions
+//
+// Example:
+//
+//	// Response times in seconds
+//	latency := streamz.NewHistogram(func(r Request) float64 {
+//		return r.Duration.Seconds()
+//	}, []float64{0.05, 0.1, 0.25, 0.5, 1})
+//
+//	requests = latency.Process(ctx, requests)
+//
+//	http.HandleFunc("/metrics", func(w http.ResponseWriter, _ *http.Request) {
+//		fmt.Fprintf(w, "buckets %v total %d\n", latency.Buckets(), latency.Total())
+//	})
+//
+// Parameters:
+//   - valFn: Extracts the value to count
+//   - buckets: Strictly ascending bucket upper bounds
+//
+// Returns a new Histogram processor.
+// Panics if buckets is empty or not strictly ascending.
+func NewHistogram[T any](valFn func(T) float64, buckets []float64) *Histogram[T] {
+	if len(buckets) == 0 {
+		panic("histogram needs at least one bucket")
+	}
+	for i := 1; i < len(buckets); i++ {
+		if !(buckets[i] > buckets[i-1]) {
+			panic("histogram buckets must be strictly ascending")
+		}
+	}
+	return &Histogram[T]{
+		name:   "histogram",
+		valFn:  valFn,
+		bounds: slices.Clone(buckets),
+		counts: make([]atomic.Uint64, len(buckets)+1),
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "histogram".
+func (h *Histogram[T]) WithName(name string) *Histogram[T] {
+	h.name = name
+	return h
+}
+
+// Buckets returns the count of each bucket, followed by the overflow bucket.
+// Safe to call concurrently with Process.
+func (h *Histogram[T]) Buckets() []uint64 {
+	counts := make([]uint64, len(h.counts))
+	for i := range h.counts {
+		counts[i] = h.counts[i].Load()
+	}
+	return counts
+}
+
+// Total returns the number of values counted.
+// Safe to call concurrently with Process.
+func (h *Histogram[T]) Total() uint64 {
+	return h.total.Load()
+}
+
+// Process forwards every item unchanged, counting successful items' values.
+func (h *Histogram[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsSuccess() {
+				// First bound at or above the value; len(bounds) is the overflow bucket
+				i, _ := slices.BinarySearch(h.bounds, h.valFn(item.Value()))
+				h.counts[i].Add(1)
+				h.total.Add(1)
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (h *Histogram[T]) Name() string {
+	return h.name
+}
@@ -0,0 +1,133 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func floatValue(v float64) float64 { return v }
+
+// histogramOf runs values through h and drains the output.
+func histogramOf(h *Histogram[float64], items ...Result[float64]) int {
+	in := make(chan Result[float64], len(items))
+	for _, item := range items {
+		in <- item
+	}
+	close(in)
+
+	forwarded := 0
+	for range h.Process(context.Background(), in) {
+		forwarded++
+	}
+	return forwarded
+}
+
+func TestHistogram_Name(t *testing.T) {
+	h := NewHistogram(floatValue, []float64{1})
+	if h.Name() != "histogram" {
+		t.Errorf("expected name 'histogram', got %q", h.Name())
+	}
+	if h.WithName("latency").Name() != "latency" {
+		t.Errorf("expected name 'latency', got %q", h.Name())
+	}
+}
+
+func TestHistogram_PanicsOnInvalidBuckets(t *testing.T) {
+	for name, buckets := range map[string][]float64{
+		"empty":      nil,
+		"descending": {1, 0.5},
+		"repeated":   {1, 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for %s buckets", name)
+				}
+			}()
+			NewHistogram(floatValue, buckets)
+		})
+	}
+}
+
+func TestHistogram_CountsKnownDistribution(t *testing.T) {
+	h := NewHistogram(floatValue, []float64{0.1, 0.5, 1})
+
+	values := []float64{0.05, 0.1, 0.2, 0.3, 0.5, 0.7, 0.9, 1, 1, 2}
+	items := make([]Result[float64], 0, len(values)+1)
+	for _, v := range values {
+		items = append(items, NewSuccess(v))
+	}
+	items = append(items, NewError(100.0, errors.New("timeout"), "client"))
+
+	if n := histogramOf(h, items...); n != len(items) {
+		t.Errorf("expected every item forwarded, got %d", n)
+	}
+	// Upper bounds are inclusive
+	if got, want := h.Buckets(), []uint64{2, 3, 4, 1}; !slices.Equal(got, want) {
+		t.Errorf("expected buckets %v, got %v", want, got)
+	}
+	if h.Total() != uint64(len(values)) {
+		t.Errorf("expected total %d excluding errors, got %d", len(values), h.Total())
+	}
+}
+
+func TestHistogram_BelowAndAboveAllBuckets(t *testing.T) {
+	h := NewHistogram(floatValue, []float64{10, 20})
+	histogramOf(h, NewSuccess(-5.0), NewSuccess(0.0), NewSuccess(25.0), NewSuccess(1e9))
+
+	if got, want := h.Buckets(), []uint64{2, 0, 2}; !slices.Equal(got, want) {
+		t.Errorf("expected low values in the first bucket and high ones in overflow, got %v", got)
+	}
+}
+
+func TestHistogram_ConcurrentReads(t *testing.T) {
+	h := NewHistogram(floatValue, []float64{250, 500, 750})
+
+	in := make(chan Result[float64])
+	out := h.Process(context.Background(), in)
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				var sum uint64
+				for _, c := range h.Buckets() {
+					sum += c
+				}
+				if total := h.Total(); sum > total+1 {
+					t.Errorf("bucket sum %d ahead of total %d", sum, total)
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(in)
+		for i := 0; i < 1000; i++ {
+			in <- NewSuccess(float64(i))
+		}
+	}()
+	for range out {
+	}
+	close(done)
+	wg.Wait()
+
+	if got, want := h.Buckets(), []uint64{251, 250, 250, 249}; !slices.Equal(got, want) {
+		t.Errorf("expected buckets %v, got %v", want, got)
+	}
+	if h.Total() != 1000 {
+		t.Errorf("expected total 1000, got %d", h.Total())
+	}
+}
@@ -0,0 +1,214 @@
+package streamz
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// IdempotencyStore remembers which idempotency keys have completed their side
+// effect. Implementations backed by shared storage, such as a database or
+// Redis, extend exactly-once behavior across processes and restarts.
+type IdempotencyStore interface {
+	// Seen reports whether key has been recorded and is still valid at now.
+	Seen(ctx context.Context, key string, now time.Time) (bool, error)
+
+	// Record marks key as completed at now.
+	Record(ctx context.Context, key string, now time.Time) error
+}
+
+// MemoryIdempotencyStore is an in-process IdempotencyStore whose keys expire
+// after a TTL. It is safe for concurrent use.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type MemoryIdempotencyStore struct {
+	ttl       time.Duration
+	mu        sync.Mutex
+	recorded  map[string]time.Time
+	lastSweep time.Time
+}
+
+// NewMemoryIdempotencyStore creates an in-memory store that forgets keys ttl
+// after they were recorded. A ttl that is not positive keeps keys forever.
+func NewMemoryIdempotencyStore(ttl time.Duration) *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{
+		ttl:      ttl,
+		recorded: make(map[string]time.Time),
+	}
+}
+
+// Seen reports whether key was recorded less than the TTL before now.
+func (s *MemoryIdempotencyStore) Seen(_ context.Context, key string, now time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	at, exists := s.recorded[key]
+	if !exists {
+		return false, nil
+	}
+	if s.expired(at, now) {
+		delete(s.recorded, key)
+		return false, nil
+	}
+	return true, nil
+}
+
+// Record marks key as completed at now, sweeping expired keys at most once per TTL.
+func (s *MemoryIdempotencyStore) Record(_ context.Context, key string, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ttl > 0 && now.Sub(s.lastSweep) >= s.ttl {
+		for k, at := range s.recorded {
+			if s.expired(at, now) {
+				delete(s.recorded, k)
+			}
+		}
+		s.lastSweep = now
+	}
+	s.recorded[key] = now
+	return nil
+}
+
+// Len returns the number of keys currently held, including any expired keys
+// not yet swept.
+func (s *MemoryIdempotencyStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.recorded)
+}
+
+// expired reports whether a key recorded at at has outlived the TTL at now.
+func (s *MemoryIdempotencyStore) expired(at, now time.Time) bool {
+	return s.ttl > 0 && now.Sub(at) >= s.ttl
+}
+
+// Idempotent runs a side effect at most once per logical key, even when items
+// are retried, replayed, or redelivered, by consulting an IdempotencyStore
+// before each call and recording every successful one.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Idempotent[T any] struct {
+	name    string
+	keyFn   func(T) string
+	fn      func(context.Context, T) error
+	store   IdempotencyStore
+	clock   Clock
+	skipped atomic.Uint64
+}
+
+// NewIdempotent creates a processor that applies fn once per idempotency key.
+// For each successful item, the store is checked first: if the key is already
+// recorded, fn is skipped and the item is forwarded as is. Otherwise fn runs and,
+// if it succeeds, the key is recorded and the item forwarded. A failed fn is not
+// recorded, so the next occurrence of the key tries again; the item becomes an
+// error Result carrying the failure. Store errors also become error Results.
+// Upstream errors pass through unchanged.
+//
+// Items are handled one at a time, so a key cannot race with itself within one
+// processor. Sharing a store between processes requires a store whose check and
+// record are coordinated, such as one built on conditional writes.
+//
+// When to use:
+//   - Sending emails, payments, or webhooks exactly once per request
+//   - Making at-least-once delivery safe for non-idempotent sinks
+//   - Replaying streams without repeating their side effects
+//
+// Example:
+//
+//	store := streamz.NewMemoryIdempotencyStore(24 * time.Hour)
+//	charge := streamz.NewIdempotent(func(p Payment) string {
+//		return p.RequestID
+//	}, func(ctx context.Context, p Payment) error {
+//		return gateway.Charge(ctx, p)
+//	}, store, streamz.RealClock)
+//
+//	charged := charge.Process(ctx, payments)
+//
+// Parameters:
+//   - keyFn: Extracts the idempotency key of an item
+//   - fn: Side effect to run once per key
+//   - store: Records completed keys
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new Idempotent processor.
+func NewIdempotent[T any](keyFn func(T) string, fn func(context.Context, T) error, store IdempotencyStore, clock Clock) *Idempotent[T] {
+	return &Idempotent[T]{
+		name:  "idempotent",
+		keyFn: keyFn,
+		fn:    fn,
+		store: store,
+		clock: clock,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "idempotent".
+func (p *Idempotent[T]) WithName(name string) *Idempotent[T] {
+	p.name = name
+	return p
+}
+
+// SkippedCount returns the number of items whose side effect was skipped as
+// already completed.
+// Safe to call concurrently with Process.
+func (p *Idempotent[T]) SkippedCount() uint64 {
+	return p.skipped.Load()
+}
+
+// Process runs the side effect for each key not yet recorded and forwards every item.
+func (p *Idempotent[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsSuccess() {
+				if err := p.apply(ctx, item.Value()); err != nil {
+					item = Result[T]{err: NewStreamError(item.Value(), err, p.name), metadata: item.metadata}
+				}
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// apply runs fn for value unless its key is already recorded.
+func (p *Idempotent[T]) apply(ctx context.Context, value T) error {
+	key := p.keyFn(value)
+
+	seen, err := p.store.Seen(ctx, key, p.clock.Now())
+	if err != nil {
+		return fmt.Errorf("idempotency check for %q: %w", key, err)
+	}
+	if seen {
+		p.skipped.Add(1)
+		return nil
+	}
+
+	if err := p.fn(ctx, value); err != nil {
+		return err
+	}
+	if err := p.store.Record(ctx, key, p.clock.Now()); err != nil {
+		return fmt.Errorf("idempotency record for %q: %w", key, err)
+	}
+	return nil
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (p *Idempotent[T]) Name() string {
+	return p.name
+}
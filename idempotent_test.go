@@ -0,0 +1,166 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+func TestIdempotent_Name(t *testing.T) {
+	noop := func(context.Context, string) error { return nil }
+	idem := NewIdempotent(identityKey, noop, NewMemoryIdempotencyStore(time.Hour), RealClock)
+	if idem.Name() != "idempotent" {
+		t.Errorf("expected name 'idempotent', got %q", idem.Name())
+	}
+	if idem.WithName("charge-once").Name() != "charge-once" {
+		t.Errorf("expected name 'charge-once', got %q", idem.Name())
+	}
+}
+
+func TestIdempotent_SkipsRecordedKeys(t *testing.T) {
+	var calls []string
+	idem := NewIdempotent(identityKey, func(_ context.Context, s string) error {
+		calls = append(calls, s)
+		return nil
+	}, NewMemoryIdempotencyStore(time.Hour), clockz.NewFakeClock())
+
+	in := make(chan Result[string])
+	out := idem.Process(context.Background(), in)
+
+	for _, key := range []string{"a", "b", "a", "a"} {
+		if result := sendAndReceive(t, in, out, NewSuccess(key)); result.Value() != key {
+			t.Errorf("expected %q forwarded, got %v", key, result)
+		}
+	}
+	upstream := sendAndReceive(t, in, out, NewError("a", errors.New("bad"), "upstream"))
+	if !upstream.IsError() || upstream.Error().ProcessorName != "upstream" {
+		t.Errorf("expected upstream error passed through, got %v", upstream)
+	}
+	close(in)
+
+	if !slices.Equal(calls, []string{"a", "b"}) {
+		t.Errorf("expected side effect once per key [a b], got %v", calls)
+	}
+	if idem.SkippedCount() != 2 {
+		t.Errorf("expected 2 skipped, got %d", idem.SkippedCount())
+	}
+}
+
+func TestIdempotent_FailureIsNotRecorded(t *testing.T) {
+	errDown := errors.New("gateway down")
+	attempts := 0
+	idem := NewIdempotent(identityKey, func(context.Context, string) error {
+		attempts++
+		if attempts == 1 {
+			return errDown
+		}
+		return nil
+	}, NewMemoryIdempotencyStore(time.Hour), clockz.NewFakeClock())
+
+	in := make(chan Result[string])
+	out := idem.Process(context.Background(), in)
+
+	failed := sendAndReceive(t, in, out, NewSuccess("a").WithMetadata(MetadataSource, "api"))
+	if !failed.IsError() || !errors.Is(failed.Error().Err, errDown) {
+		t.Fatalf("expected side effect error, got %v", failed)
+	}
+	if failed.Error().Item != "a" || failed.Error().ProcessorName != "idempotent" {
+		t.Errorf("expected error for item 'a' from idempotent, got %v", failed.Error())
+	}
+	if source, _, _ := failed.GetStringMetadata(MetadataSource); source != "api" {
+		t.Errorf("expected metadata preserved, got %q", source)
+	}
+
+	// The failed key was not recorded, so the retry runs again and succeeds
+	if retried := sendAndReceive(t, in, out, NewSuccess("a")); !retried.IsSuccess() {
+		t.Errorf("expected retry to succeed, got %v", retried)
+	}
+	sendAndReceive(t, in, out, NewSuccess("a"))
+	close(in)
+
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestIdempotent_TTLExpiryAllowsReexecution(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	calls := 0
+	idem := NewIdempotent(identityKey, func(context.Context, string) error {
+		calls++
+		return nil
+	}, NewMemoryIdempotencyStore(time.Minute), clock)
+
+	in := make(chan Result[string])
+	out := idem.Process(context.Background(), in)
+
+	sendAndReceive(t, in, out, NewSuccess("a"))
+	clock.Advance(59 * time.Second)
+	sendAndReceive(t, in, out, NewSuccess("a"))
+	if calls != 1 {
+		t.Fatalf("expected duplicate within TTL skipped, got %d calls", calls)
+	}
+
+	clock.Advance(time.Second)
+	sendAndReceive(t, in, out, NewSuccess("a"))
+	close(in)
+
+	if calls != 2 {
+		t.Errorf("expected re-execution after TTL, got %d calls", calls)
+	}
+}
+
+type failingStore struct{}
+
+func (failingStore) Seen(context.Context, string, time.Time) (bool, error) {
+	return false, errors.New("store unavailable")
+}
+
+func (failingStore) Record(context.Context, string, time.Time) error { return nil }
+
+func TestIdempotent_StoreErrorSkipsSideEffect(t *testing.T) {
+	calls := 0
+	idem := NewIdempotent(identityKey, func(context.Context, string) error {
+		calls++
+		return nil
+	}, failingStore{}, clockz.NewFakeClock())
+
+	in := make(chan Result[string])
+	out := idem.Process(context.Background(), in)
+
+	if result := sendAndReceive(t, in, out, NewSuccess("a")); !result.IsError() {
+		t.Errorf("expected store error as error Result, got %v", result)
+	}
+	close(in)
+
+	if calls != 0 {
+		t.Errorf("expected side effect not run on store error, got %d calls", calls)
+	}
+}
+
+func TestMemoryIdempotencyStore_SweepsExpiredKeys(t *testing.T) {
+	ctx := context.Background()
+	start := time.Unix(0, 0)
+	store := NewMemoryIdempotencyStore(time.Minute)
+
+	_ = store.Record(ctx, "a", start)
+	_ = store.Record(ctx, "b", start.Add(30*time.Second))
+	if store.Len() != 2 {
+		t.Fatalf("expected 2 keys, got %d", store.Len())
+	}
+
+	_ = store.Record(ctx, "c", start.Add(70*time.Second))
+	if store.Len() != 2 {
+		t.Errorf("expected expired key swept on record, got %d keys", store.Len())
+	}
+	if seen, _ := store.Seen(ctx, "a", start.Add(70*time.Second)); seen {
+		t.Error("expected expired key unseen")
+	}
+	if seen, _ := store.Seen(ctx, "b", start.Add(70*time.Second)); !seen {
+		t.Error("expected unexpired key seen")
+	}
+}
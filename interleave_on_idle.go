@@ -0,0 +1,167 @@
+package streamz
+
+import (
+	"context"
+	"time"
+)
+
+// InterleaveOnIdle forwards a primary stream and fills its quiet periods with
+// items from a low-priority secondary stream, such as status pings or progress
+// reports that should only be sent when the main work is idle.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type InterleaveOnIdle[T any] struct {
+	name      string
+	secondary <-chan Result[T]
+	idle      time.Duration
+	clock     Clock
+}
+
+// NewInterleaveOnIdle creates a processor that emits one secondary item each time
+// the primary stream has been idle for the given duration.
+// Once the primary has been quiet for idle, the processor waits for whichever
+// comes first: a primary item, which is forwarded and restarts the idle period,
+// or a secondary item, which is emitted and also restarts it. A primary item that
+// is ready always wins over a ready secondary item. Secondary items are never
+// read while the primary is busy, so they queue in the secondary channel.
+//
+// The output closes when the primary input closes; the secondary stream is not
+// drained. A closed secondary stream simply stops contributing.
+//
+// When to use:
+//   - Sending keep-alive or status messages on quiet connections
+//   - Emitting progress updates only between bursts of real work
+//   - Backfilling low-priority work when a primary source goes idle
+//
+// Example:
+//
+//	// Report progress when no events have been sent for 10 seconds
+//	progress := make(chan streamz.Result[Message], 1)
+//	interleave := streamz.NewInterleaveOnIdle(progress, 10*time.Second, streamz.RealClock)
+//
+//	messages := interleave.Process(ctx, events)
+//	go func() {
+//		for range ticker.C {
+//			progress <- streamz.NewSuccess(statusMessage())
+//		}
+//	}()
+//
+// Parameters:
+//   - secondary: Low-priority stream read only while the primary is idle
+//   - idle: How long the primary must be quiet before a secondary item is emitted
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new InterleaveOnIdle processor.
+func NewInterleaveOnIdle[T any](secondary <-chan Result[T], idle time.Duration, clock Clock) *InterleaveOnIdle[T] {
+	return &InterleaveOnIdle[T]{
+		name:      "interleave-on-idle",
+		secondary: secondary,
+		idle:      idle,
+		clock:     clock,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "interleave-on-idle".
+func (i *InterleaveOnIdle[T]) WithName(name string) *InterleaveOnIdle[T] {
+	i.name = name
+	return i
+}
+
+// Process forwards the primary stream, interleaving secondary items while it is idle.
+func (i *InterleaveOnIdle[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		secondary := i.secondary
+		var timer Timer
+		var idleC <-chan time.Time
+		restart := func() {
+			if timer != nil {
+				timer.Stop()
+			}
+			timer = i.clock.NewTimer(i.idle)
+			idleC = timer.C()
+		}
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+		restart()
+
+		// Secondary items are only read once the idle period has elapsed
+		idle := false
+
+		for {
+			var fill <-chan Result[T]
+			if idle {
+				fill = secondary
+
+				// A ready primary item takes precedence over the secondary
+				select {
+				case item, ok := <-in:
+					if !ok {
+						return
+					}
+					restart()
+					idle = false
+					if !i.send(ctx, out, item) {
+						return
+					}
+					continue
+				default:
+				}
+			}
+
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+				restart()
+				idle = false
+				if !i.send(ctx, out, item) {
+					return
+				}
+
+			case <-idleC:
+				idleC = nil
+				idle = true
+
+			case item, ok := <-fill:
+				if !ok {
+					secondary = nil
+					continue
+				}
+				restart()
+				idle = false
+				if !i.send(ctx, out, item) {
+					return
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// send forwards item, reporting false if ctx was canceled first.
+func (*InterleaveOnIdle[T]) send(ctx context.Context, out chan<- Result[T], item Result[T]) bool {
+	select {
+	case out <- item:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (i *InterleaveOnIdle[T]) Name() string {
+	return i.name
+}
@@ -0,0 +1,130 @@
+package streamz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+func TestInterleaveOnIdle_Name(t *testing.T) {
+	interleave := NewInterleaveOnIdle(make(chan Result[int]), time.Second, RealClock)
+	if interleave.Name() != "interleave-on-idle" {
+		t.Errorf("expected name 'interleave-on-idle', got %q", interleave.Name())
+	}
+	if interleave.WithName("keepalive").Name() != "keepalive" {
+		t.Errorf("expected name 'keepalive', got %q", interleave.Name())
+	}
+}
+
+func TestInterleaveOnIdle_BusyPrimarySuppressesSecondary(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	secondary := make(chan Result[int], 1)
+	secondary <- NewSuccess(100)
+
+	in := make(chan Result[int])
+	out := NewInterleaveOnIdle(secondary, time.Second, clock).Process(context.Background(), in)
+	defer close(in)
+
+	for i := 1; i <= 5; i++ {
+		in <- NewSuccess(i)
+		if got := receiveN(t, out, 1); got[0] != i {
+			t.Fatalf("expected primary item %d, got %d", i, got[0])
+		}
+		clock.Advance(500 * time.Millisecond)
+		clock.BlockUntilReady()
+	}
+
+	expectNoItem(t, out)
+	if len(secondary) != 1 {
+		t.Error("expected the secondary item to stay queued while the primary is busy")
+	}
+}
+
+func TestInterleaveOnIdle_IdlePrimaryEmitsSecondary(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	secondary := make(chan Result[int], 2)
+	secondary <- NewSuccess(100)
+	secondary <- NewSuccess(101)
+
+	in := make(chan Result[int])
+	out := NewInterleaveOnIdle(secondary, time.Second, clock).Process(context.Background(), in)
+	defer close(in)
+
+	waitForTimer(t, clock)
+	clock.Advance(999 * time.Millisecond)
+	clock.BlockUntilReady()
+	expectNoItem(t, out)
+
+	clock.Advance(time.Millisecond)
+	clock.BlockUntilReady()
+	if got := receiveN(t, out, 1); got[0] != 100 {
+		t.Fatalf("expected secondary item 100, got %d", got[0])
+	}
+
+	// Only one secondary item per idle period
+	expectNoItem(t, out)
+
+	clock.Advance(time.Second)
+	clock.BlockUntilReady()
+	if got := receiveN(t, out, 1); got[0] != 101 {
+		t.Fatalf("expected secondary item 101, got %d", got[0])
+	}
+}
+
+func TestInterleaveOnIdle_PrimaryResumptionTakesPrecedence(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	secondary := make(chan Result[int], 1)
+
+	in := make(chan Result[int])
+	out := NewInterleaveOnIdle(secondary, time.Second, clock).Process(context.Background(), in)
+	defer close(in)
+
+	// Idle with nothing on the secondary: the primary resumes
+	waitForTimer(t, clock)
+	clock.Advance(time.Second)
+	clock.BlockUntilReady()
+	in <- NewSuccess(1)
+	if got := receiveN(t, out, 1); got[0] != 1 {
+		t.Fatalf("expected primary item 1, got %d", got[0])
+	}
+
+	// Resumption restarts the idle period before the secondary is read again
+	secondary <- NewSuccess(100)
+	expectNoItem(t, out)
+
+	clock.Advance(time.Second)
+	clock.BlockUntilReady()
+	if got := receiveN(t, out, 1); got[0] != 100 {
+		t.Fatalf("expected secondary item 100, got %d", got[0])
+	}
+}
+
+func TestInterleaveOnIdle_ClosesWithPrimary(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	secondary := make(chan Result[int])
+	close(secondary)
+
+	in := make(chan Result[int])
+	out := NewInterleaveOnIdle(secondary, time.Second, clock).Process(context.Background(), in)
+
+	waitForTimer(t, clock)
+	clock.Advance(time.Second)
+	clock.BlockUntilReady()
+
+	in <- NewSuccess(1)
+	if got := receiveN(t, out, 1); got[0] != 1 {
+		t.Fatalf("expected primary item 1, got %d", got[0])
+	}
+	close(in)
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Error("expected output to close after the primary closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for output to close")
+	}
+}
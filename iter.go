@@ -0,0 +1,55 @@
+package streamz
+
+import (
+	"context"
+	"iter"
+)
+
+// Iterate adapts a Result channel into an iterator for use with range-over-func:
+//
+//	for result := range streamz.Iterate(ctx, out) {
+//		if result.IsError() {
+//			log.Printf("failed: %v", result.Error())
+//			continue
+//		}
+//		handle(result.Value())
+//	}
+//
+// Iteration ends when the channel closes, when the context is canceled, or when
+// the loop breaks. Breaking out of the loop stops reading but does not cancel the
+// pipeline; cancel its context to release upstream processors.
+func Iterate[T any](ctx context.Context, in <-chan Result[T]) iter.Seq[Result[T]] {
+	return func(yield func(Result[T]) bool) {
+		for {
+			item, ok := receive(ctx, in)
+			if !ok || !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq feeds the values of an iterator into a pipeline as successful Results.
+// The returned channel closes once the iterator is exhausted or the context is
+// canceled, whichever comes first, so an abandoned consumer does not leak the
+// producing goroutine as long as the context is eventually canceled.
+//
+//	numbers := streamz.FromSeq(ctx, slices.Values([]int{1, 2, 3}))
+//	doubled := doubler.Process(ctx, numbers)
+func FromSeq[T any](ctx context.Context, seq iter.Seq[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for value := range seq {
+			select {
+			case out <- NewSuccess(value):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
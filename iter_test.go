@@ -0,0 +1,111 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"slices"
+	"testing"
+	"time"
+)
+
+// waitForGoroutines polls until the goroutine count returns to baseline.
+func waitForGoroutines(t *testing.T, baseline int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > baseline {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected goroutines to return to %d, got %d", baseline, runtime.NumGoroutine())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestIterate_FiniteStream(t *testing.T) {
+	in := make(chan Result[int], 3)
+	in <- NewSuccess(1)
+	in <- NewError(2, errors.New("bad"), "parser")
+	in <- NewSuccess(3)
+	close(in)
+
+	var values []int
+	errorCount := 0
+	for result := range Iterate(context.Background(), in) {
+		if result.IsError() {
+			errorCount++
+			continue
+		}
+		values = append(values, result.Value())
+	}
+
+	if !slices.Equal(values, []int{1, 3}) || errorCount != 1 {
+		t.Errorf("expected values [1 3] and 1 error, got %v and %d", values, errorCount)
+	}
+}
+
+func TestIterate_StopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[int])
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range Iterate(ctx, in) {
+		}
+	}()
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected iteration to stop on cancellation")
+	}
+}
+
+func TestIterate_EarlyBreakNoLeak(t *testing.T) {
+	baseline := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// An endless source and a processor between it and the loop
+	endless := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	doubled := NewMapper(func(_ context.Context, n int) (int, error) {
+		return n * 2, nil
+	}).Process(ctx, FromSeq(ctx, endless))
+
+	var seen []int
+	for result := range Iterate(ctx, doubled) {
+		seen = append(seen, result.Value())
+		if len(seen) == 3 {
+			break
+		}
+	}
+	cancel()
+
+	if !slices.Equal(seen, []int{0, 2, 4}) {
+		t.Errorf("expected [0 2 4], got %v", seen)
+	}
+	waitForGoroutines(t, baseline)
+}
+
+func TestFromSeq_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	input := []string{"a", "b", "c"}
+
+	var output []string
+	for result := range Iterate(ctx, FromSeq(ctx, slices.Values(input))) {
+		if result.IsError() {
+			t.Fatalf("unexpected error: %v", result.Error())
+		}
+		output = append(output, result.Value())
+	}
+
+	if !slices.Equal(output, input) {
+		t.Errorf("expected %v, got %v", input, output)
+	}
+}
@@ -0,0 +1,131 @@
+package streamz
+
+import (
+	"context"
+	"sync"
+)
+
+// KeyedState materializes a stream into a lookup table of the latest value per key
+// while forwarding items unchanged. The table can be queried concurrently with
+// processing, turning a stream of updates into a queryable view such as the latest
+// price per symbol or the current status per device.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type KeyedState[T any] struct {
+	name   string
+	keyFn  func(T) string
+	mu     sync.RWMutex
+	latest map[string]T
+}
+
+// NewKeyedState creates a processor that records the latest successful value per key.
+// Each successful item replaces the stored value for its key before it is forwarded,
+// so a value is queryable no later than it is seen downstream. Errors pass through
+// without affecting the state.
+//
+// When to use:
+//   - Serving current state derived from a change stream
+//   - Latest price, position, or status lookups by key
+//   - Enriching other streams with the most recent reference data
+//
+// Example:
+//
+//	prices := streamz.NewKeyedState(func(q Quote) string { return q.Symbol })
+//	quotes = prices.Process(ctx, quotes)
+//
+//	http.HandleFunc("/price", func(w http.ResponseWriter, r *http.Request) {
+//		if q, ok := prices.Get(r.URL.Query().Get("symbol")); ok {
+//			json.NewEncoder(w).Encode(q)
+//		}
+//	})
+//
+// Parameters:
+//   - keyFn: Extracts the key an item updates
+//
+// Returns a new KeyedState processor.
+func NewKeyedState[T any](keyFn func(T) string) *KeyedState[T] {
+	return &KeyedState[T]{
+		name:   "keyed-state",
+		keyFn:  keyFn,
+		latest: make(map[string]T),
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "keyed-state".
+func (s *KeyedState[T]) WithName(name string) *KeyedState[T] {
+	s.name = name
+	return s
+}
+
+// Get returns the latest value recorded for key.
+// Safe to call concurrently with Process.
+func (s *KeyedState[T]) Get(key string) (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, ok := s.latest[key]
+	return value, ok
+}
+
+// Snapshot returns a point-in-time copy of the latest value per key.
+// The copy is consistent and is not affected by later updates.
+// Safe to call concurrently with Process.
+func (s *KeyedState[T]) Snapshot() map[string]T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snapshot := make(map[string]T, len(s.latest))
+	for k, v := range s.latest {
+		snapshot[k] = v
+	}
+	return snapshot
+}
+
+// Len returns the number of keys currently held.
+// Safe to call concurrently with Process.
+func (s *KeyedState[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.latest)
+}
+
+// Process records each successful item as the latest value for its key and
+// forwards every item unchanged.
+func (s *KeyedState[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+
+				if item.IsSuccess() {
+					value := item.Value()
+					key := s.keyFn(value)
+					s.mu.Lock()
+					s.latest[key] = value
+					s.mu.Unlock()
+				}
+
+				select {
+				case out <- item:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (s *KeyedState[T]) Name() string {
+	return s.name
+}
@@ -0,0 +1,137 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+type quote struct {
+	Symbol string
+	Price  float64
+}
+
+func quoteSymbol(q quote) string { return q.Symbol }
+
+func TestKeyedState_Name(t *testing.T) {
+	state := NewKeyedState(quoteSymbol)
+	if state.Name() != "keyed-state" {
+		t.Errorf("expected name 'keyed-state', got %q", state.Name())
+	}
+	if state.WithName("prices").Name() != "prices" {
+		t.Errorf("expected name 'prices', got %q", state.Name())
+	}
+}
+
+func TestKeyedState_LatestValuePerKey(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[quote], 6)
+	in <- NewSuccess(quote{"AAPL", 100})
+	in <- NewSuccess(quote{"MSFT", 200})
+	in <- NewSuccess(quote{"AAPL", 101})
+	in <- NewError(quote{"AAPL", 0}, errors.New("stale feed"), "feed")
+	in <- NewSuccess(quote{"GOOG", 300})
+	in <- NewSuccess(quote{"MSFT", 199.5})
+	close(in)
+
+	state := NewKeyedState(quoteSymbol)
+	count := 0
+	for range state.Process(ctx, in) {
+		count++
+	}
+	if count != 6 {
+		t.Errorf("expected all 6 items forwarded, got %d", count)
+	}
+
+	expected := map[string]float64{"AAPL": 101, "MSFT": 199.5, "GOOG": 300}
+	for symbol, price := range expected {
+		q, ok := state.Get(symbol)
+		if !ok || q.Price != price {
+			t.Errorf("expected %s at %v, got %v (found=%v)", symbol, price, q.Price, ok)
+		}
+	}
+	if _, ok := state.Get("TSLA"); ok {
+		t.Error("expected unknown key to be missing")
+	}
+	if state.Len() != 3 {
+		t.Errorf("expected 3 keys, got %d", state.Len())
+	}
+}
+
+func TestKeyedState_SnapshotIsCopy(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[quote])
+	state := NewKeyedState(quoteSymbol)
+	out := state.Process(ctx, in)
+
+	in <- NewSuccess(quote{"AAPL", 100})
+	<-out
+	snapshot := state.Snapshot()
+
+	in <- NewSuccess(quote{"AAPL", 105})
+	<-out
+	in <- NewSuccess(quote{"MSFT", 200})
+	<-out
+	close(in)
+
+	if len(snapshot) != 1 || snapshot["AAPL"].Price != 100 {
+		t.Errorf("expected snapshot unaffected by later updates, got %v", snapshot)
+	}
+
+	snapshot["AAPL"] = quote{"AAPL", 0}
+	if q, _ := state.Get("AAPL"); q.Price != 105 {
+		t.Errorf("expected state unaffected by snapshot mutation, got %v", q.Price)
+	}
+}
+
+func TestKeyedState_ConcurrentReads(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[quote])
+	state := NewKeyedState(quoteSymbol)
+	out := state.Process(ctx, in)
+
+	go func() {
+		defer close(in)
+		for i := 0; i < 1000; i++ {
+			in <- NewSuccess(quote{fmt.Sprintf("SYM%d", i%10), float64(i)})
+		}
+	}()
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				for _, q := range state.Snapshot() {
+					if latest, ok := state.Get(q.Symbol); !ok || latest.Price < q.Price {
+						t.Errorf("expected %s to only move forward, got %v after %v", q.Symbol, latest.Price, q.Price)
+						return
+					}
+				}
+				time.Sleep(10 * time.Microsecond)
+			}
+		}()
+	}
+
+	for range out {
+	}
+	close(done)
+	wg.Wait()
+
+	if state.Len() != 10 {
+		t.Errorf("expected 10 keys, got %d", state.Len())
+	}
+	if q, _ := state.Get("SYM9"); q.Price != 999 {
+		t.Errorf("expected final SYM9 price 999, got %v", q.Price)
+	}
+}
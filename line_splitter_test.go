@@ -0,0 +1,140 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+// splitLines feeds chunks through a LineSplitter and collects the records as strings.
+func splitLines(t *testing.T, splitter *LineSplitter, chunks ...string) []string {
+	t.Helper()
+	in := make(chan Result[[]byte], len(chunks))
+	for _, chunk := range chunks {
+		in <- NewSuccess([]byte(chunk))
+	}
+	close(in)
+
+	var records []string
+	for result := range splitter.Process(context.Background(), in) {
+		if result.IsError() {
+			t.Fatalf("unexpected error: %v", result.Error())
+		}
+		records = append(records, string(result.Value()))
+	}
+	return records
+}
+
+func TestLineSplitter_Name(t *testing.T) {
+	splitter := NewLineSplitter('\n')
+	if splitter.Name() != "line-splitter" {
+		t.Errorf("expected name 'line-splitter', got %q", splitter.Name())
+	}
+	if splitter.WithName("log-lines").Name() != "log-lines" {
+		t.Errorf("expected name 'log-lines', got %q", splitter.Name())
+	}
+}
+
+func TestLineSplitter_ReassemblesAcrossChunks(t *testing.T) {
+	got := splitLines(t, NewLineSplitter('\n'), "GET /ind", "ex.html 200\nPOST /api", " 201\n")
+	want := []string{"GET /index.html 200", "POST /api 201"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestLineSplitter_MultipleRecordsPerChunk(t *testing.T) {
+	got := splitLines(t, NewLineSplitter(';'), "a=1;b=2;c=3;")
+	want := []string{"a=1", "b=2", "c=3"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestLineSplitter_TrailingPartialRecord(t *testing.T) {
+	splitter := NewLineSplitter('\n')
+	in := make(chan Result[[]byte])
+	out := splitter.Process(context.Background(), in)
+
+	in <- NewSuccess([]byte("first\nsec"))
+	if got := string((<-out).Value()); got != "first" {
+		t.Errorf("expected 'first', got %q", got)
+	}
+
+	// The partial record is held until a later chunk completes it
+	in <- NewSuccess([]byte("ond\nthi"))
+	if got := string((<-out).Value()); got != "second" {
+		t.Errorf("expected 'second', got %q", got)
+	}
+
+	in <- NewSuccess([]byte("rd"))
+	select {
+	case result := <-out:
+		t.Fatalf("expected partial record held, got %q", result.Value())
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	// ...and flushed when the input closes
+	close(in)
+	if got := string((<-out).Value()); got != "third" {
+		t.Errorf("expected flushed 'third', got %q", got)
+	}
+	if _, ok := <-out; ok {
+		t.Error("expected output closed")
+	}
+}
+
+func TestLineSplitter_EmptyRecords(t *testing.T) {
+	got := splitLines(t, NewLineSplitter('\n'), "a\n\n", "\nb\n")
+	if want := []string{"a", "", "", "b"}; !slices.Equal(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	got = splitLines(t, NewLineSplitter('\n').WithSkipEmpty(true), "a\n\n", "\nb\n")
+	if want := []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("expected empty records skipped %q, got %q", want, got)
+	}
+}
+
+func TestLineSplitter_RecordsDoNotAliasChunks(t *testing.T) {
+	buf := []byte("abc\ndef\n")
+	in := make(chan Result[[]byte], 1)
+	in <- NewSuccess(buf)
+	close(in)
+
+	var records [][]byte
+	for result := range NewLineSplitter('\n').Process(context.Background(), in) {
+		records = append(records, result.Value())
+	}
+
+	// A producer reusing its read buffer must not corrupt emitted records
+	copy(buf, "XXXXXXXX")
+	if string(records[0]) != "abc" || string(records[1]) != "def" {
+		t.Errorf("expected records unaffected by buffer reuse, got %q", records)
+	}
+}
+
+func TestLineSplitter_ErrorsPassThrough(t *testing.T) {
+	in := make(chan Result[[]byte], 3)
+	in <- NewSuccess([]byte("par"))
+	in <- NewError([]byte(nil), errors.New("read timeout"), "reader")
+	in <- NewSuccess([]byte("tial\n"))
+	close(in)
+
+	var results []Result[[]byte]
+	for result := range NewLineSplitter('\n').Process(context.Background(), in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("expected error and one record, got %d results", len(results))
+	}
+	if !results[0].IsError() {
+		t.Error("expected error passed through first")
+	}
+	if string(results[1].Value()) != "partial" {
+		t.Errorf("expected record reassembled around the error, got %q", results[1].Value())
+	}
+}
@@ -0,0 +1,136 @@
+package streamz
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// LoadShedder drops items while an external health check reports the system as
+// overloaded, protecting downstream stages during spikes. The health check can
+// look at anything: CPU, memory, queue depth, or downstream latency.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type LoadShedder[T any] struct {
+	name         string
+	healthy      func() bool
+	clock        Clock
+	minShed      time.Duration
+	droppedCount atomic.Uint64
+	shedding     atomic.Bool
+}
+
+// NewLoadShedder creates a processor that sheds load while healthy returns false.
+// The health check is consulted for every successful item. When it fails,
+// shedding starts and lasts at least the minimum shed duration, one second
+// unless configured with WithMinShedDuration, even if health recovers sooner;
+// after that, the first successful check ends it. This hysteresis keeps a health
+// signal hovering around its threshold from flapping item by item.
+//
+// Shed items are dropped and counted. Errors always pass through, since they
+// carry the information needed to diagnose the overload.
+//
+// When to use:
+//   - Protecting a database or API from overload during traffic spikes
+//   - Prioritizing system stability over completeness for best-effort data
+//   - Reacting to resource pressure that upstream rate limits cannot see
+//
+// Example:
+//
+//	// Shed telemetry while the write queue is more than 80% full
+//	shedder := streamz.NewLoadShedder[Metric](func() bool {
+//		return writer.QueueDepth() < writer.Capacity()*8/10
+//	}, streamz.RealClock).WithMinShedDuration(5 * time.Second)
+//
+//	metrics = shedder.Process(ctx, metrics)
+//
+// Parameters:
+//   - healthy: Reports whether the system can accept more work; must be fast
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new LoadShedder processor.
+func NewLoadShedder[T any](healthy func() bool, clock Clock) *LoadShedder[T] {
+	return &LoadShedder[T]{
+		name:    "load-shedder",
+		healthy: healthy,
+		clock:   clock,
+		minShed: time.Second,
+	}
+}
+
+// WithMinShedDuration sets how long shedding lasts at minimum once started.
+// A duration of zero stops shedding as soon as health recovers.
+// If not set, defaults to one second.
+func (s *LoadShedder[T]) WithMinShedDuration(d time.Duration) *LoadShedder[T] {
+	if d >= 0 {
+		s.minShed = d
+	}
+	return s
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "load-shedder".
+func (s *LoadShedder[T]) WithName(name string) *LoadShedder[T] {
+	s.name = name
+	return s
+}
+
+// DroppedCount returns the number of items shed so far.
+// Safe to call concurrently with Process.
+func (s *LoadShedder[T]) DroppedCount() uint64 {
+	return s.droppedCount.Load()
+}
+
+// Shedding reports whether the processor is currently shedding load.
+// Safe to call concurrently with Process.
+func (s *LoadShedder[T]) Shedding() bool {
+	return s.shedding.Load()
+}
+
+// Process forwards items while healthy and drops them while shedding.
+func (s *LoadShedder[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		var shedSince time.Time
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsSuccess() {
+				now := s.clock.Now()
+				healthy := s.healthy()
+				switch {
+				case !s.shedding.Load() && !healthy:
+					s.shedding.Store(true)
+					shedSince = now
+				case s.shedding.Load() && healthy && now.Sub(shedSince) >= s.minShed:
+					s.shedding.Store(false)
+				}
+
+				if s.shedding.Load() {
+					s.droppedCount.Add(1)
+					continue
+				}
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (s *LoadShedder[T]) Name() string {
+	return s.name
+}
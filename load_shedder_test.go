@@ -0,0 +1,108 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+func TestLoadShedder_Name(t *testing.T) {
+	shedder := NewLoadShedder[int](func() bool { return true }, RealClock)
+	if shedder.Name() != "load-shedder" {
+		t.Errorf("expected name 'load-shedder', got %q", shedder.Name())
+	}
+	if shedder.WithName("ingest-shedder").Name() != "ingest-shedder" {
+		t.Errorf("expected name 'ingest-shedder', got %q", shedder.Name())
+	}
+}
+
+func TestLoadShedder_DropsWhileUnhealthy(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	var healthy atomic.Bool
+	healthy.Store(true)
+	shedder := NewLoadShedder[int](healthy.Load, clock).WithMinShedDuration(0)
+	in := make(chan Result[int])
+	out := shedder.Process(context.Background(), in)
+
+	for _, v := range []int{1, 2} {
+		if passed := sendWithBarrier(t, in, out, NewSuccess(v)); len(passed) != 1 {
+			t.Fatalf("expected item %d to pass while healthy", v)
+		}
+	}
+
+	healthy.Store(false)
+	for _, v := range []int{3, 4} {
+		if passed := sendWithBarrier(t, in, out, NewSuccess(v)); len(passed) != 0 {
+			t.Fatalf("expected item %d dropped while unhealthy", v)
+		}
+	}
+	if !shedder.Shedding() {
+		t.Error("expected shedding while unhealthy")
+	}
+
+	healthy.Store(true)
+	if passed := sendWithBarrier(t, in, out, NewSuccess(5)); len(passed) != 1 {
+		t.Fatal("expected items to pass once healthy again")
+	}
+	close(in)
+
+	if shedder.DroppedCount() != 2 {
+		t.Errorf("expected 2 dropped, got %d", shedder.DroppedCount())
+	}
+}
+
+func TestLoadShedder_HysteresisPreventsFlapping(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	var healthy atomic.Bool
+	healthy.Store(true)
+	shedder := NewLoadShedder[int](healthy.Load, clock).WithMinShedDuration(5 * time.Second)
+	in := make(chan Result[int])
+	out := shedder.Process(context.Background(), in)
+
+	healthy.Store(false)
+	if passed := sendWithBarrier(t, in, out, NewSuccess(1)); len(passed) != 0 {
+		t.Fatal("expected shedding to start")
+	}
+
+	// Health flaps back and forth, but shedding holds for the minimum duration
+	for i := 0; i < 4; i++ {
+		clock.Advance(time.Second)
+		healthy.Store(i%2 == 0)
+		if passed := sendWithBarrier(t, in, out, NewSuccess(i+2)); len(passed) != 0 {
+			t.Fatalf("expected shedding to hold during flapping, item %d passed", i+2)
+		}
+	}
+
+	clock.Advance(time.Second)
+	healthy.Store(true)
+	if passed := sendWithBarrier(t, in, out, NewSuccess(10)); len(passed) != 1 {
+		t.Fatal("expected shedding to end once healthy after the minimum duration")
+	}
+	close(in)
+
+	if shedder.DroppedCount() != 5 {
+		t.Errorf("expected 5 dropped, got %d", shedder.DroppedCount())
+	}
+}
+
+func TestLoadShedder_ErrorsAlwaysPass(t *testing.T) {
+	shedder := NewLoadShedder[int](func() bool { return false }, clockz.NewFakeClock())
+
+	in := make(chan Result[int], 2)
+	in <- NewSuccess(1)
+	in <- NewError(2, errors.New("upstream failure"), "source")
+	close(in)
+
+	var results []Result[int]
+	for result := range shedder.Process(context.Background(), in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 1 || !results[0].IsError() {
+		t.Errorf("expected only the error to pass, got %v", results)
+	}
+}
@@ -81,11 +81,10 @@ func (m *Mapper[In, Out]) Process(ctx context.Context, in <-chan Result[In]) <-c
 	go func() {
 		defer close(out)
 
-		for item := range in {
-			select {
-			case <-ctx.Done():
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
 				return
-			default:
 			}
 
 			if item.IsError() {
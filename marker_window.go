@@ -0,0 +1,144 @@
+package streamz
+
+import (
+	"context"
+)
+
+// MarkerWindow groups items into windows delimited by marker items in the stream.
+// Each time a marker arrives the current window is emitted and a new one begins,
+// making it batching driven by data content rather than size or time.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type MarkerWindow[T any] struct {
+	name          string
+	isMarker      func(T) bool
+	includeMarker bool
+	emitEmpty     bool
+}
+
+// NewMarkerWindow creates a processor that closes a window at every marker item.
+// By default the marker is excluded from the window and markers that would close
+// an empty window are ignored. The final partial window is emitted when input closes.
+// Errors pass through immediately without affecting the current window.
+//
+// When to use:
+//   - Grouping records between commit or checkpoint boundaries
+//   - Assembling messages delimited by end-of-message frames
+//   - Flushing work when an upstream "flush" signal appears in-band
+//
+// Example:
+//
+//	// Apply changes transactionally at each commit record
+//	txns := streamz.NewMarkerWindow(func(c Change) bool {
+//		return c.Op == "COMMIT"
+//	})
+//
+//	for result := range txns.Process(ctx, changes) {
+//		if result.IsSuccess() {
+//			applyTransaction(result.Value())
+//		}
+//	}
+//
+// Parameters:
+//   - isMarker: Reports whether an item closes the current window
+//
+// Returns a new MarkerWindow processor.
+func NewMarkerWindow[T any](isMarker func(T) bool) *MarkerWindow[T] {
+	return &MarkerWindow[T]{
+		name:     "marker-window",
+		isMarker: isMarker,
+	}
+}
+
+// WithIncludeMarker appends the marker as the last item of the window it closes.
+// If not set, markers are dropped.
+func (w *MarkerWindow[T]) WithIncludeMarker(include bool) *MarkerWindow[T] {
+	w.includeMarker = include
+	return w
+}
+
+// WithEmitEmpty emits an empty window for markers that arrive with no items
+// pending, such as back-to-back markers. Only applies when markers are excluded;
+// an included marker always makes its window non-empty.
+// If not set, such markers produce no output.
+func (w *MarkerWindow[T]) WithEmitEmpty(emit bool) *MarkerWindow[T] {
+	w.emitEmpty = emit
+	return w
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "marker-window".
+func (w *MarkerWindow[T]) WithName(name string) *MarkerWindow[T] {
+	w.name = name
+	return w
+}
+
+// Process groups items into windows, emitting one at each marker and a final
+// non-empty window when input closes.
+func (w *MarkerWindow[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[[]T] {
+	out := make(chan Result[[]T])
+
+	go func() {
+		defer close(out)
+
+		var window []T
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					if len(window) > 0 {
+						select {
+						case out <- NewSuccess(window):
+						case <-ctx.Done():
+						}
+					}
+					return
+				}
+
+				if item.IsError() {
+					errorResult := NewError(make([]T, 0), item.Error().Err, item.Error().ProcessorName)
+					select {
+					case out <- errorResult:
+					case <-ctx.Done():
+						return
+					}
+					continue
+				}
+
+				value := item.Value()
+				if !w.isMarker(value) {
+					window = append(window, value)
+					continue
+				}
+
+				if w.includeMarker {
+					window = append(window, value)
+				}
+				if len(window) == 0 && !w.emitEmpty {
+					continue
+				}
+				if window == nil {
+					window = make([]T, 0)
+				}
+
+				select {
+				case out <- NewSuccess(window):
+					window = nil
+				case <-ctx.Done():
+					return
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (w *MarkerWindow[T]) Name() string {
+	return w.name
+}
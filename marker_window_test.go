@@ -0,0 +1,121 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func isCommit(s string) bool { return s == "COMMIT" }
+
+// collectMarkerWindows runs values through the window and formats each result.
+func collectMarkerWindows(window *MarkerWindow[string], values ...string) []string {
+	in := make(chan Result[string], len(values))
+	for _, v := range values {
+		in <- NewSuccess(v)
+	}
+	close(in)
+
+	var windows []string
+	for result := range window.Process(context.Background(), in) {
+		windows = append(windows, fmt.Sprint(result.Value()))
+	}
+	return windows
+}
+
+func assertWindows(t *testing.T, expected, got []string) {
+	t.Helper()
+	if len(got) != len(expected) {
+		t.Fatalf("expected windows %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("window %d: expected %s, got %s", i, expected[i], got[i])
+		}
+	}
+}
+
+func TestMarkerWindow_Name(t *testing.T) {
+	window := NewMarkerWindow(isCommit)
+	if window.Name() != "marker-window" {
+		t.Errorf("expected name 'marker-window', got %q", window.Name())
+	}
+	if window.WithName("txn-window").Name() != "txn-window" {
+		t.Errorf("expected name 'txn-window', got %q", window.Name())
+	}
+}
+
+func TestMarkerWindow_ClosesAtMarkers(t *testing.T) {
+	got := collectMarkerWindows(NewMarkerWindow(isCommit),
+		"a", "b", "COMMIT", "c", "COMMIT", "d", "e", "f", "COMMIT")
+	assertWindows(t, []string{"[a b]", "[c]", "[d e f]"}, got)
+}
+
+func TestMarkerWindow_IncludeMarker(t *testing.T) {
+	got := collectMarkerWindows(NewMarkerWindow(isCommit).WithIncludeMarker(true),
+		"a", "COMMIT", "COMMIT", "b", "COMMIT")
+	assertWindows(t, []string{"[a COMMIT]", "[COMMIT]", "[b COMMIT]"}, got)
+}
+
+func TestMarkerWindow_EmptyWindows(t *testing.T) {
+	values := []string{"COMMIT", "a", "COMMIT", "COMMIT", "b", "COMMIT"}
+
+	t.Run("skipped by default", func(t *testing.T) {
+		got := collectMarkerWindows(NewMarkerWindow(isCommit), values...)
+		assertWindows(t, []string{"[a]", "[b]"}, got)
+	})
+
+	t.Run("emitted when enabled", func(t *testing.T) {
+		got := collectMarkerWindows(NewMarkerWindow(isCommit).WithEmitEmpty(true), values...)
+		assertWindows(t, []string{"[]", "[a]", "[]", "[b]"}, got)
+	})
+}
+
+func TestMarkerWindow_FlushOnClose(t *testing.T) {
+	got := collectMarkerWindows(NewMarkerWindow(isCommit).WithEmitEmpty(true),
+		"a", "COMMIT", "b", "c")
+	assertWindows(t, []string{"[a]", "[b c]"}, got)
+
+	// Nothing pending at close produces no trailing window
+	got = collectMarkerWindows(NewMarkerWindow(isCommit).WithEmitEmpty(true), "a", "COMMIT")
+	assertWindows(t, []string{"[a]"}, got)
+}
+
+func TestMarkerWindow_ErrorsPassThrough(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[string], 4)
+	in <- NewSuccess("a")
+	in <- NewError("bad", errors.New("decode failed"), "decoder")
+	in <- NewSuccess("b")
+	in <- NewSuccess("COMMIT")
+	close(in)
+
+	var results []Result[[]string]
+	for result := range NewMarkerWindow(isCommit).Process(ctx, in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("expected error and one window, got %d results", len(results))
+	}
+	if !results[0].IsError() || results[0].Error().ProcessorName != "decoder" {
+		t.Errorf("expected upstream error first, got %v", results[0])
+	}
+	if fmt.Sprint(results[1].Value()) != "[a b]" {
+		t.Errorf("expected error not to split the window, got %v", results[1].Value())
+	}
+}
+
+func TestMarkerWindow_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[string])
+	out := NewMarkerWindow(isCommit).Process(ctx, in)
+
+	in <- NewSuccess("a")
+	cancel()
+
+	for range out {
+		t.Error("expected no windows after cancellation")
+	}
+}
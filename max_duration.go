@@ -0,0 +1,113 @@
+package streamz
+
+import (
+	"context"
+	"time"
+)
+
+// MaxDuration forwards items until a fixed time limit has elapsed, then closes its
+// output whether or not the input is exhausted. It is the time-based counterpart
+// of taking the first N items, useful for bounded test runs and as a safety limit
+// on otherwise unbounded streams.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type MaxDuration[T any] struct {
+	name     string
+	duration time.Duration
+	clock    Clock
+}
+
+// NewMaxDuration creates a processor that stops d after Process is called.
+// Items, successes and errors alike, pass through unchanged until the limit;
+// at the limit the output closes and no further input is read, including an
+// item already received but not yet delivered. A non-positive d closes the
+// output immediately.
+//
+// MaxDuration stops reading without canceling anything upstream. Cancel the
+// upstream context once the output closes so producers do not block.
+//
+// When to use:
+//   - Running a pipeline for a fixed sampling period
+//   - Bounding integration tests that read from live or endless sources
+//   - Enforcing a hard time budget on batch jobs
+//
+// Example:
+//
+//	// Sample live traffic for 30 seconds
+//	ctx, cancel := context.WithCancel(ctx)
+//	defer cancel()
+//
+//	limit := streamz.NewMaxDuration[Event](30*time.Second, streamz.RealClock)
+//	for result := range limit.Process(ctx, source.Process(ctx)) {
+//		profile.Add(result)
+//	}
+//	cancel() // stop the source
+//
+// Parameters:
+//   - d: How long to forward items before closing the output
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new MaxDuration processor.
+func NewMaxDuration[T any](d time.Duration, clock Clock) *MaxDuration[T] {
+	return &MaxDuration[T]{
+		name:     "max-duration",
+		duration: d,
+		clock:    clock,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "max-duration".
+func (m *MaxDuration[T]) WithName(name string) *MaxDuration[T] {
+	m.name = name
+	return m
+}
+
+// Process forwards items until the time limit elapses, the input closes, or ctx is canceled.
+func (m *MaxDuration[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		if m.duration <= 0 {
+			return
+		}
+		deadline := m.clock.NewTimer(m.duration)
+		defer deadline.Stop()
+
+		for {
+			// The limit takes priority over input that is already waiting
+			select {
+			case <-deadline.C():
+				return
+			default:
+			}
+
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case out <- item:
+				case <-deadline.C():
+					return
+				case <-ctx.Done():
+					return
+				}
+			case <-deadline.C():
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (m *MaxDuration[T]) Name() string {
+	return m.name
+}
@@ -0,0 +1,104 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+func TestMaxDuration_Name(t *testing.T) {
+	limit := NewMaxDuration[int](time.Second, RealClock)
+	if limit.Name() != "max-duration" {
+		t.Errorf("expected name 'max-duration', got %q", limit.Name())
+	}
+	if limit.WithName("sampling-period").Name() != "sampling-period" {
+		t.Errorf("expected name 'sampling-period', got %q", limit.Name())
+	}
+}
+
+func TestMaxDuration_ClosesAfterDuration(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	limit := NewMaxDuration[int](time.Minute, clock)
+
+	in := make(chan Result[int], 10)
+	out := limit.Process(context.Background(), in)
+
+	in <- NewSuccess(1)
+	in <- NewError(2, errors.New("bad"), "parser")
+	if got := <-out; got.Value() != 1 {
+		t.Errorf("expected 1, got %d", got.Value())
+	}
+	if got := <-out; !got.IsError() {
+		t.Error("expected error passed through")
+	}
+
+	waitForTimer(t, clock)
+	clock.Advance(59 * time.Second)
+	clock.BlockUntilReady()
+	in <- NewSuccess(3)
+	if got := <-out; got.Value() != 3 {
+		t.Errorf("expected 3 before the limit, got %d", got.Value())
+	}
+
+	clock.Advance(time.Second)
+	clock.BlockUntilReady()
+
+	// Input is still open with items pending, yet the output closes
+	for i := 4; i <= 6; i++ {
+		in <- NewSuccess(i)
+	}
+	for result := range out {
+		t.Errorf("expected no items after the limit, got %v", result.Value())
+	}
+}
+
+func TestMaxDuration_InputClosedBeforeLimit(t *testing.T) {
+	in := make(chan Result[int], 2)
+	in <- NewSuccess(1)
+	in <- NewSuccess(2)
+	close(in)
+
+	limit := NewMaxDuration[int](time.Minute, clockz.NewFakeClock())
+	count := 0
+	for range limit.Process(context.Background(), in) {
+		count++
+	}
+
+	if count != 2 {
+		t.Errorf("expected 2 items, got %d", count)
+	}
+}
+
+func TestMaxDuration_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	limit := NewMaxDuration[int](time.Minute, clockz.NewFakeClock())
+
+	in := make(chan Result[int])
+	out := limit.Process(ctx, in)
+
+	in <- NewSuccess(1)
+	<-out
+	cancel()
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Error("expected no items after cancellation")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected output closed after cancellation")
+	}
+}
+
+func TestMaxDuration_NonPositiveDuration(t *testing.T) {
+	in := make(chan Result[int], 1)
+	in <- NewSuccess(1)
+
+	limit := NewMaxDuration[int](0, clockz.NewFakeClock())
+	if _, ok := <-limit.Process(context.Background(), in); ok {
+		t.Error("expected output closed immediately")
+	}
+}
@@ -0,0 +1,103 @@
+package streamz
+
+import (
+	"context"
+)
+
+// MetadataJoin is a stream-table join whose table is the latest-value view of
+// another stream, as maintained by a KeyedState. Each item is looked up by key
+// and metadata derived from the matching table entry is stamped onto its Result,
+// leaving the value itself untouched.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type MetadataJoin[T, E any] struct {
+	name      string
+	table     *KeyedState[E]
+	keyFn     func(T) string
+	mergeMeta func(E) map[string]interface{}
+}
+
+// NewMetadataJoin creates a processor that adds metadata from table to matching items.
+// For each successful item, the table entry for keyFn(item) is read at the moment
+// the item is processed, so updates to the table apply to every later item.
+// The entries returned by mergeMeta are added to the Result's metadata, overriding
+// existing keys. Items with no table entry, and errors, pass through unchanged.
+//
+// The join only sees table updates that the KeyedState has processed; items
+// arriving before their key has been recorded are not matched, so start the table
+// stream first when initial reference data matters.
+//
+// When to use:
+//   - Tagging events with the current tier, region, or owner of their account
+//   - Attaching slowly-changing reference data without changing item types
+//   - Routing on table attributes with ResultRouter after the join
+//
+// Example:
+//
+//	accounts := streamz.NewKeyedState(func(a Account) string { return a.ID })
+//	go drain(accounts.Process(ctx, accountUpdates))
+//
+//	join := streamz.NewMetadataJoin(accounts, func(o Order) string {
+//		return o.AccountID
+//	}, func(a Account) map[string]interface{} {
+//		return map[string]interface{}{"tier": a.Tier, "region": a.Region}
+//	})
+//
+//	tagged := join.Process(ctx, orders)
+//
+// Parameters:
+//   - table: Latest value per key, updated by its own stream
+//   - keyFn: Extracts the lookup key from an item
+//   - mergeMeta: Derives the metadata to add from a table entry
+//
+// Returns a new MetadataJoin processor.
+func NewMetadataJoin[T, E any](table *KeyedState[E], keyFn func(T) string, mergeMeta func(E) map[string]interface{}) *MetadataJoin[T, E] {
+	return &MetadataJoin[T, E]{
+		name:      "metadata-join",
+		table:     table,
+		keyFn:     keyFn,
+		mergeMeta: mergeMeta,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "metadata-join".
+func (j *MetadataJoin[T, E]) WithName(name string) *MetadataJoin[T, E] {
+	j.name = name
+	return j
+}
+
+// Process stamps each item that has a table entry with the derived metadata.
+func (j *MetadataJoin[T, E]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsSuccess() {
+				if entry, found := j.table.Get(j.keyFn(item.Value())); found {
+					item = item.WithMetadataMap(j.mergeMeta(entry))
+				}
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (j *MetadataJoin[T, E]) Name() string {
+	return j.name
+}
@@ -0,0 +1,113 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type trade struct {
+	Symbol   string
+	Quantity int
+}
+
+func tradeSymbol(tr trade) string { return tr.Symbol }
+
+func quotePriceMeta(q quote) map[string]interface{} {
+	return map[string]interface{}{"price": q.Price, MetadataSource: "quotes"}
+}
+
+// loadQuotes runs quotes through table and waits until all are recorded.
+func loadQuotes(t *testing.T, table *KeyedState[quote], quotes ...quote) {
+	t.Helper()
+	in := make(chan Result[quote], len(quotes))
+	for _, q := range quotes {
+		in <- NewSuccess(q)
+	}
+	close(in)
+	if got := collectResults(table.Process(context.Background(), in), time.Second); len(got) != len(quotes) {
+		t.Fatalf("expected %d quotes recorded, got %d", len(quotes), len(got))
+	}
+}
+
+func TestMetadataJoin_Name(t *testing.T) {
+	join := NewMetadataJoin(NewKeyedState(quoteSymbol), tradeSymbol, quotePriceMeta)
+	if join.Name() != "metadata-join" {
+		t.Errorf("expected name 'metadata-join', got %q", join.Name())
+	}
+	if join.WithName("price-join").Name() != "price-join" {
+		t.Errorf("expected name 'price-join', got %q", join.Name())
+	}
+}
+
+func TestMetadataJoin_StampsMatchingItems(t *testing.T) {
+	table := NewKeyedState(quoteSymbol)
+	loadQuotes(t, table, quote{"AAPL", 100}, quote{"MSFT", 200})
+
+	in := make(chan Result[trade], 4)
+	in <- NewSuccess(trade{"AAPL", 5}).WithMetadata(MetadataSource, "orders")
+	in <- NewSuccess(trade{"GOOG", 3}).WithMetadata(MetadataSource, "orders")
+	in <- NewError(trade{"MSFT", 1}, errors.New("rejected"), "risk")
+	in <- NewSuccess(trade{"MSFT", 2})
+	close(in)
+
+	results := collectResults(NewMetadataJoin(table, tradeSymbol, quotePriceMeta).Process(context.Background(), in), time.Second)
+	if len(results) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(results))
+	}
+
+	if price, _ := results[0].GetMetadata("price"); price != 100.0 {
+		t.Errorf("expected AAPL price 100, got %v", price)
+	}
+	// Joined metadata overrides existing keys
+	if source, _, _ := results[0].GetStringMetadata(MetadataSource); source != "quotes" {
+		t.Errorf("expected joined source 'quotes', got %q", source)
+	}
+	if results[0].Value() != (trade{"AAPL", 5}) {
+		t.Errorf("expected value unchanged, got %v", results[0].Value())
+	}
+
+	if _, found := results[1].GetMetadata("price"); found {
+		t.Error("expected unmatched item without price")
+	}
+	if source, _, _ := results[1].GetStringMetadata(MetadataSource); source != "orders" {
+		t.Errorf("expected unmatched item unchanged, got source %q", source)
+	}
+
+	if !results[2].IsError() || results[2].HasMetadata() {
+		t.Errorf("expected error passed through unchanged, got %v", results[2])
+	}
+	if price, _ := results[3].GetMetadata("price"); price != 200.0 {
+		t.Errorf("expected MSFT price 200, got %v", price)
+	}
+}
+
+func TestMetadataJoin_ReflectsTableUpdates(t *testing.T) {
+	table := NewKeyedState(quoteSymbol)
+	loadQuotes(t, table, quote{"AAPL", 100})
+
+	in := make(chan Result[trade])
+	out := NewMetadataJoin(table, tradeSymbol, quotePriceMeta).Process(context.Background(), in)
+	defer close(in)
+
+	in <- NewSuccess(trade{"AAPL", 1})
+	if price, _ := (<-out).GetMetadata("price"); price != 100.0 {
+		t.Errorf("expected price 100 before update, got %v", price)
+	}
+	in <- NewSuccess(trade{"GOOG", 1})
+	if _, found := (<-out).GetMetadata("price"); found {
+		t.Error("expected GOOG unmatched before it is recorded")
+	}
+
+	loadQuotes(t, table, quote{"AAPL", 105}, quote{"GOOG", 300})
+
+	in <- NewSuccess(trade{"AAPL", 1})
+	if price, _ := (<-out).GetMetadata("price"); price != 105.0 {
+		t.Errorf("expected updated price 105, got %v", price)
+	}
+	in <- NewSuccess(trade{"GOOG", 1})
+	if price, _ := (<-out).GetMetadata("price"); price != 300.0 {
+		t.Errorf("expected newly recorded price 300, got %v", price)
+	}
+}
@@ -0,0 +1,114 @@
+package streamz
+
+import (
+	"context"
+)
+
+// MetadataPipelineMode records the operating mode of the pipeline that produced an item.
+const MetadataPipelineMode = "pipeline_mode" // string - ModeLive or ModeBackfill
+
+// Standard pipeline modes stamped by ModeTagger.
+const (
+	ModeLive     = "live"     // Processing current data as it arrives
+	ModeBackfill = "backfill" // Replaying or catching up on historical data
+)
+
+// ModeTagger stamps every Result with the pipeline mode so downstream processors
+// can adapt their behavior, for example skipping alerts while backfilling.
+type ModeTagger[T any] struct {
+	name string
+	mode string
+}
+
+// NewModeTagger creates a processor that sets MetadataPipelineMode on every Result,
+// successful or error, replacing any mode already present.
+//
+// When to use:
+//   - Marking replayed history so alerting and notifications can be skipped
+//   - Distinguishing catch-up traffic from live traffic in metrics
+//   - Sharing one pipeline definition between live and backfill runs
+//
+// Example:
+//
+//	mode := streamz.ModeLive
+//	if replaying {
+//		mode = streamz.ModeBackfill
+//	}
+//	tagged := streamz.NewModeTagger[Event](mode).Process(ctx, events)
+//
+//	for result := range tagged {
+//		if result.IsSuccess() && !streamz.IsBackfill(result) {
+//			alert(result.Value())
+//		}
+//	}
+//
+// Parameters:
+//   - mode: Mode to stamp, typically ModeLive or ModeBackfill
+//
+// Returns a new ModeTagger processor.
+func NewModeTagger[T any](mode string) *ModeTagger[T] {
+	return &ModeTagger[T]{
+		name: "mode-tagger",
+		mode: mode,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "mode-tagger".
+func (m *ModeTagger[T]) WithName(name string) *ModeTagger[T] {
+	m.name = name
+	return m
+}
+
+// Process forwards every Result stamped with the configured mode.
+func (m *ModeTagger[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+
+				select {
+				case out <- item.WithMetadata(MetadataPipelineMode, m.mode):
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (m *ModeTagger[T]) Name() string {
+	return m.name
+}
+
+// PipelineMode returns the mode stamped on a Result.
+// Results without a mode are considered live.
+func PipelineMode[T any](r Result[T]) string {
+	if mode, found, err := r.GetStringMetadata(MetadataPipelineMode); found && err == nil {
+		return mode
+	}
+	return ModeLive
+}
+
+// IsBackfill reports whether a Result was produced in backfill mode.
+func IsBackfill[T any](r Result[T]) bool {
+	return PipelineMode(r) == ModeBackfill
+}
+
+// IsLive reports whether a Result was produced in live mode, including
+// Results that carry no mode at all.
+func IsLive[T any](r Result[T]) bool {
+	return PipelineMode(r) == ModeLive
+}
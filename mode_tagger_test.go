@@ -0,0 +1,70 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestModeTagger_Name(t *testing.T) {
+	tagger := NewModeTagger[int](ModeBackfill)
+	if tagger.Name() != "mode-tagger" {
+		t.Errorf("expected name 'mode-tagger', got %q", tagger.Name())
+	}
+	if tagger.WithName("replay-mode").Name() != "replay-mode" {
+		t.Errorf("expected name 'replay-mode', got %q", tagger.Name())
+	}
+}
+
+func TestModeTagger_StampsEveryResult(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int], 3)
+	in <- NewSuccess(1)
+	in <- NewError(2, errors.New("bad"), "parser")
+	in <- NewSuccess(3).WithMetadata(MetadataPipelineMode, ModeLive)
+	close(in)
+
+	count := 0
+	for result := range NewModeTagger[int](ModeBackfill).Process(ctx, in) {
+		count++
+		mode, found, err := result.GetStringMetadata(MetadataPipelineMode)
+		if !found || err != nil || mode != ModeBackfill {
+			t.Errorf("expected mode %q, got %q (found=%v, err=%v)", ModeBackfill, mode, found, err)
+		}
+		if !IsBackfill(result) || IsLive(result) {
+			t.Errorf("expected helpers to report backfill for %v", result)
+		}
+	}
+	if count != 3 {
+		t.Errorf("expected 3 results, got %d", count)
+	}
+}
+
+func TestPipelineMode_Helpers(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   Result[int]
+		mode     string
+		backfill bool
+	}{
+		{"no mode defaults to live", NewSuccess(1), ModeLive, false},
+		{"live", NewSuccess(1).WithMetadata(MetadataPipelineMode, ModeLive), ModeLive, false},
+		{"backfill", NewSuccess(1).WithMetadata(MetadataPipelineMode, ModeBackfill), ModeBackfill, true},
+		{"custom mode", NewSuccess(1).WithMetadata(MetadataPipelineMode, "shadow"), "shadow", false},
+		{"wrong type defaults to live", NewSuccess(1).WithMetadata(MetadataPipelineMode, 1), ModeLive, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if mode := PipelineMode(tt.result); mode != tt.mode {
+				t.Errorf("expected mode %q, got %q", tt.mode, mode)
+			}
+			if IsBackfill(tt.result) != tt.backfill {
+				t.Errorf("expected IsBackfill=%v", tt.backfill)
+			}
+			if IsLive(tt.result) != (tt.mode == ModeLive) {
+				t.Errorf("expected IsLive=%v", tt.mode == ModeLive)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package streamz
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// MetadataClamped flags items whose timestamp MonotonicClamp moved forward.
+const MetadataClamped = "clamped" // bool - timestamp was raised to the running maximum
+
+// MonotonicClamp enforces non-decreasing timestamps on a stream whose source
+// occasionally goes backwards, protecting windowing and other event-time logic
+// downstream. Late items are either clamped up to the latest timestamp seen so
+// far or dropped.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type MonotonicClamp[T any] struct {
+	name       string
+	tsFn       func(T) time.Time
+	setFn      func(T, time.Time) T
+	drop       bool
+	violations atomic.Uint64
+}
+
+// NewMonotonicClamp creates a processor that keeps timestamps from going backwards.
+// Items at or after the running maximum pass unchanged and advance it. An item
+// earlier than the maximum has its timestamp replaced with the maximum via setFn
+// and is flagged with MetadataClamped, or is dropped when configured with
+// WithDropViolations(true). Errors pass through and do not affect the maximum.
+//
+// When to use:
+//   - Sanitizing device or log timestamps with small clock skews
+//   - Feeding event-time windows that assume ordered input
+//   - Measuring how often a source produces out-of-order events
+//
+// Example:
+//
+//	clamp := streamz.NewMonotonicClamp(
+//		func(r Reading) time.Time { return r.At },
+//		func(r Reading, at time.Time) Reading {
+//			r.At = at
+//			return r
+//		},
+//	)
+//
+//	ordered := clamp.Process(ctx, readings)
+//
+// Parameters:
+//   - tsFn: Extracts the timestamp from an item
+//   - setFn: Returns a copy of the item with its timestamp replaced
+//
+// Returns a new MonotonicClamp processor.
+func NewMonotonicClamp[T any](tsFn func(T) time.Time, setFn func(T, time.Time) T) *MonotonicClamp[T] {
+	return &MonotonicClamp[T]{
+		name:  "monotonic-clamp",
+		tsFn:  tsFn,
+		setFn: setFn,
+	}
+}
+
+// WithDropViolations drops items with a backward timestamp instead of clamping them.
+// If not set, defaults to false (items are clamped and flagged).
+func (m *MonotonicClamp[T]) WithDropViolations(drop bool) *MonotonicClamp[T] {
+	m.drop = drop
+	return m
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "monotonic-clamp".
+func (m *MonotonicClamp[T]) WithName(name string) *MonotonicClamp[T] {
+	m.name = name
+	return m
+}
+
+// ViolationCount returns the number of items whose timestamp went backwards,
+// whether clamped or dropped.
+// Safe to call concurrently with Process.
+func (m *MonotonicClamp[T]) ViolationCount() uint64 {
+	return m.violations.Load()
+}
+
+// Process forwards items with non-decreasing timestamps.
+func (m *MonotonicClamp[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		var latest time.Time
+		seen := false
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsSuccess() {
+				ts := m.tsFn(item.Value())
+				switch {
+				case !seen || !ts.Before(latest):
+					latest, seen = ts, true
+				case m.drop:
+					m.violations.Add(1)
+					continue
+				default:
+					m.violations.Add(1)
+					item = Result[T]{value: m.setFn(item.Value(), latest), metadata: item.metadata}.
+						WithMetadata(MetadataClamped, true)
+				}
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (m *MonotonicClamp[T]) Name() string {
+	return m.name
+}
@@ -0,0 +1,117 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var clampBase = time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+
+func setEventTime(e timedEvent, at time.Time) timedEvent {
+	e.At = at
+	return e
+}
+
+func clampEvents(t *testing.T, clamp *MonotonicClamp[timedEvent], offsets ...time.Duration) []Result[timedEvent] {
+	t.Helper()
+	in := make(chan Result[timedEvent], len(offsets))
+	for i, offset := range offsets {
+		in <- NewSuccess(timedEvent{ID: string(rune('a' + i)), At: clampBase.Add(offset)})
+	}
+	close(in)
+
+	var results []Result[timedEvent]
+	for result := range clamp.Process(context.Background(), in) {
+		results = append(results, result)
+	}
+	return results
+}
+
+func TestMonotonicClamp_Name(t *testing.T) {
+	clamp := NewMonotonicClamp(eventTime, setEventTime)
+	if clamp.Name() != "monotonic-clamp" {
+		t.Errorf("expected name 'monotonic-clamp', got %q", clamp.Name())
+	}
+	if clamp.WithName("skew-guard").Name() != "skew-guard" {
+		t.Errorf("expected name 'skew-guard', got %q", clamp.Name())
+	}
+}
+
+func TestMonotonicClamp_IncreasingUnchanged(t *testing.T) {
+	clamp := NewMonotonicClamp(eventTime, setEventTime)
+	results := clampEvents(t, clamp, 0, time.Second, time.Second, 5*time.Second)
+
+	if len(results) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(results))
+	}
+	for i, offset := range []time.Duration{0, time.Second, time.Second, 5 * time.Second} {
+		if !results[i].Value().At.Equal(clampBase.Add(offset)) {
+			t.Errorf("expected item %d unchanged, got %v", i, results[i].Value().At)
+		}
+		if _, found := results[i].GetMetadata(MetadataClamped); found {
+			t.Errorf("expected item %d not flagged", i)
+		}
+	}
+	if clamp.ViolationCount() != 0 {
+		t.Errorf("expected no violations, got %d", clamp.ViolationCount())
+	}
+}
+
+func TestMonotonicClamp_BackwardJumpClamped(t *testing.T) {
+	clamp := NewMonotonicClamp(eventTime, setEventTime)
+	// c jumps back below b; e moves the maximum forward again
+	results := clampEvents(t, clamp, 0, 10*time.Second, 3*time.Second, 12*time.Second, 11*time.Second)
+
+	expected := []time.Duration{0, 10 * time.Second, 10 * time.Second, 12 * time.Second, 12 * time.Second}
+	flagged := []bool{false, false, true, false, true}
+	for i := range expected {
+		if !results[i].Value().At.Equal(clampBase.Add(expected[i])) {
+			t.Errorf("item %d: expected %v, got %v", i, clampBase.Add(expected[i]), results[i].Value().At)
+		}
+		clamped, _ := results[i].GetMetadata(MetadataClamped)
+		if (clamped == true) != flagged[i] {
+			t.Errorf("item %d: expected clamped=%v, got %v", i, flagged[i], clamped)
+		}
+	}
+	if clamp.ViolationCount() != 2 {
+		t.Errorf("expected 2 violations, got %d", clamp.ViolationCount())
+	}
+}
+
+func TestMonotonicClamp_DropViolations(t *testing.T) {
+	clamp := NewMonotonicClamp(eventTime, setEventTime).WithDropViolations(true)
+	results := clampEvents(t, clamp, 0, 10*time.Second, 3*time.Second, 12*time.Second)
+
+	if len(results) != 3 {
+		t.Fatalf("expected backward item dropped, got %d results", len(results))
+	}
+	if results[2].Value().ID != "d" {
+		t.Errorf("expected item d after the dropped item, got %q", results[2].Value().ID)
+	}
+	if clamp.ViolationCount() != 1 {
+		t.Errorf("expected 1 violation, got %d", clamp.ViolationCount())
+	}
+}
+
+func TestMonotonicClamp_ErrorsPassThrough(t *testing.T) {
+	in := make(chan Result[timedEvent], 3)
+	in <- NewSuccess(timedEvent{ID: "a", At: clampBase})
+	in <- NewError(timedEvent{ID: "bad", At: clampBase.Add(time.Hour)}, errors.New("decode failed"), "decoder")
+	in <- NewSuccess(timedEvent{ID: "b", At: clampBase.Add(time.Minute)})
+	close(in)
+
+	var results []Result[timedEvent]
+	for result := range NewMonotonicClamp(eventTime, setEventTime).Process(context.Background(), in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 3 || !results[1].IsError() {
+		t.Fatalf("expected error passed through, got %v", results)
+	}
+	// The error's later timestamp must not raise the maximum
+	if !results[2].Value().At.Equal(clampBase.Add(time.Minute)) {
+		t.Errorf("expected item b unclamped, got %v", results[2].Value().At)
+	}
+}
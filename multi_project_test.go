@@ -0,0 +1,110 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+type invoice struct {
+	Number   string
+	Quantity int
+}
+
+func TestMultiProject_Name(t *testing.T) {
+	split := NewMultiProject[invoice]()
+	if split.Name() != "multi-project" {
+		t.Errorf("expected name 'multi-project', got %q", split.Name())
+	}
+	if split.WithName("invoice-split").Name() != "invoice-split" {
+		t.Errorf("expected name 'invoice-split', got %q", split.Name())
+	}
+}
+
+func TestMultiProject_DispatchesEachProjection(t *testing.T) {
+	errNoNumber := errors.New("missing number")
+	split := NewMultiProject[invoice]().WithBufferSize(10)
+	quantities := AddProjection(split, "quantity", func(i invoice) (int, error) {
+		return i.Quantity, nil
+	})
+	numbers := AddProjection(split, "number", func(i invoice) (string, error) {
+		if i.Number == "" {
+			return "", errNoNumber
+		}
+		return strings.ToUpper(i.Number), nil
+	})
+
+	if names := split.ProjectionNames(); !slices.Equal(names, []string{"quantity", "number"}) {
+		t.Errorf("expected projections [quantity number], got %v", names)
+	}
+
+	in := make(chan Result[invoice], 4)
+	in <- NewSuccess(invoice{"inv-1", 3}).WithMetadata(MetadataSource, "erp")
+	in <- NewSuccess(invoice{"", 5})
+	in <- NewError(invoice{}, errors.New("decode failed"), "decoder")
+	in <- NewSuccess(invoice{"inv-2", 7})
+	close(in)
+
+	split.Process(context.Background(), in)
+
+	gotQuantities := collectResults(quantities, time.Second)
+	gotNumbers := collectResults(numbers, time.Second)
+	if len(gotQuantities) != 4 || len(gotNumbers) != 4 {
+		t.Fatalf("expected 4 results per projection, got %d and %d", len(gotQuantities), len(gotNumbers))
+	}
+
+	for i, want := range map[int]int{0: 3, 1: 5, 3: 7} {
+		if gotQuantities[i].IsError() || gotQuantities[i].Value() != want {
+			t.Errorf("quantity %d: expected %d, got %v", i, want, gotQuantities[i])
+		}
+	}
+	if gotNumbers[0].Value() != "INV-1" || gotNumbers[3].Value() != "INV-2" {
+		t.Errorf("expected numbers INV-1 and INV-2, got %v and %v", gotNumbers[0], gotNumbers[3])
+	}
+
+	// A projection error only surfaces on its own channel
+	if !gotNumbers[1].IsError() || !errors.Is(gotNumbers[1].Error().Err, errNoNumber) {
+		t.Errorf("expected projection error on number channel, got %v", gotNumbers[1])
+	}
+	if gotNumbers[1].Error().ProcessorName != "multi-project" {
+		t.Errorf("expected error from multi-project, got %q", gotNumbers[1].Error().ProcessorName)
+	}
+
+	// Upstream errors reach every projection
+	if !gotQuantities[2].IsError() || gotQuantities[2].Error().ProcessorName != "decoder" {
+		t.Errorf("expected decoder error on quantity channel, got %v", gotQuantities[2])
+	}
+	if !gotNumbers[2].IsError() || gotNumbers[2].Error().ProcessorName != "decoder" {
+		t.Errorf("expected decoder error on number channel, got %v", gotNumbers[2])
+	}
+
+	if s, _, _ := gotQuantities[0].GetStringMetadata(MetadataSource); s != "erp" {
+		t.Errorf("expected metadata preserved on quantity, got %q", s)
+	}
+	if s, _, _ := gotNumbers[0].GetStringMetadata(MetadataSource); s != "erp" {
+		t.Errorf("expected metadata preserved on number, got %q", s)
+	}
+}
+
+func TestMultiProject_ClosesOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	split := NewMultiProject[invoice]()
+	quantities := AddProjection(split, "quantity", func(i invoice) (int, error) {
+		return i.Quantity, nil
+	})
+
+	split.Process(ctx, make(chan Result[invoice]))
+	cancel()
+
+	select {
+	case _, ok := <-quantities:
+		if ok {
+			t.Error("expected no items after cancellation")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected projection channel closed after cancellation")
+	}
+}
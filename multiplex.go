@@ -0,0 +1,207 @@
+package streamz
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+)
+
+// MetadataTag identifies the logical stream an item belongs to when several
+// streams share one channel.
+const MetadataTag = "tag" // string - logical stream tag (multiplex)
+
+// Multiplex tags each item with the logical stream it belongs to so that several
+// streams can share a single channel or transport. Tags are carried in the
+// MetadataTag metadata key and consumed on the other side by Demultiplex.
+type Multiplex[T any] struct {
+	name  string
+	tagFn func(T) string
+}
+
+// NewMultiplex creates a processor that stamps every item with a stream tag.
+// The tag function is applied to successful values and to the item carried by
+// errors, so failures travel with the logical stream they came from.
+//
+// When to use:
+//   - Carrying several logical streams over one network connection
+//   - Merging per-tenant streams before a shared processing stage
+//   - Preserving stream identity across a FanIn
+//
+// Example:
+//
+//	// Tag sensor readings by device before sending them over one connection
+//	mux := streamz.NewMultiplex(func(r Reading) string { return r.DeviceID })
+//	tagged := mux.Process(ctx, readings)
+//
+//	// On the receiving side, split back into per-device streams
+//	demux := streamz.NewDemultiplex[Reading]([]string{"thermo-1", "thermo-2"})
+//	streams := demux.Process(ctx, tagged)
+//	go monitor(streams["thermo-1"])
+//	go monitor(streams["thermo-2"])
+//
+// Parameters:
+//   - tagFn: Returns the logical stream tag for an item
+//
+// Returns a new Multiplex processor.
+func NewMultiplex[T any](tagFn func(T) string) *Multiplex[T] {
+	return &Multiplex[T]{
+		name:  "multiplex",
+		tagFn: tagFn,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "multiplex".
+func (m *Multiplex[T]) WithName(name string) *Multiplex[T] {
+	m.name = name
+	return m
+}
+
+// Process stamps each item with its tag and forwards it in arrival order.
+// The output channel closes when the input closes or the context is canceled.
+func (m *Multiplex[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+
+				var tag string
+				if item.IsError() {
+					tag = m.tagFn(item.Error().Item)
+				} else {
+					tag = m.tagFn(item.Value())
+				}
+
+				select {
+				case out <- item.WithMetadata(MetadataTag, tag):
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (m *Multiplex[T]) Name() string {
+	return m.name
+}
+
+// Demultiplex splits a tagged stream produced by Multiplex back into one output
+// channel per logical stream. Items keep their relative order within each tag.
+//
+// Outputs share a single reader, so every output channel must be consumed to
+// avoid blocking the others.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Demultiplex[T any] struct {
+	name         string
+	tags         []string
+	droppedCount atomic.Uint64
+}
+
+// NewDemultiplex creates a processor that routes items to per-tag channels.
+// Items whose MetadataTag is missing or not among the configured tags are dropped
+// and counted; use DroppedCount to monitor them.
+//
+// When to use:
+//   - Receiving multiplexed streams from a shared transport
+//   - Splitting a merged stream back into per-tenant pipelines
+//   - Undoing a Multiplex after a shared processing stage
+//
+// Example:
+//
+//	demux := streamz.NewDemultiplex[Event]([]string{"orders", "payments"})
+//	streams := demux.Process(ctx, tagged)
+//
+//	go processOrders(streams["orders"])
+//	go processPayments(streams["payments"])
+//
+// Parameters:
+//   - tags: Logical stream tags to create output channels for
+//
+// Returns a new Demultiplex processor.
+func NewDemultiplex[T any](tags []string) *Demultiplex[T] {
+	return &Demultiplex[T]{
+		name: "demultiplex",
+		tags: tags,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "demultiplex".
+func (d *Demultiplex[T]) WithName(name string) *Demultiplex[T] {
+	d.name = name
+	return d
+}
+
+// DroppedCount returns the number of items dropped for a missing or unknown tag.
+func (d *Demultiplex[T]) DroppedCount() uint64 {
+	return d.droppedCount.Load()
+}
+
+// Process routes each item to the output channel matching its tag.
+// All output channels close when the input closes or the context is canceled.
+func (d *Demultiplex[T]) Process(ctx context.Context, in <-chan Result[T]) map[string]<-chan Result[T] {
+	channels := make(map[string]chan Result[T], len(d.tags))
+	outs := make(map[string]<-chan Result[T], len(d.tags))
+	for _, tag := range d.tags {
+		if _, exists := channels[tag]; exists {
+			continue
+		}
+		ch := make(chan Result[T])
+		channels[tag] = ch
+		outs[tag] = ch
+	}
+
+	go func() {
+		defer func() {
+			for _, ch := range channels {
+				close(ch)
+			}
+		}()
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+
+				tag, _, _ := item.GetStringMetadata(MetadataTag)
+				ch, exists := channels[tag]
+				if !exists {
+					d.droppedCount.Add(1)
+					log.Printf("Demultiplex[%s]: dropped item with unknown tag %q", d.name, tag)
+					continue
+				}
+
+				select {
+				case ch <- item:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return outs
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (d *Demultiplex[T]) Name() string {
+	return d.name
+}
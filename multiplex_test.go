@@ -0,0 +1,165 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// streamTag derives the logical stream from items of the form "tag:value".
+func streamTag(s string) string {
+	tag, _, _ := strings.Cut(s, ":")
+	return tag
+}
+
+// drainTags reads every demultiplexed output concurrently.
+func drainTags(outs map[string]<-chan Result[string]) map[string][]Result[string] {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	collected := make(map[string][]Result[string], len(outs))
+
+	for tag, ch := range outs {
+		wg.Add(1)
+		go func(tag string, ch <-chan Result[string]) {
+			defer wg.Done()
+			for result := range ch {
+				mu.Lock()
+				collected[tag] = append(collected[tag], result)
+				mu.Unlock()
+			}
+		}(tag, ch)
+	}
+
+	wg.Wait()
+	return collected
+}
+
+func TestMultiplex_Name(t *testing.T) {
+	mux := NewMultiplex(streamTag)
+	if mux.Name() != "multiplex" {
+		t.Errorf("expected name 'multiplex', got %q", mux.Name())
+	}
+	if mux.WithName("tagger").Name() != "tagger" {
+		t.Errorf("expected name 'tagger', got %q", mux.Name())
+	}
+
+	demux := NewDemultiplex[string]([]string{"a"})
+	if demux.Name() != "demultiplex" {
+		t.Errorf("expected name 'demultiplex', got %q", demux.Name())
+	}
+	if demux.WithName("splitter").Name() != "splitter" {
+		t.Errorf("expected name 'splitter', got %q", demux.Name())
+	}
+}
+
+func TestMultiplex_TagsItems(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[string], 2)
+	in <- NewSuccess("orders:1")
+	in <- NewError("payments:2", errors.New("declined"), "charger")
+	close(in)
+
+	var results []Result[string]
+	for result := range NewMultiplex(streamTag).Process(ctx, in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	expected := []string{"orders", "payments"}
+	for i, result := range results {
+		tag, found, err := result.GetStringMetadata(MetadataTag)
+		if err != nil || !found || tag != expected[i] {
+			t.Errorf("expected tag %q at %d, got %q (found=%v, err=%v)", expected[i], i, tag, found, err)
+		}
+	}
+	if !results[1].IsError() {
+		t.Error("expected error to pass through multiplex")
+	}
+}
+
+func TestMultiplex_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[string], 6)
+	in <- NewSuccess("a:1")
+	in <- NewSuccess("b:1")
+	in <- NewSuccess("a:2")
+	in <- NewSuccess("a:3")
+	in <- NewError("b:2", errors.New("bad reading"), "parser")
+	in <- NewSuccess("b:3")
+	close(in)
+
+	tagged := NewMultiplex(streamTag).Process(ctx, in)
+	outs := NewDemultiplex[string]([]string{"a", "b"}).Process(ctx, tagged)
+
+	if len(outs) != 2 {
+		t.Fatalf("expected 2 output channels, got %d", len(outs))
+	}
+
+	collected := drainTags(outs)
+
+	expectedA := []string{"a:1", "a:2", "a:3"}
+	if len(collected["a"]) != len(expectedA) {
+		t.Fatalf("expected %d items on 'a', got %d", len(expectedA), len(collected["a"]))
+	}
+	for i, result := range collected["a"] {
+		if result.Value() != expectedA[i] {
+			t.Errorf("expected %q at a[%d], got %q", expectedA[i], i, result.Value())
+		}
+	}
+
+	b := collected["b"]
+	if len(b) != 3 {
+		t.Fatalf("expected 3 items on 'b', got %d", len(b))
+	}
+	if b[0].Value() != "b:1" || b[2].Value() != "b:3" {
+		t.Errorf("expected b:1 and b:3 in order, got %q and %q", b[0].Value(), b[2].Value())
+	}
+	if !b[1].IsError() || b[1].Error().Item != "b:2" {
+		t.Errorf("expected error for b:2 routed to 'b', got %v", b[1])
+	}
+}
+
+func TestDemultiplex_UnknownTagsDropped(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[string], 3)
+	in <- NewSuccess("a:1").WithMetadata(MetadataTag, "a")
+	in <- NewSuccess("c:1").WithMetadata(MetadataTag, "c")
+	in <- NewSuccess("untagged")
+	close(in)
+
+	demux := NewDemultiplex[string]([]string{"a"})
+	collected := drainTags(demux.Process(ctx, in))
+
+	if len(collected["a"]) != 1 || collected["a"][0].Value() != "a:1" {
+		t.Errorf("expected only a:1 on 'a', got %v", collected["a"])
+	}
+	if demux.DroppedCount() != 2 {
+		t.Errorf("expected 2 dropped items, got %d", demux.DroppedCount())
+	}
+}
+
+func TestDemultiplex_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[string])
+	outs := NewDemultiplex[string]([]string{"a", "b"}).Process(ctx, in)
+
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		drainTags(outs)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("outputs didn't close after cancellation")
+	}
+	close(in)
+}
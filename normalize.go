@@ -0,0 +1,85 @@
+package streamz
+
+import (
+	"context"
+)
+
+// Normalize rewrites each item into a canonical form, such as trimmed and
+// lowercased identifiers, so that later stages keyed on item content treat
+// equivalent items alike.
+type Normalize[T any] struct {
+	name string
+	fn   func(T) T
+}
+
+// NewNormalize creates a processor that replaces each successful item with fn(item).
+// Metadata is preserved. Errors pass through unchanged.
+//
+// To canonicalize only the key used by Dedupe or Partition while leaving items
+// untouched, use their WithKeyNormalizer option instead.
+//
+// When to use:
+//   - Canonicalizing emails, hostnames, or IDs before deduplication or grouping
+//   - Cleaning whitespace and casing from user-entered fields
+//   - Rounding or bucketing values before comparison
+//
+// Example:
+//
+//	// Canonical emails, so "Ann@Example.com " and "ann@example.com" match downstream
+//	canonical := streamz.NewNormalize(func(u Signup) Signup {
+//		u.Email = strings.ToLower(strings.TrimSpace(u.Email))
+//		return u
+//	})
+//
+//	signups = deduper.Process(ctx, canonical.Process(ctx, signups))
+//
+// Parameters:
+//   - fn: Returns the canonical form of an item; should be pure and idempotent
+//
+// Returns a new Normalize processor.
+func NewNormalize[T any](fn func(T) T) *Normalize[T] {
+	return &Normalize[T]{
+		name: "normalize",
+		fn:   fn,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "normalize".
+func (n *Normalize[T]) WithName(name string) *Normalize[T] {
+	n.name = name
+	return n
+}
+
+// Process forwards the canonical form of each successful item.
+func (n *Normalize[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsSuccess() {
+				item = Result[T]{value: n.fn(item.Value()), metadata: item.metadata}
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (n *Normalize[T]) Name() string {
+	return n.name
+}
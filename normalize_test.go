@@ -0,0 +1,70 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNormalize_Name(t *testing.T) {
+	normalize := NewNormalize(strings.ToLower)
+	if normalize.Name() != "normalize" {
+		t.Errorf("expected name 'normalize', got %q", normalize.Name())
+	}
+	if normalize.WithName("canonical-email").Name() != "canonical-email" {
+		t.Errorf("expected name 'canonical-email', got %q", normalize.Name())
+	}
+}
+
+func TestNormalize_CanonicalizesItems(t *testing.T) {
+	in := make(chan Result[string], 3)
+	in <- NewSuccess(" Apple ").WithMetadata("source", "feed")
+	in <- NewError("Bad", errors.New("invalid"), "parser")
+	in <- NewSuccess("BANANA")
+	close(in)
+
+	normalize := NewNormalize(func(s string) string {
+		return strings.ToLower(strings.TrimSpace(s))
+	})
+
+	var results []Result[string]
+	for result := range normalize.Process(context.Background(), in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if results[0].Value() != "apple" {
+		t.Errorf("expected 'apple', got %q", results[0].Value())
+	}
+	if source, _, _ := results[0].GetStringMetadata("source"); source != "feed" {
+		t.Errorf("expected metadata preserved, got %q", source)
+	}
+	if !results[1].IsError() || results[1].Error().Item != "Bad" {
+		t.Errorf("expected error passed through unchanged, got %v", results[1])
+	}
+	if results[2].Value() != "banana" {
+		t.Errorf("expected 'banana', got %q", results[2].Value())
+	}
+}
+
+func TestNormalize_FeedsDedupe(t *testing.T) {
+	in := make(chan Result[string], 3)
+	in <- NewSuccess("Apple")
+	in <- NewSuccess("apple")
+	in <- NewSuccess("APPLE")
+	close(in)
+
+	ctx := context.Background()
+	dedupe := NewDedupe(identityKey, RealClock)
+	count := 0
+	for range dedupe.Process(ctx, NewNormalize(strings.ToLower).Process(ctx, in)) {
+		count++
+	}
+
+	if count != 1 {
+		t.Errorf("expected case variants deduplicated to 1 item, got %d", count)
+	}
+}
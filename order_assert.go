@@ -0,0 +1,115 @@
+package streamz
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// OrderViolation records an out-of-order pair detected by OrderAssert.
+type OrderViolation struct {
+	Previous uint64 // Sequence of the item before the violating one
+	Current  uint64 // Sequence that was not greater than Previous
+}
+
+// OrderAssert is a debug processor that checks a stream stays in sequence order.
+// Placed at the end of a pipeline that must preserve ordering, it records every
+// item whose sequence does not increase, so CI can catch ordering regressions
+// such as a stage accidentally made concurrent.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type OrderAssert[T any] struct {
+	name       string
+	seqFn      func(T) uint64
+	mu         sync.Mutex
+	violations []OrderViolation
+}
+
+// NewOrderAssert creates a processor that records ordering violations.
+// Each successful item's sequence must be strictly greater than that of the
+// successful item before it; when it is not, the pair is recorded as an
+// OrderViolation. Every item is forwarded unchanged whether or not it is in
+// order. Errors are forwarded without being checked.
+//
+// When to use:
+//   - Asserting order preservation in pipeline tests
+//   - Verifying that ordered parallel stages such as AsyncMapper keep sequence
+//   - Diagnosing reordering introduced by retries or fan-in
+//
+// Example:
+//
+//	check := streamz.NewOrderAssert(func(e Event) uint64 { return e.Seq })
+//	for range check.Process(ctx, pipeline.Process(ctx, events)) {
+//	}
+//
+//	if violations := check.Violations(); len(violations) > 0 {
+//		t.Errorf("pipeline reordered events: %v", violations)
+//	}
+//
+// Parameters:
+//   - seqFn: Extracts the sequence number that must increase
+//
+// Returns a new OrderAssert processor.
+func NewOrderAssert[T any](seqFn func(T) uint64) *OrderAssert[T] {
+	return &OrderAssert[T]{
+		name:  "order-assert",
+		seqFn: seqFn,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "order-assert".
+func (a *OrderAssert[T]) WithName(name string) *OrderAssert[T] {
+	a.name = name
+	return a
+}
+
+// Violations returns the ordering violations recorded so far, in detection order.
+// Safe to call concurrently with Process.
+func (a *OrderAssert[T]) Violations() []OrderViolation {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return slices.Clone(a.violations)
+}
+
+// Process forwards every item, recording any that arrive out of order.
+func (a *OrderAssert[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		var previous uint64
+		started := false
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsSuccess() {
+				seq := a.seqFn(item.Value())
+				if started && seq <= previous {
+					a.mu.Lock()
+					a.violations = append(a.violations, OrderViolation{Previous: previous, Current: seq})
+					a.mu.Unlock()
+				}
+				previous, started = seq, true
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (a *OrderAssert[T]) Name() string {
+	return a.name
+}
@@ -0,0 +1,82 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func identitySeq(v uint64) uint64 { return v }
+
+// runOrderAssert passes items through check and returns how many were forwarded.
+func runOrderAssert(check *OrderAssert[uint64], items ...Result[uint64]) int {
+	in := make(chan Result[uint64], len(items))
+	for _, item := range items {
+		in <- item
+	}
+	close(in)
+
+	forwarded := 0
+	for range check.Process(context.Background(), in) {
+		forwarded++
+	}
+	return forwarded
+}
+
+func sequence(values ...uint64) []Result[uint64] {
+	items := make([]Result[uint64], len(values))
+	for i, v := range values {
+		items[i] = NewSuccess(v)
+	}
+	return items
+}
+
+func TestOrderAssert_Name(t *testing.T) {
+	check := NewOrderAssert(identitySeq)
+	if check.Name() != "order-assert" {
+		t.Errorf("expected name 'order-assert', got %q", check.Name())
+	}
+	if check.WithName("ordering").Name() != "ordering" {
+		t.Errorf("expected name 'ordering', got %q", check.Name())
+	}
+}
+
+func TestOrderAssert_InOrderRecordsNothing(t *testing.T) {
+	check := NewOrderAssert(identitySeq)
+	if n := runOrderAssert(check, sequence(1, 2, 5, 9, 10)...); n != 5 {
+		t.Errorf("expected 5 items forwarded, got %d", n)
+	}
+	if violations := check.Violations(); len(violations) != 0 {
+		t.Errorf("expected no violations, got %v", violations)
+	}
+}
+
+func TestOrderAssert_RecordsViolationPairs(t *testing.T) {
+	check := NewOrderAssert(identitySeq)
+	if n := runOrderAssert(check, sequence(1, 3, 2, 4, 4, 6, 5)...); n != 7 {
+		t.Errorf("expected every item forwarded, got %d", n)
+	}
+
+	want := []OrderViolation{{Previous: 3, Current: 2}, {Previous: 4, Current: 4}, {Previous: 6, Current: 5}}
+	if got := check.Violations(); !slices.Equal(got, want) {
+		t.Errorf("expected violations %v, got %v", want, got)
+	}
+}
+
+func TestOrderAssert_SkipsErrors(t *testing.T) {
+	check := NewOrderAssert(identitySeq)
+	items := []Result[uint64]{
+		NewSuccess[uint64](1),
+		NewError[uint64](0, errors.New("failed"), "mapper"),
+		NewSuccess[uint64](2),
+		NewError[uint64](1, errors.New("failed"), "mapper"),
+		NewSuccess[uint64](3),
+	}
+	if n := runOrderAssert(check, items...); n != 5 {
+		t.Errorf("expected errors forwarded too, got %d items", n)
+	}
+	if violations := check.Violations(); len(violations) != 0 {
+		t.Errorf("expected errors to be skipped, got %v", violations)
+	}
+}
@@ -0,0 +1,74 @@
+package streamz
+
+import (
+	"context"
+)
+
+// Parallel runs several processors side by side over the same input and merges
+// their outputs into a single stream. It packages the common FanOut → processors
+// → FanIn wiring for doing several independent things to each item.
+type Parallel[T any] struct {
+	name  string
+	procs []Processor[T]
+}
+
+// NewParallel creates a processor that broadcasts every input Result to each of
+// procs, runs them concurrently, and merges everything they emit. Each input thus
+// yields the combined output of all processors, in no particular order across them.
+// Errors are broadcast like any other Result, so each processor decides how to
+// handle them.
+//
+// Every processor receives the same value; if T contains pointers, processors
+// must not mutate shared state. A slow processor applies backpressure to all the
+// others, as with FanOut. With no processors, input is drained and nothing is emitted.
+//
+// When to use:
+//   - Computing several independent enrichments or projections per item
+//   - Running alerting, metrics, and archival branches from one source
+//   - Replacing hand-written FanOut/goroutine/FanIn plumbing
+//
+// Example:
+//
+//	// Emit both a normalized event and an audit record for each input
+//	parallel := streamz.NewParallel[Event](
+//		streamz.NewMapper(normalize),
+//		streamz.NewMapper(toAuditRecord),
+//	)
+//
+//	combined := parallel.Process(ctx, events)
+//
+// Parameters:
+//   - procs: Processors to run over every input Result
+//
+// Returns a new Parallel processor.
+func NewParallel[T any](procs ...Processor[T]) *Parallel[T] {
+	return &Parallel[T]{
+		name:  "parallel",
+		procs: procs,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "parallel".
+func (p *Parallel[T]) WithName(name string) *Parallel[T] {
+	p.name = name
+	return p
+}
+
+// Process fans input out to every processor and merges their outputs.
+// The output closes once all processors have closed their outputs.
+func (p *Parallel[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	branches := NewFanOut[T](len(p.procs)).Process(ctx, in)
+
+	outputs := make([]<-chan Result[T], len(p.procs))
+	for i, proc := range p.procs {
+		outputs[i] = proc.Process(ctx, branches[i])
+	}
+
+	return NewFanIn[T]().Process(ctx, outputs...)
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (p *Parallel[T]) Name() string {
+	return p.name
+}
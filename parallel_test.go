@@ -0,0 +1,123 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+)
+
+// Compile-time checks that common same-type processors satisfy Processor.
+var (
+	_ Processor[int] = (*Filter[int])(nil)
+	_ Processor[int] = (*Mapper[int, int])(nil)
+	_ Processor[int] = (*Tap[int])(nil)
+	_ Processor[int] = (*Parallel[int])(nil)
+)
+
+func TestParallel_Name(t *testing.T) {
+	parallel := NewParallel[int]()
+	if parallel.Name() != "parallel" {
+		t.Errorf("expected name 'parallel', got %q", parallel.Name())
+	}
+	if parallel.WithName("enrich-all").Name() != "enrich-all" {
+		t.Errorf("expected name 'enrich-all', got %q", parallel.Name())
+	}
+}
+
+func TestParallel_MergesAllProcessorOutputs(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int], 3)
+	in <- NewSuccess(1)
+	in <- NewSuccess(2)
+	in <- NewSuccess(3)
+	close(in)
+
+	parallel := NewParallel[int](
+		NewMapper(func(_ context.Context, v int) (int, error) { return v * 10, nil }),
+		NewMapper(func(_ context.Context, v int) (int, error) { return v + 1000, nil }),
+	)
+
+	var values []int
+	for result := range parallel.Process(ctx, in) {
+		if result.IsError() {
+			t.Fatalf("unexpected error: %v", result.Error())
+		}
+		values = append(values, result.Value())
+	}
+
+	sort.Ints(values)
+	expected := []int{10, 20, 30, 1001, 1002, 1003}
+	if len(values) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, values)
+	}
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, values)
+			break
+		}
+	}
+}
+
+func TestParallel_ProcessorsSeeErrorsIndependently(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int], 3)
+	in <- NewSuccess(1)
+	in <- NewError(2, errors.New("bad"), "source")
+	in <- NewSuccess(4)
+	close(in)
+
+	// One branch keeps only even values; the other passes everything through
+	parallel := NewParallel[int](
+		NewFilter(func(v int) bool { return v%2 == 0 }),
+		NewTap(func(Result[int]) {}),
+	)
+
+	successes, errorCount := 0, 0
+	for result := range parallel.Process(ctx, in) {
+		if result.IsError() {
+			errorCount++
+		} else {
+			successes++
+		}
+	}
+
+	// Filter emits 4 and its error; Tap emits 1, 4, and its error
+	if successes != 3 {
+		t.Errorf("expected 3 successes, got %d", successes)
+	}
+	if errorCount != 2 {
+		t.Errorf("expected the error once per processor, got %d", errorCount)
+	}
+}
+
+func TestParallel_NoProcessors(t *testing.T) {
+	in := make(chan Result[int], 2)
+	in <- NewSuccess(1)
+	in <- NewSuccess(2)
+	close(in)
+
+	for result := range NewParallel[int]().Process(context.Background(), in) {
+		t.Errorf("expected no output, got %v", result)
+	}
+}
+
+func TestParallel_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[int], 10)
+	for i := 0; i < 10; i++ {
+		in <- NewSuccess(i)
+	}
+	close(in)
+
+	out := NewParallel[int](
+		NewFilter(func(int) bool { return true }),
+		NewFilter(func(int) bool { return true }),
+	).Process(ctx, in)
+
+	// Read one item, then stop consuming; cancellation must still close the output
+	<-out
+	cancel()
+	for range out {
+	}
+}
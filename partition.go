@@ -3,8 +3,11 @@ package streamz
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"hash/fnv"
+	"sort"
+	"sync"
 	"sync/atomic"
 	"time"
 )
@@ -18,6 +21,8 @@ type Partition[T any] struct {
 	name           string               // 16 bytes (pointer + len)
 	partitionCount int                  // 8 bytes (aligned)
 	bufferSize     int                  // 8 bytes (aligned)
+	overflowPolicy OverflowPolicy       // 8 bytes (aligned)
+	droppedCount   atomic.Uint64        // 8 bytes
 }
 
 // PartitionStrategy defines the routing behavior for distributing values across partitions.
@@ -41,18 +46,67 @@ type RoundRobinPartition[T any] struct {
 	counter uint64 // Atomic counter for thread-safe operation
 }
 
+// RangePartition implements boundary-based routing over a numeric key.
+// Boundaries are upper bounds (exclusive): with boundaries [100, 1000], keys below 100
+// route to partition 0, keys below 1000 to partition 1, and all others to partition 2.
+// Keys beyond the last partition clamp to it, and panics route to partition 0.
+type RangePartition[T any] struct {
+	keyExtractor func(T) float64
+	boundaries   []float64
+}
+
+// StickyPartition implements load-aware routing that keeps each key on one partition.
+// A key is assigned to the partition that has received the fewest items so far the
+// first time it is seen, and every later item with that key follows it, combining
+// per-key ordering with balanced load. Assignments are remembered for the lifetime
+// of the strategy, so memory grows with the number of distinct keys.
+type StickyPartition[T any] struct {
+	keyExtractor func(T) string
+	mu           sync.Mutex
+	assignments  map[string]int
+	loads        []uint64
+}
+
 // PartitionConfig configures partition behavior including strategy and buffer sizing.
 type PartitionConfig[T any] struct {
 	Strategy       PartitionStrategy[T] // Routing strategy implementation
 	PartitionCount int                  // Number of output partitions (must be > 0)
 	BufferSize     int                  // Buffer size applied to all output channels (must be >= 0)
+	OverflowPolicy OverflowPolicy       // Behavior when a partition buffer is full (default OverflowBlock)
 }
 
+// OverflowPolicy controls what happens when a partition's output buffer is full.
+// Any policy other than OverflowBlock keeps one stalled consumer from blocking
+// the remaining partitions.
+type OverflowPolicy int
+
+// Partition overflow policies.
+const (
+	// OverflowBlock waits for the consumer to make room, applying backpressure to all partitions.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropNewest discards the item that does not fit.
+	OverflowDropNewest
+	// OverflowDropOldest evicts the oldest buffered item to make room for the new one.
+	// Unbuffered partitions have nothing to evict and fall back to OverflowDropNewest.
+	OverflowDropOldest
+	// OverflowRouteToError sends the item to partition 0 as an ErrPartitionOverflow error.
+	// Partition 0 is where every error Result is routed, so anything that overflows it
+	// has nowhere else to go and is dropped: successes routed to partition 0, overflow
+	// errors that find it full, and upstream error Results. Dropped items, errors
+	// included, are counted by DroppedCount; size partition 0's buffer for error
+	// bursts when errors must not be lost, or use OverflowBlock.
+	OverflowRouteToError
+)
+
+// ErrPartitionOverflow is reported for items that did not fit in their partition
+// buffer under OverflowRouteToError.
+var ErrPartitionOverflow = errors.New("partition buffer full")
+
 // Standard partition metadata keys for tracing and debugging.
 const (
 	MetadataPartitionIndex    = "partition_index"    // int - target partition [0, N)
 	MetadataPartitionTotal    = "partition_total"    // int - total partition count N
-	MetadataPartitionStrategy = "partition_strategy" // string - "hash", "round_robin", or "error"
+	MetadataPartitionStrategy = "partition_strategy" // string - "hash", "round_robin", "range", "sticky", or "error"
 )
 
 // Partition strategy name constants.
@@ -71,6 +125,7 @@ func NewPartition[T any](config PartitionConfig[T]) (*Partition[T], error) {
 		strategy:       config.Strategy,
 		partitionCount: config.PartitionCount,
 		bufferSize:     config.BufferSize,
+		overflowPolicy: config.OverflowPolicy,
 		name:           "partition",
 	}, nil
 }
@@ -100,6 +155,43 @@ func NewHashPartition[T any, K comparable](
 	}, nil
 }
 
+// NewRangeStrategy creates a range routing strategy for use with NewPartition.
+// Boundaries are copied and sorted, so callers may pass them in any order.
+// Configure PartitionCount as len(boundaries)+1 for one partition per range;
+// fewer partitions merge the upper ranges into the last partition.
+func NewRangeStrategy[T any](keyExtractor func(T) float64, boundaries ...float64) *RangePartition[T] {
+	sorted := append([]float64(nil), boundaries...)
+	sort.Float64s(sorted)
+	return &RangePartition[T]{
+		keyExtractor: keyExtractor,
+		boundaries:   sorted,
+	}
+}
+
+// NewStickyPartition creates a partition that pins each key to the least-loaded
+// partition on first sight, preserving per-key order with round-robin-like balance.
+// The keyExtractor function must be pure (no side effects, no shared mutable state).
+func NewStickyPartition[T any](keyExtractor func(T) string, partitionCount int, bufferSize int) (*Partition[T], error) {
+	if err := validateHashConfig(partitionCount, keyExtractor, bufferSize); err != nil {
+		return nil, err
+	}
+
+	return &Partition[T]{
+		strategy:       NewStickyStrategy(keyExtractor),
+		partitionCount: partitionCount,
+		bufferSize:     bufferSize,
+		name:           "partition",
+	}, nil
+}
+
+// NewStickyStrategy creates a sticky routing strategy for use with NewPartition.
+func NewStickyStrategy[T any](keyExtractor func(T) string) *StickyPartition[T] {
+	return &StickyPartition[T]{
+		keyExtractor: keyExtractor,
+		assignments:  make(map[string]int),
+	}
+}
+
 // NewRoundRobinPartition creates a round-robin partition that distributes values evenly.
 // Uses atomic operations for lock-free thread safety.
 func NewRoundRobinPartition[T any](partitionCount int, bufferSize int) (*Partition[T], error) {
@@ -122,6 +214,38 @@ func NewRoundRobinPartition[T any](partitionCount int, bufferSize int) (*Partiti
 	}, nil
 }
 
+// WithKeyNormalizer canonicalizes each extracted key before routing, so that keys
+// differing only in form, such as "Apple" and "apple" with strings.ToLower, land
+// on the same partition. Items are routed unchanged. Applies to the string-keyed
+// strategies, hash partitioning with string keys and sticky partitioning; other
+// strategies are left as they are. Call it before Process; sticky assignments
+// made so far are discarded.
+func (p *Partition[T]) WithKeyNormalizer(normalize func(string) string) *Partition[T] {
+	switch s := p.strategy.(type) {
+	case *HashPartition[T, string]:
+		p.strategy = &HashPartition[T, string]{
+			keyExtractor: normalizedKey(s.keyExtractor, normalize),
+			hasher:       s.hasher,
+		}
+	case *StickyPartition[T]:
+		p.strategy = NewStickyStrategy(normalizedKey(s.keyExtractor, normalize))
+	}
+	return p
+}
+
+// normalizedKey wraps a key extractor so its keys pass through normalize.
+func normalizedKey[T any](extract func(T) string, normalize func(string) string) func(T) string {
+	return func(value T) string {
+		return normalize(extract(value))
+	}
+}
+
+// DroppedCount returns the number of items discarded by the overflow policy,
+// error Results included. Always zero under OverflowBlock.
+func (p *Partition[T]) DroppedCount() uint64 {
+	return p.droppedCount.Load()
+}
+
 // Process splits input across N output channels using the configured strategy.
 // Channels are created during this method call, not in the constructor.
 // Returns a read-only slice of channels for immediate consumption.
@@ -189,12 +313,68 @@ func (p *Partition[T]) routeResult(ctx context.Context, result Result[T], channe
 		WithMetadata(MetadataProcessor, p.name).
 		WithMetadata(MetadataTimestamp, time.Now())
 
-	// Send to target partition with context cancellation support
+	if p.overflowPolicy == OverflowBlock {
+		// Send to target partition with context cancellation support
+		select {
+		case channels[targetIndex] <- enrichedResult:
+		case <-ctx.Done():
+		}
+		return
+	}
+
+	// Non-blocking policies: deliver if there is room, otherwise apply the policy
 	select {
 	case channels[targetIndex] <- enrichedResult:
-	case <-ctx.Done():
 		return
+	default:
 	}
+	p.handleOverflow(enrichedResult, targetIndex, channels)
+}
+
+// handleOverflow applies the configured overflow policy to a result that did not
+// fit in its target partition. It never blocks.
+func (p *Partition[T]) handleOverflow(result Result[T], targetIndex int, channels []chan Result[T]) {
+	switch p.overflowPolicy {
+	case OverflowDropOldest:
+		ch := channels[targetIndex]
+		if cap(ch) == 0 {
+			break
+		}
+		for {
+			// Evict the oldest buffered item, then retry; the consumer may drain concurrently
+			select {
+			case <-ch:
+				p.droppedCount.Add(1)
+			default:
+			}
+			select {
+			case ch <- result:
+				return
+			default:
+			}
+		}
+
+	case OverflowRouteToError:
+		// Errors already target partition 0, so an overflowing error has no fallback
+		if targetIndex == 0 || result.IsError() {
+			break
+		}
+		err := fmt.Errorf("%w: partition %d", ErrPartitionOverflow, targetIndex)
+		overflow := Result[T]{
+			err:      NewStreamError(result.Value(), err, p.name),
+			metadata: result.metadata,
+		}
+		overflow = overflow.
+			WithMetadata(MetadataPartitionIndex, 0).
+			WithMetadata(MetadataPartitionStrategy, partitionStrategyError)
+		select {
+		case channels[0] <- overflow:
+			return
+		default:
+		}
+	}
+
+	p.droppedCount.Add(1)
 }
 
 // safeRoute calls the strategy with panic recovery.
@@ -223,6 +403,10 @@ func (p *Partition[T]) getStrategyName() string {
 		return "hash"
 	case *RoundRobinPartition[T]:
 		return "round_robin"
+	case *RangePartition[T]:
+		return "range"
+	case *StickyPartition[T]:
+		return "sticky"
 	default:
 		return "custom"
 	}
@@ -253,6 +437,69 @@ func (h *HashPartition[T, K]) Route(value T, partitionCount int) (idx int) {
 	return partition
 }
 
+// Route implements range routing with panic recovery.
+// Returns the number of boundaries at or below the key, clamped to the last partition.
+func (r *RangePartition[T]) Route(value T, partitionCount int) (idx int) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			idx = 0 // Route to partition 0 on panic
+		}
+	}()
+
+	// Guard against invalid partition count
+	if partitionCount <= 0 {
+		return 0
+	}
+
+	key := r.keyExtractor(value) // Can panic - recovered above
+	partition := sort.Search(len(r.boundaries), func(i int) bool {
+		return key < r.boundaries[i]
+	})
+	if partition >= partitionCount {
+		partition = partitionCount - 1
+	}
+	return partition
+}
+
+// Route implements sticky routing with panic recovery.
+// Known keys return their assigned partition; new keys are assigned to the partition
+// with the fewest routed items, preferring the lowest index on ties.
+func (s *StickyPartition[T]) Route(value T, partitionCount int) (idx int) {
+	defer func() {
+		if r := recover(); r != nil {
+			idx = 0 // Route to partition 0 on panic
+		}
+	}()
+
+	// Guard against invalid partition count
+	if partitionCount <= 0 {
+		return 0
+	}
+
+	key := s.keyExtractor(value) // Can panic - recovered above
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// First use, or reuse with a different partition count: assignments start over
+	if len(s.loads) != partitionCount {
+		s.loads = make([]uint64, partitionCount)
+		clear(s.assignments)
+	}
+
+	partition, ok := s.assignments[key]
+	if !ok {
+		for i, load := range s.loads {
+			if load < s.loads[partition] {
+				partition = i
+			}
+		}
+		s.assignments[key] = partition
+	}
+	s.loads[partition]++
+	return partition
+}
+
 // Route implements round-robin routing using atomic counter.
 // Thread-safe operation without locks for high performance.
 func (r *RoundRobinPartition[T]) Route(_ T, partitionCount int) int {
@@ -309,6 +556,9 @@ func validateConfig[T any](config PartitionConfig[T]) error {
 	if config.Strategy == nil {
 		return fmt.Errorf("strategy cannot be nil")
 	}
+	if config.OverflowPolicy < OverflowBlock || config.OverflowPolicy > OverflowRouteToError {
+		return fmt.Errorf("unknown overflow policy %d", config.OverflowPolicy)
+	}
 	return nil
 }
 
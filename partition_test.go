@@ -2,8 +2,11 @@ package streamz
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -397,6 +400,252 @@ func TestRoundRobinPartition_EvenDistribution(t *testing.T) {
 	}
 }
 
+func TestRangePartition_BoundaryAssignment(t *testing.T) {
+	// Boundaries deliberately unsorted; the strategy sorts them
+	strategy := NewRangeStrategy(func(v float64) float64 { return v }, 1000, 100)
+
+	tests := []struct {
+		value    float64
+		expected int
+	}{
+		{-1e9, 0},
+		{0, 0},
+		{99.999, 0},
+		{100, 1},
+		{100.001, 1},
+		{999, 1},
+		{1000, 2},
+		{1001, 2},
+		{1e9, 2},
+	}
+
+	for _, tt := range tests {
+		if got := strategy.Route(tt.value, 3); got != tt.expected {
+			t.Errorf("value %v: expected partition %d, got %d", tt.value, tt.expected, got)
+		}
+	}
+}
+
+func TestRangePartition_ClampsToLastPartition(t *testing.T) {
+	strategy := NewRangeStrategy(func(v float64) float64 { return v }, 10, 20, 30)
+
+	// Only two partitions: everything from 10 upward clamps to partition 1
+	for value, expected := range map[float64]int{5: 0, 10: 1, 25: 1, 1000: 1} {
+		if got := strategy.Route(value, 2); got != expected {
+			t.Errorf("value %v: expected partition %d, got %d", value, expected, got)
+		}
+	}
+}
+
+func TestRangePartition_KeyExtractorPanic(t *testing.T) {
+	strategy := NewRangeStrategy(func(_ float64) float64 { panic("bad key") }, 100)
+	if got := strategy.Route(500, 2); got != 0 {
+		t.Errorf("expected panic to route to partition 0, got %d", got)
+	}
+}
+
+func TestPartition_RangeRouting(t *testing.T) {
+	ctx := context.Background()
+
+	type reading struct {
+		Sensor string
+		Value  int
+	}
+
+	partition, err := NewPartition(PartitionConfig[reading]{
+		Strategy:       NewRangeStrategy(func(r reading) float64 { return float64(r.Value) }, 100, 1000),
+		PartitionCount: 3,
+		BufferSize:     10,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create partition: %v", err)
+	}
+
+	in := make(chan Result[reading], 5)
+	in <- NewSuccess(reading{"a", 5})
+	in <- NewSuccess(reading{"b", 150})
+	in <- NewSuccess(reading{"c", 5000})
+	in <- NewSuccess(reading{"d", 99})
+	in <- NewSuccess(reading{"e", 1000})
+	close(in)
+
+	outs := partition.Process(ctx, in)
+
+	expected := [][]string{{"a", "d"}, {"b"}, {"c", "e"}}
+	for i, out := range outs {
+		var sensors []string
+		for result := range out {
+			sensors = append(sensors, result.Value().Sensor)
+			if strategy, _ := result.GetMetadata(MetadataPartitionStrategy); strategy != "range" {
+				t.Errorf("partition %d: expected strategy 'range', got %v", i, strategy)
+			}
+			if index, _ := result.GetMetadata(MetadataPartitionIndex); index != i {
+				t.Errorf("partition %d: expected index metadata %d, got %v", i, i, index)
+			}
+		}
+		if len(sensors) != len(expected[i]) {
+			t.Errorf("partition %d: expected %v, got %v", i, expected[i], sensors)
+			continue
+		}
+		for j := range sensors {
+			if sensors[j] != expected[i][j] {
+				t.Errorf("partition %d: expected %v, got %v", i, expected[i], sensors)
+				break
+			}
+		}
+	}
+}
+
+func TestStickyPartition_KeysStayOnOnePartition(t *testing.T) {
+	ctx := context.Background()
+
+	type order struct {
+		Customer string
+		Seq      int
+	}
+
+	partition, err := NewStickyPartition(func(o order) string { return o.Customer }, 4, 200)
+	if err != nil {
+		t.Fatalf("Failed to create partition: %v", err)
+	}
+
+	in := make(chan Result[order], 200)
+	for seq := 0; seq < 20; seq++ {
+		for c := 0; c < 10; c++ {
+			in <- NewSuccess(order{Customer: fmt.Sprintf("customer-%d", c), Seq: seq})
+		}
+	}
+	close(in)
+
+	outs := partition.Process(ctx, in)
+
+	home := make(map[string]int)
+	lastSeq := make(map[string]int)
+	for i, out := range outs {
+		for result := range out {
+			o := result.Value()
+			if p, seen := home[o.Customer]; seen && p != i {
+				t.Errorf("%s split across partitions %d and %d", o.Customer, p, i)
+			}
+			home[o.Customer] = i
+
+			if last, seen := lastSeq[o.Customer]; seen && o.Seq <= last {
+				t.Errorf("%s out of order: %d after %d", o.Customer, o.Seq, last)
+			}
+			lastSeq[o.Customer] = o.Seq
+
+			if strategy, _ := result.GetMetadata(MetadataPartitionStrategy); strategy != "sticky" {
+				t.Errorf("expected strategy 'sticky', got %v", strategy)
+			}
+		}
+	}
+
+	if len(home) != 10 {
+		t.Errorf("expected all 10 customers routed, got %d", len(home))
+	}
+}
+
+func TestStickyPartition_BalancesLoad(t *testing.T) {
+	strategy := NewStickyStrategy(func(s string) string { return s })
+	counts := make([]int, 4)
+
+	// 1000 keys, each with a varying number of items
+	for k := 0; k < 1000; k++ {
+		key := fmt.Sprintf("key-%d", k)
+		for i := 0; i < 1+k%7; i++ {
+			counts[strategy.Route(key, 4)]++
+		}
+	}
+
+	total := 0
+	for _, c := range counts {
+		total += c
+	}
+	for i, c := range counts {
+		if c < total/4*9/10 || c > total/4*11/10 {
+			t.Errorf("partition %d has %d of %d items, expected within 10%% of even", i, c, total)
+		}
+	}
+}
+
+func TestStickyPartition_AssignsNewKeysToLeastLoaded(t *testing.T) {
+	strategy := NewStickyStrategy(func(s string) string { return s })
+
+	// A hot key fills partition 0; new keys go elsewhere until loads even out
+	for i := 0; i < 5; i++ {
+		if p := strategy.Route("hot", 3); p != 0 {
+			t.Fatalf("expected hot key on partition 0, got %d", p)
+		}
+	}
+	if p := strategy.Route("a", 3); p != 1 {
+		t.Errorf("expected first new key on partition 1, got %d", p)
+	}
+	if p := strategy.Route("b", 3); p != 2 {
+		t.Errorf("expected second new key on partition 2, got %d", p)
+	}
+	if p := strategy.Route("c", 3); p != 1 {
+		t.Errorf("expected third new key on least-loaded partition 1, got %d", p)
+	}
+}
+
+func TestPartition_WithKeyNormalizer(t *testing.T) {
+	keys := []string{"Apple", "apple", " APPLE", "Banana", "banana ", "cherry", "Cherry"}
+	canonical := func(key string) string { return strings.ToLower(strings.TrimSpace(key)) }
+
+	hash, err := NewHashPartition(8, func(s string) string { return s }, len(keys))
+	if err != nil {
+		t.Fatalf("Failed to create partition: %v", err)
+	}
+	sticky, err := NewStickyPartition(func(s string) string { return s }, 8, len(keys))
+	if err != nil {
+		t.Fatalf("Failed to create partition: %v", err)
+	}
+
+	for name, partition := range map[string]*Partition[string]{"hash": hash, "sticky": sticky} {
+		t.Run(name, func(t *testing.T) {
+			partition.WithKeyNormalizer(canonical)
+
+			in := make(chan Result[string], len(keys))
+			for _, key := range keys {
+				in <- NewSuccess(key)
+			}
+			close(in)
+
+			home := make(map[string]int)
+			for i, out := range partition.Process(context.Background(), in) {
+				for result := range out {
+					// Items keep their original form
+					original := result.Value()
+					if !slices.Contains(keys, original) {
+						t.Errorf("unexpected item %q", original)
+					}
+					key := canonical(original)
+					if p, seen := home[key]; seen && p != i {
+						t.Errorf("%q variants split across partitions %d and %d", key, p, i)
+					}
+					home[key] = i
+				}
+			}
+			if len(home) != 3 {
+				t.Errorf("expected 3 canonical keys routed, got %d", len(home))
+			}
+		})
+	}
+}
+
+func TestNewStickyPartition_Validation(t *testing.T) {
+	key := func(s string) string { return s }
+	if _, err := NewStickyPartition(key, 0, 0); err == nil {
+		t.Error("expected error for zero partitions")
+	}
+	if _, err := NewStickyPartition(key, 2, -1); err == nil {
+		t.Error("expected error for negative buffer size")
+	}
+	if _, err := NewStickyPartition[string](nil, 2, 0); err == nil {
+		t.Error("expected error for nil key extractor")
+	}
+}
+
 func TestPartition_SinglePartition(t *testing.T) {
 	partition, err := NewRoundRobinPartition[string](1, 5)
 	if err != nil {
@@ -608,6 +857,16 @@ func TestPartition_ConfigValidation(t *testing.T) {
 			},
 			expectedError: "strategy cannot be nil",
 		},
+		{
+			name: "unknown overflow policy",
+			config: PartitionConfig[string]{
+				PartitionCount: 3,
+				Strategy:       &RoundRobinPartition[string]{},
+				BufferSize:     5,
+				OverflowPolicy: OverflowPolicy(99),
+			},
+			expectedError: "unknown overflow policy 99",
+		},
 	}
 
 	for _, tt := range tests {
@@ -750,6 +1009,159 @@ func TestPartition_BufferOverflow(t *testing.T) {
 	}
 }
 
+// testHundredsStrategy routes values by their hundreds digit: 1-99 to partition 0, 101-199 to 1.
+type testHundredsStrategy struct{}
+
+func (testHundredsStrategy) Route(value int, _ int) int {
+	return value / 100
+}
+
+// runOverflowPartition routes values through a 2-partition Partition without any
+// consumer until the input has been fully routed, then drains both partitions.
+// Negative values are sent as error Results.
+func runOverflowPartition(t *testing.T, policy OverflowPolicy, values []int) (partition *Partition[int], outputs [2][]Result[int]) {
+	t.Helper()
+
+	partition, err := NewPartition(PartitionConfig[int]{
+		Strategy:       testHundredsStrategy{},
+		PartitionCount: 2,
+		BufferSize:     3,
+		OverflowPolicy: policy,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create partition: %v", err)
+	}
+
+	in := make(chan Result[int], len(values))
+	for _, v := range values {
+		if v < 0 {
+			in <- NewError(v, errors.New("upstream failure"), "source")
+		} else {
+			in <- NewSuccess(v)
+		}
+	}
+	close(in)
+
+	outs := partition.Process(context.Background(), in)
+
+	// Both consumers are stalled; every item must still end up buffered or dropped.
+	// Under OverflowBlock this would never complete.
+	deadline := time.Now().Add(time.Second)
+	for len(outs[0])+len(outs[1])+int(partition.DroppedCount()) < len(values) { // #nosec G115 -- small test counts
+		if time.Now().After(deadline) {
+			t.Fatal("partitioner stalled on a full partition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	for result := range outs[1] {
+		outputs[1] = append(outputs[1], result)
+	}
+	for result := range outs[0] {
+		outputs[0] = append(outputs[0], result)
+	}
+	return partition, outputs
+}
+
+func partitionValues(results []Result[int]) []int {
+	values := make([]int, 0, len(results))
+	for _, result := range results {
+		values = append(values, result.Value())
+	}
+	return values
+}
+
+func TestPartition_OverflowDropNewest(t *testing.T) {
+	partition, outputs := runOverflowPartition(t, OverflowDropNewest, []int{1, 101, 2, 102, 3, 103, 4, 5, 6})
+
+	if got := partitionValues(outputs[0]); fmt.Sprint(got) != "[1 2 3]" {
+		t.Errorf("expected partition 0 to keep [1 2 3], got %v", got)
+	}
+	if got := partitionValues(outputs[1]); fmt.Sprint(got) != "[101 102 103]" {
+		t.Errorf("expected partition 1 to receive [101 102 103], got %v", got)
+	}
+	if partition.DroppedCount() != 3 {
+		t.Errorf("expected 3 dropped items, got %d", partition.DroppedCount())
+	}
+}
+
+func TestPartition_OverflowDropOldest(t *testing.T) {
+	partition, outputs := runOverflowPartition(t, OverflowDropOldest, []int{1, 101, 2, 102, 3, 103, 4, 5, 6})
+
+	if got := partitionValues(outputs[0]); fmt.Sprint(got) != "[4 5 6]" {
+		t.Errorf("expected partition 0 to keep [4 5 6], got %v", got)
+	}
+	if got := partitionValues(outputs[1]); fmt.Sprint(got) != "[101 102 103]" {
+		t.Errorf("expected partition 1 to receive [101 102 103], got %v", got)
+	}
+	if partition.DroppedCount() != 3 {
+		t.Errorf("expected 3 dropped items, got %d", partition.DroppedCount())
+	}
+}
+
+func TestPartition_OverflowRouteToError(t *testing.T) {
+	partition, outputs := runOverflowPartition(t, OverflowRouteToError, []int{1, 101, 102, 103, 104, 105})
+
+	if got := partitionValues(outputs[1]); fmt.Sprint(got) != "[101 102 103]" {
+		t.Errorf("expected partition 1 to receive [101 102 103], got %v", got)
+	}
+
+	if len(outputs[0]) != 3 {
+		t.Fatalf("expected 3 results on partition 0, got %d", len(outputs[0]))
+	}
+	if outputs[0][0].IsError() || outputs[0][0].Value() != 1 {
+		t.Errorf("expected regular item 1 first on partition 0, got %v", outputs[0][0])
+	}
+	for i, expected := range []int{104, 105} {
+		result := outputs[0][i+1]
+		if !result.IsError() {
+			t.Fatalf("expected overflow error for %d, got success", expected)
+		}
+		if !errors.Is(result.Error(), ErrPartitionOverflow) {
+			t.Errorf("expected ErrPartitionOverflow, got %v", result.Error())
+		}
+		if result.Error().Item != expected {
+			t.Errorf("expected overflow item %d, got %d", expected, result.Error().Item)
+		}
+		if strategy, _ := result.GetMetadata(MetadataPartitionStrategy); strategy != "error" {
+			t.Errorf("expected error strategy metadata, got %v", strategy)
+		}
+	}
+	if partition.DroppedCount() != 0 {
+		t.Errorf("expected no dropped items, got %d", partition.DroppedCount())
+	}
+}
+
+func TestPartition_OverflowRouteToErrorFullErrorPartition(t *testing.T) {
+	// Partition 0 overflows itself, so there is nowhere to report the error
+	partition, outputs := runOverflowPartition(t, OverflowRouteToError, []int{1, 2, 3, 4, 101})
+
+	if got := partitionValues(outputs[0]); fmt.Sprint(got) != "[1 2 3]" {
+		t.Errorf("expected partition 0 to keep [1 2 3], got %v", got)
+	}
+	if len(outputs[1]) != 1 {
+		t.Errorf("expected partition 1 to keep flowing, got %v", partitionValues(outputs[1]))
+	}
+	if partition.DroppedCount() != 1 {
+		t.Errorf("expected 1 dropped item, got %d", partition.DroppedCount())
+	}
+}
+
+func TestPartition_OverflowRouteToErrorDropsErrorsOnFullErrorPartition(t *testing.T) {
+	// Upstream errors already target partition 0, so one that overflows it is dropped
+	partition, outputs := runOverflowPartition(t, OverflowRouteToError, []int{1, -2, 3, -4, 101})
+
+	if len(outputs[0]) != 3 || outputs[0][0].Value() != 1 || !outputs[0][1].IsError() || outputs[0][2].Value() != 3 {
+		t.Fatalf("expected partition 0 to keep [1 error 3], got %v", outputs[0])
+	}
+	if len(outputs[1]) != 1 {
+		t.Errorf("expected partition 1 to keep flowing, got %v", partitionValues(outputs[1]))
+	}
+	if partition.DroppedCount() != 1 {
+		t.Errorf("expected the overflowing error to be counted as dropped, got %d", partition.DroppedCount())
+	}
+}
+
 func TestDefaultHasher_CommonTypes(t *testing.T) {
 	tests := []struct {
 		name string
@@ -0,0 +1,281 @@
+package streamz
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"time"
+)
+
+// tdigestCompression bounds a t-digest to roughly this many centroids; quantile
+// error is then well under 1% of the rank, and smallest near the tails.
+const tdigestCompression = 100
+
+// QuantileWindow estimates quantiles of a numeric value over tumbling time
+// windows with a t-digest sketch, so percentiles such as p50, p95, and p99 can
+// be reported per window without holding every item in memory.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type QuantileWindow[T any] struct {
+	name      string
+	valFn     func(T) float64
+	quantiles []float64
+	window    time.Duration
+	clock     Clock
+}
+
+// NewQuantileWindow creates a processor that emits the requested quantiles once per window.
+// Windows are consecutive and non-overlapping, starting when Process is called.
+// At each window close, a single Result maps every requested quantile to its
+// estimated value and carries the window metadata (see GetWindowMetadata).
+// Windows with no items emit nothing, and a partial window is flushed when the
+// input closes. Memory per window is bounded by the sketch, not the item count.
+//
+// Errors pass through immediately as error Results and are not measured.
+//
+// When to use:
+//   - Tracking latency percentiles against SLOs per interval
+//   - Reporting payload size distributions
+//   - Feeding percentile dashboards from high-volume streams
+//
+// Example:
+//
+//	// p50, p95, and p99 request latency per minute
+//	latency := streamz.NewQuantileWindow(func(r Request) float64 {
+//		return r.Duration.Seconds()
+//	}, []float64{0.5, 0.95, 0.99}, time.Minute, streamz.RealClock)
+//
+//	for result := range latency.Process(ctx, requests) {
+//		if result.IsSuccess() {
+//			metrics.Gauge("latency_p99", result.Value()[0.99])
+//		}
+//	}
+//
+// Parameters:
+//   - valFn: Extracts the value whose distribution is measured
+//   - quantiles: Quantiles to report, each between 0.0 and 1.0
+//   - window: Duration of each window
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new QuantileWindow processor.
+// Panics if any quantile is outside the valid range [0.0, 1.0].
+func NewQuantileWindow[T any](valFn func(T) float64, quantiles []float64, window time.Duration, clock Clock) *QuantileWindow[T] {
+	for _, q := range quantiles {
+		if q < 0.0 || q > 1.0 || math.IsNaN(q) {
+			panic("quantiles must be between 0.0 and 1.0")
+		}
+	}
+	return &QuantileWindow[T]{
+		name:      "quantile-window",
+		valFn:     valFn,
+		quantiles: slices.Clone(quantiles),
+		window:    window,
+		clock:     clock,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "quantile-window".
+func (w *QuantileWindow[T]) WithName(name string) *QuantileWindow[T] {
+	w.name = name
+	return w
+}
+
+// Process sketches each window's values and emits their quantiles when the window closes.
+func (w *QuantileWindow[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[map[float64]float64] {
+	out := make(chan Result[map[float64]float64])
+
+	go func() {
+		defer close(out)
+
+		ticker := w.clock.NewTicker(w.window)
+		defer ticker.Stop()
+
+		start := w.clock.Now()
+		current := WindowMetadata{Start: start, End: start.Add(w.window), Type: "tumbling", Size: w.window}
+		digest := newTDigest(tdigestCompression)
+
+		emit := func() bool {
+			if digest.count == 0 {
+				return true
+			}
+			values := make(map[float64]float64, len(w.quantiles))
+			for _, q := range w.quantiles {
+				values[q] = digest.quantile(q)
+			}
+			digest.reset()
+
+			select {
+			case out <- AddWindowMetadata(NewSuccess(values), current):
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					emit()
+					return
+				}
+
+				if item.IsError() {
+					select {
+					case out <- NewError(map[float64]float64{}, item.Error().Err, item.Error().ProcessorName):
+					case <-ctx.Done():
+						return
+					}
+					continue
+				}
+
+				digest.add(w.valFn(item.Value()))
+
+			case <-ticker.C():
+				if !emit() {
+					return
+				}
+				current = WindowMetadata{Start: current.End, End: current.End.Add(w.window), Type: "tumbling", Size: w.window}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (w *QuantileWindow[T]) Name() string {
+	return w.name
+}
+
+// centroid summarizes weight values by their mean.
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// tdigest is a merging t-digest. Values are buffered and periodically merged
+// into centroids whose size is limited by the arcsine scale function, keeping
+// centroids small near the tails where quantile accuracy matters most.
+type tdigest struct {
+	compression float64
+	centroids   []centroid
+	buffer      []centroid
+	count       float64
+	min, max    float64
+}
+
+func newTDigest(compression float64) *tdigest {
+	return &tdigest{
+		compression: compression,
+		buffer:      make([]centroid, 0, 5*int(compression)),
+	}
+}
+
+// add records a single value.
+func (d *tdigest) add(x float64) {
+	if d.count == 0 || x < d.min {
+		d.min = x
+	}
+	if d.count == 0 || x > d.max {
+		d.max = x
+	}
+	d.count++
+	d.buffer = append(d.buffer, centroid{mean: x, weight: 1})
+	if len(d.buffer) == cap(d.buffer) {
+		d.merge()
+	}
+}
+
+// reset empties the digest for reuse.
+func (d *tdigest) reset() {
+	d.centroids = d.centroids[:0]
+	d.buffer = d.buffer[:0]
+	d.count = 0
+}
+
+// scale maps a quantile to the k-scale, on which each centroid spans at most one unit.
+func (d *tdigest) scale(q float64) float64 {
+	return d.compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+// scaleInverse maps a k-scale position back to a quantile.
+func (d *tdigest) scaleInverse(k float64) float64 {
+	if k >= d.compression/4 {
+		return 1
+	}
+	return (math.Sin(k*2*math.Pi/d.compression) + 1) / 2
+}
+
+// merge folds buffered values into the centroids.
+func (d *tdigest) merge() {
+	if len(d.buffer) == 0 {
+		return
+	}
+	all := make([]centroid, 0, len(d.centroids)+len(d.buffer))
+	all = append(all, d.centroids...)
+	all = append(all, d.buffer...)
+	slices.SortFunc(all, func(a, b centroid) int { return cmp.Compare(a.mean, b.mean) })
+
+	merged := make([]centroid, 0, len(d.centroids)+1)
+	current := all[0]
+	var before float64
+	limit := d.scaleInverse(d.scale(0) + 1)
+	for _, next := range all[1:] {
+		if (before+current.weight+next.weight)/d.count <= limit {
+			total := current.weight + next.weight
+			current.mean += (next.mean - current.mean) * next.weight / total
+			current.weight = total
+			continue
+		}
+		before += current.weight
+		merged = append(merged, current)
+		limit = d.scaleInverse(d.scale(before/d.count) + 1)
+		current = next
+	}
+	d.centroids = append(merged, current)
+	d.buffer = d.buffer[:0]
+}
+
+// quantile estimates the value at quantile q by interpolating between centroid
+// means, treating each centroid's weight as centered on its mean.
+func (d *tdigest) quantile(q float64) float64 {
+	d.merge()
+	if len(d.centroids) == 0 {
+		return math.NaN()
+	}
+	if q <= 0 {
+		return d.min
+	}
+	if q >= 1 {
+		return d.max
+	}
+
+	target := q * d.count
+	first := d.centroids[0]
+	if target < first.weight/2 {
+		return d.min + (first.mean-d.min)*target/(first.weight/2)
+	}
+
+	position := first.weight / 2
+	for i := 1; i < len(d.centroids); i++ {
+		prev, next := d.centroids[i-1], d.centroids[i]
+		step := (prev.weight + next.weight) / 2
+		if target < position+step {
+			return prev.mean + (next.mean-prev.mean)*(target-position)/step
+		}
+		position += step
+	}
+
+	last := d.centroids[len(d.centroids)-1]
+	tail := last.weight / 2
+	if tail == 0 {
+		return d.max
+	}
+	return last.mean + (d.max-last.mean)*math.Min(1, (target-position)/tail)
+}
@@ -0,0 +1,138 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+func TestQuantileWindow_Name(t *testing.T) {
+	window := NewQuantileWindow(requestSeconds, []float64{0.5}, time.Minute, RealClock)
+	if window.Name() != "quantile-window" {
+		t.Errorf("expected name 'quantile-window', got %q", window.Name())
+	}
+	if window.WithName("latency").Name() != "latency" {
+		t.Errorf("expected name 'latency', got %q", window.Name())
+	}
+}
+
+func TestQuantileWindow_PanicsOnInvalidQuantile(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for quantile above 1.0")
+		}
+	}()
+	NewQuantileWindow(requestSeconds, []float64{0.5, 1.5}, time.Minute, RealClock)
+}
+
+func TestQuantileWindow_EstimatesQuantiles(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	start := clock.Now()
+	quantiles := []float64{0, 0.5, 0.9, 0.99, 1}
+	window := NewQuantileWindow(func(v int) float64 { return float64(v) }, quantiles, time.Minute, clock)
+
+	in := make(chan Result[int])
+	out := window.Process(context.Background(), in)
+
+	// Values 1..10000, uniformly distributed, fed in a scrambled order
+	const n = 10000
+	for i := 0; i < n; i++ {
+		in <- NewSuccess(i*7919%n + 1)
+	}
+	// The unbuffered send above only returns once the previous value is sketched
+	in <- NewError(0, errors.New("timeout"), "client")
+	if result := <-out; !result.IsError() {
+		t.Fatal("expected error passed through immediately")
+	}
+
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+
+	result := <-out
+	if result.IsError() {
+		t.Fatalf("unexpected error: %v", result.Error())
+	}
+	values := result.Value()
+	if len(values) != len(quantiles) {
+		t.Fatalf("expected %d quantiles, got %v", len(quantiles), values)
+	}
+	// Within 0.5% of the range
+	for _, q := range quantiles {
+		want := math.Max(1, q*n)
+		if got := values[q]; math.Abs(got-want) > 0.005*n {
+			t.Errorf("quantile %v: expected about %v, got %v", q, want, got)
+		}
+	}
+	if values[0] != 1 || values[1] != n {
+		t.Errorf("expected exact extremes 1 and %d, got %v and %v", n, values[0], values[1])
+	}
+
+	meta, err := GetWindowMetadata(result)
+	if err != nil {
+		t.Fatalf("expected window metadata: %v", err)
+	}
+	if !meta.Start.Equal(start) || !meta.End.Equal(start.Add(time.Minute)) {
+		t.Errorf("expected window [%v, %v), got [%v, %v)", start, start.Add(time.Minute), meta.Start, meta.End)
+	}
+	if meta.Type != "tumbling" || meta.Size != time.Minute {
+		t.Errorf("expected tumbling window of 1m, got %s of %v", meta.Type, meta.Size)
+	}
+
+	// The next window starts from an empty sketch and is flushed on close
+	in <- NewSuccess(42)
+	close(in)
+	result = <-out
+	if got := result.Value()[0.5]; got != 42 {
+		t.Errorf("expected median 42 for a single value, got %v", got)
+	}
+	meta, _ = GetWindowMetadata(result)
+	if !meta.Start.Equal(start.Add(time.Minute)) {
+		t.Errorf("expected second window to start at %v, got %v", start.Add(time.Minute), meta.Start)
+	}
+	if _, ok := <-out; ok {
+		t.Error("expected output closed")
+	}
+}
+
+func TestQuantileWindow_EmptyWindowEmitsNothing(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	window := NewQuantileWindow(requestSeconds, []float64{0.5}, time.Minute, clock)
+
+	in := make(chan Result[request])
+	out := window.Process(context.Background(), in)
+
+	waitForTimer(t, clock)
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+	close(in)
+
+	if result, ok := <-out; ok {
+		t.Errorf("expected no output for empty window, got %v", result)
+	}
+}
+
+func TestTDigest_SkewedDistribution(t *testing.T) {
+	// Exponentially distributed values stress the tails
+	digest := newTDigest(tdigestCompression)
+	const n = 20000
+	for i := 0; i < n; i++ {
+		u := (float64(i*7919%n) + 0.5) / n
+		digest.add(-math.Log(1 - u))
+	}
+
+	// Rank error stays within one centroid, whose width shrinks toward the tails
+	for _, q := range []float64{0.5, 0.9, 0.99, 0.999} {
+		got := digest.quantile(q)
+		rank := 1 - math.Exp(-got)
+		if bound := math.Pi / tdigestCompression * math.Sqrt(q*(1-q)); math.Abs(rank-q) > bound {
+			t.Errorf("quantile %v: estimate %.4f has rank %.5f, beyond error bound %.5f", q, got, rank, bound)
+		}
+	}
+	if len(digest.centroids) > 2*tdigestCompression {
+		t.Errorf("expected at most %d centroids, got %d", 2*tdigestCompression, len(digest.centroids))
+	}
+}
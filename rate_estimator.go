@@ -0,0 +1,134 @@
+package streamz
+
+import (
+	"context"
+	"math"
+	"sync/atomic"
+	"time"
+)
+
+// RateEstimator measures stream throughput as an exponentially weighted moving
+// average (EWMA) while forwarding items unchanged. Controllers can poll EWMARate
+// to react to smoothed load instead of spiky instantaneous rates.
+//
+// Each arrival contributes an instantaneous rate of items per second since the
+// previous arrival. Items that arrive with no elapsed time are accumulated and
+// folded into the next measurement.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type RateEstimator[T any] struct {
+	name  string
+	alpha float64
+	clock Clock
+	rate  atomic.Uint64 // math.Float64bits of the current EWMA
+}
+
+// NewRateEstimator creates a processor that tracks an EWMA of items per second.
+// Alpha controls smoothing: values near 1 follow the latest inter-arrival time
+// closely, values near 0 change slowly. Both successful items and errors count
+// toward throughput.
+//
+// When to use:
+//   - Driving adaptive sampling or throttling from smoothed load
+//   - Exporting throughput gauges to monitoring
+//   - Detecting sustained slowdowns without reacting to single gaps
+//
+// Example:
+//
+//	estimator := streamz.NewRateEstimator[Event](0.2, streamz.RealClock)
+//	events = estimator.Process(ctx, events)
+//
+//	go func() {
+//		for range time.Tick(time.Second) {
+//			metrics.Gauge("events_per_second", estimator.EWMARate())
+//		}
+//	}()
+//
+// Parameters:
+//   - alpha: Smoothing factor in (0.0, 1.0]
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new RateEstimator processor.
+// Panics if alpha is outside the valid range (0.0, 1.0].
+func NewRateEstimator[T any](alpha float64, clock Clock) *RateEstimator[T] {
+	if alpha <= 0.0 || alpha > 1.0 || math.IsNaN(alpha) {
+		panic("rate estimator alpha must be in (0.0, 1.0]")
+	}
+
+	return &RateEstimator[T]{
+		name:  "rate-estimator",
+		alpha: alpha,
+		clock: clock,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "rate-estimator".
+func (r *RateEstimator[T]) WithName(name string) *RateEstimator[T] {
+	r.name = name
+	return r
+}
+
+// EWMARate returns the smoothed throughput in items per second.
+// Returns 0 until at least two items have arrived with time between them.
+// Safe to call concurrently with Process.
+func (r *RateEstimator[T]) EWMARate() float64 {
+	return math.Float64frombits(r.rate.Load())
+}
+
+// Process forwards every item unchanged, updating the rate estimate on arrival.
+func (r *RateEstimator[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		var last time.Time
+		var pending int
+		started := false
+		initialized := false
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+
+				now := r.clock.Now()
+				if !started {
+					last = now
+					started = true
+				} else {
+					pending++
+					if elapsed := now.Sub(last); elapsed > 0 {
+						instant := float64(pending) / elapsed.Seconds()
+						rate := instant
+						if initialized {
+							rate = r.alpha*instant + (1-r.alpha)*r.EWMARate()
+						}
+						r.rate.Store(math.Float64bits(rate))
+						initialized = true
+						last = now
+						pending = 0
+					}
+				}
+
+				select {
+				case out <- item:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (r *RateEstimator[T]) Name() string {
+	return r.name
+}
@@ -0,0 +1,143 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+// feedAtInterval sends count items through the estimator, advancing the clock by
+// interval before each one and waiting for it to be forwarded.
+func feedAtInterval(t *testing.T, clock *clockz.FakeClock, in chan<- Result[int], out <-chan Result[int], count int, interval time.Duration) {
+	t.Helper()
+	for i := 0; i < count; i++ {
+		clock.Advance(interval)
+		in <- NewSuccess(i)
+		select {
+		case <-out:
+		case <-time.After(time.Second):
+			t.Fatal("item not forwarded")
+		}
+	}
+}
+
+func TestRateEstimator_Name(t *testing.T) {
+	estimator := NewRateEstimator[int](0.5, RealClock)
+	if estimator.Name() != "rate-estimator" {
+		t.Errorf("expected name 'rate-estimator', got %q", estimator.Name())
+	}
+	if estimator.WithName("ingest-rate").Name() != "ingest-rate" {
+		t.Errorf("expected name 'ingest-rate', got %q", estimator.Name())
+	}
+}
+
+func TestRateEstimator_InvalidAlpha(t *testing.T) {
+	for _, alpha := range []float64{0, -0.1, 1.5, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for alpha %v", alpha)
+				}
+			}()
+			NewRateEstimator[int](alpha, RealClock)
+		}()
+	}
+}
+
+func TestRateEstimator_ForwardsUnchanged(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int], 3)
+	in <- NewSuccess(1).WithMetadata(MetadataSource, "api")
+	in <- NewError(2, errors.New("bad"), "parser")
+	in <- NewSuccess(3)
+	close(in)
+
+	var results []Result[int]
+	for result := range NewRateEstimator[int](0.5, clockz.NewFakeClock()).Process(ctx, in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if source, _, _ := results[0].GetStringMetadata(MetadataSource); source != "api" {
+		t.Errorf("expected metadata preserved, got %q", source)
+	}
+	if !results[1].IsError() || results[2].Value() != 3 {
+		t.Errorf("expected items forwarded unchanged, got %v", results)
+	}
+}
+
+func TestRateEstimator_ConvergesToSteadyRate(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	in := make(chan Result[int])
+	estimator := NewRateEstimator[int](0.3, clock)
+	out := estimator.Process(ctx, in)
+
+	if estimator.EWMARate() != 0 {
+		t.Errorf("expected zero rate before any items, got %f", estimator.EWMARate())
+	}
+
+	// 10 items per second
+	feedAtInterval(t, clock, in, out, 20, 100*time.Millisecond)
+
+	if rate := estimator.EWMARate(); math.Abs(rate-10) > 1e-9 {
+		t.Errorf("expected steady rate of 10/s, got %f", rate)
+	}
+	close(in)
+}
+
+func TestRateEstimator_StepChangeSmoothing(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	in := make(chan Result[int])
+	alpha := 0.5
+	estimator := NewRateEstimator[int](alpha, clock)
+	out := estimator.Process(ctx, in)
+
+	// Settle at 10/s
+	feedAtInterval(t, clock, in, out, 5, 100*time.Millisecond)
+	if rate := estimator.EWMARate(); math.Abs(rate-10) > 1e-9 {
+		t.Fatalf("expected 10/s before step, got %f", rate)
+	}
+
+	// Step to 20/s: each arrival moves the estimate halfway to the new rate
+	expected := 10.0
+	for i := 0; i < 4; i++ {
+		feedAtInterval(t, clock, in, out, 1, 50*time.Millisecond)
+		expected = alpha*20 + (1-alpha)*expected
+		if rate := estimator.EWMARate(); math.Abs(rate-expected) > 1e-9 {
+			t.Errorf("step %d: expected %f, got %f", i, expected, rate)
+		}
+	}
+
+	// Converges to the new rate
+	feedAtInterval(t, clock, in, out, 40, 50*time.Millisecond)
+	if rate := estimator.EWMARate(); math.Abs(rate-20) > 1e-6 {
+		t.Errorf("expected convergence to 20/s, got %f", rate)
+	}
+	close(in)
+}
+
+func TestRateEstimator_SimultaneousArrivals(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	in := make(chan Result[int])
+	estimator := NewRateEstimator[int](1.0, clock)
+	out := estimator.Process(ctx, in)
+
+	feedAtInterval(t, clock, in, out, 1, time.Second)
+	// Three items with no elapsed time, then one a second later: 4 items in 1s
+	feedAtInterval(t, clock, in, out, 3, 0)
+	feedAtInterval(t, clock, in, out, 1, time.Second)
+
+	if rate := estimator.EWMARate(); math.Abs(rate-4) > 1e-9 {
+		t.Errorf("expected burst folded into 4/s, got %f", rate)
+	}
+	close(in)
+}
@@ -0,0 +1,115 @@
+package streamz
+
+import (
+	"context"
+)
+
+// Rebatch splits oversized batches into sub-batches no larger than a maximum size,
+// decoupling upstream batch sizing from downstream limits such as bulk API caps.
+type Rebatch[T any] struct {
+	name    string
+	maxSize int
+}
+
+// NewRebatch creates a processor that enforces a maximum batch size.
+// Batches larger than maxSize are split, in order, into chunks of maxSize with a
+// smaller final chunk; batches within the limit pass through unchanged. Each
+// sub-batch carries the metadata of the batch it came from.
+//
+// Empty batches are dropped. Error batches pass through unchanged.
+//
+// When to use:
+//   - Respecting downstream bulk-write or request size limits
+//   - Re-chunking batches from a KeyedBatcher or external source
+//   - Bounding per-batch memory or latency in later stages
+//
+// Example:
+//
+//	// Bulk indexing accepts at most 500 documents per request
+//	batches := streamz.NewBatcher[Doc](streamz.BatchConfig{MaxSize: 5000, MaxLatency: time.Second}, streamz.RealClock)
+//	rebatch := streamz.NewRebatch[Doc](500)
+//
+//	for result := range rebatch.Process(ctx, batches.Process(ctx, docs)) {
+//		if result.IsSuccess() {
+//			index.Bulk(result.Value())
+//		}
+//	}
+//
+// Parameters:
+//   - maxSize: Maximum number of items per emitted batch (must be positive)
+//
+// Returns a new Rebatch processor.
+// Panics if maxSize is less than 1.
+func NewRebatch[T any](maxSize int) *Rebatch[T] {
+	if maxSize < 1 {
+		panic("rebatch max size must be positive")
+	}
+
+	return &Rebatch[T]{
+		name:    "rebatch",
+		maxSize: maxSize,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "rebatch".
+func (r *Rebatch[T]) WithName(name string) *Rebatch[T] {
+	r.name = name
+	return r
+}
+
+// Process splits each incoming batch into sub-batches of at most maxSize items.
+func (r *Rebatch[T]) Process(ctx context.Context, in <-chan Result[[]T]) <-chan Result[[]T] {
+	out := make(chan Result[[]T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsError() {
+				select {
+				case out <- item:
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+
+			batch := item.Value()
+			if len(batch) <= r.maxSize {
+				if len(batch) == 0 {
+					continue
+				}
+				select {
+				case out <- item:
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+
+			for start := 0; start < len(batch); start += r.maxSize {
+				end := min(start+r.maxSize, len(batch))
+				// Cap each chunk so appends downstream cannot overwrite its neighbor
+				chunk := Result[[]T]{value: batch[start:end:end], metadata: item.metadata}
+				select {
+				case out <- chunk:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (r *Rebatch[T]) Name() string {
+	return r.name
+}
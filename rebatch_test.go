@@ -0,0 +1,108 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func rebatchAll(t *testing.T, maxSize int, batches ...Result[[]int]) [][]int {
+	t.Helper()
+	in := make(chan Result[[]int], len(batches))
+	for _, batch := range batches {
+		in <- batch
+	}
+	close(in)
+
+	var out [][]int
+	for result := range NewRebatch[int](maxSize).Process(context.Background(), in) {
+		if result.IsError() {
+			t.Fatalf("unexpected error: %v", result.Error())
+		}
+		out = append(out, result.Value())
+	}
+	return out
+}
+
+func TestRebatch_Name(t *testing.T) {
+	rebatch := NewRebatch[int](10)
+	if rebatch.Name() != "rebatch" {
+		t.Errorf("expected name 'rebatch', got %q", rebatch.Name())
+	}
+	if rebatch.WithName("bulk-limit").Name() != "bulk-limit" {
+		t.Errorf("expected name 'bulk-limit', got %q", rebatch.Name())
+	}
+}
+
+func TestRebatch_InvalidMaxSize(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic for zero max size")
+		}
+	}()
+	NewRebatch[int](0)
+}
+
+func TestRebatch_SplitsOversizedBatch(t *testing.T) {
+	got := rebatchAll(t, 3, NewSuccess([]int{1, 2, 3, 4, 5, 6, 7, 8}))
+
+	expected := [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestRebatch_SizedBatchesUnchanged(t *testing.T) {
+	got := rebatchAll(t, 3,
+		NewSuccess([]int{1, 2, 3}),
+		NewSuccess([]int{4}),
+	)
+
+	expected := [][]int{{1, 2, 3}, {4}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestRebatch_DropsEmptyBatches(t *testing.T) {
+	got := rebatchAll(t, 3,
+		NewSuccess([]int{}),
+		NewSuccess([]int(nil)),
+		NewSuccess([]int{1}),
+	)
+
+	if !reflect.DeepEqual(got, [][]int{{1}}) {
+		t.Errorf("expected empty batches dropped, got %v", got)
+	}
+}
+
+func TestRebatch_ErrorsAndMetadata(t *testing.T) {
+	in := make(chan Result[[]int], 2)
+	in <- NewError([]int{1, 2, 3, 4}, errors.New("flush failed"), "batcher")
+	in <- NewSuccess([]int{1, 2, 3, 4}).WithMetadata(MetadataSource, "orders")
+	close(in)
+
+	var results []Result[[]int]
+	for result := range NewRebatch[int](2).Process(context.Background(), in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("expected error plus 2 sub-batches, got %d results", len(results))
+	}
+	if !results[0].IsError() || len(results[0].Error().Item) != 4 {
+		t.Errorf("expected error batch passed through unchanged, got %v", results[0])
+	}
+	for _, result := range results[1:] {
+		if source, _, _ := result.GetStringMetadata(MetadataSource); source != "orders" {
+			t.Errorf("expected sub-batch to keep metadata, got %q", source)
+		}
+	}
+
+	// Appending to a sub-batch must not clobber its neighbor
+	first := append(results[1].Value(), 99)
+	if results[2].Value()[0] != 3 || len(first) != 3 {
+		t.Errorf("expected independent sub-batches, got %v and %v", first, results[2].Value())
+	}
+}
@@ -0,0 +1,35 @@
+package streamz
+
+import (
+	"context"
+)
+
+// receive waits for the next item from in, giving cancellation priority.
+// It returns false once in is closed or ctx is canceled, so processor loops
+// written as
+//
+//	for {
+//		item, ok := receive(ctx, in)
+//		if !ok {
+//			return
+//		}
+//		...
+//	}
+//
+// exit on cancellation even when the producer never closes its channel.
+func receive[T any](ctx context.Context, in <-chan T) (T, bool) {
+	var zero T
+
+	select {
+	case <-ctx.Done():
+		return zero, false
+	default:
+	}
+
+	select {
+	case item, ok := <-in:
+		return item, ok
+	case <-ctx.Done():
+		return zero, false
+	}
+}
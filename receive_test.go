@@ -0,0 +1,89 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var errBarrier = errors.New("barrier")
+
+// sendWithBarrier sends item, then a marker error, and returns the results out
+// emitted before the marker came back. Processors handle items in order and
+// forward errors, so once the marker is read item has been fully handled, even
+// when it produced nothing. The marker is consumed and not returned.
+func sendWithBarrier[T, U any](t *testing.T, in chan<- Result[T], out <-chan Result[U], item Result[T]) []Result[U] {
+	t.Helper()
+	var zero T
+	queue := []Result[T]{item, NewError(zero, errBarrier, "test")}
+	timeout := time.After(time.Second)
+
+	var emitted []Result[U]
+	for {
+		// Keep reading while sending, since item may block until its output is taken
+		var input chan<- Result[T]
+		var next Result[T]
+		if len(queue) > 0 {
+			input, next = in, queue[0]
+		}
+
+		select {
+		case input <- next:
+			queue = queue[1:]
+		case result := <-out:
+			if result.IsError() && errors.Is(result.Error().Err, errBarrier) {
+				return emitted
+			}
+			emitted = append(emitted, result)
+		case <-timeout:
+			t.Fatal("timed out waiting for the barrier")
+			return nil
+		}
+	}
+}
+
+func TestReceive(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan int, 1)
+
+	in <- 7
+	if v, ok := receive(ctx, in); !ok || v != 7 {
+		t.Errorf("expected (7, true), got (%d, %v)", v, ok)
+	}
+
+	close(in)
+	if v, ok := receive(ctx, in); ok || v != 0 {
+		t.Errorf("expected (0, false) on closed input, got (%d, %v)", v, ok)
+	}
+}
+
+func TestReceive_CancellationWithOpenInput(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+
+	done := make(chan bool)
+	go func() {
+		_, ok := receive(ctx, in)
+		done <- ok
+	}()
+
+	cancel()
+	if ok := <-done; ok {
+		t.Error("expected receive to return false after cancellation")
+	}
+}
+
+func TestReceive_CancellationTakesPriority(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	in := make(chan int, 1)
+	in <- 1
+
+	for i := 0; i < 100; i++ {
+		if _, ok := receive(ctx, in); ok {
+			t.Fatal("expected canceled context to win over a ready input")
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package streamz
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// MetadataSequenceGap records how many missing sequence numbers were skipped
+// immediately before an item emitted by Reorder.
+const MetadataSequenceGap = "sequence_gap" // uint64 - sequences skipped before this item
+
+// Reorder restores strict sequence order to a stream whose items arrive out of
+// order, such as the output of an unordered AsyncMapper or a network transport.
+// Items ahead of the expected sequence are buffered until the gap fills.
+//
+// Gaps that never fill are skipped when the buffer grows past maxBuffer, when the
+// optional gap timeout expires, or when the input closes. The first item emitted
+// after a skip carries MetadataSequenceGap with the number of sequences skipped.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Reorder[T any] struct {
+	name       string
+	seqFn      func(T) uint64
+	byMetadata bool
+	maxBuffer  int
+	start      uint64
+	gapTimeout time.Duration
+	clock      Clock
+}
+
+// NewReorder creates a processor that emits items in strict sequence order.
+// Sequences start at 0 unless configured with WithStartSequence.
+//
+// Outcomes by arrival:
+//   - Expected sequence: emitted immediately, followed by any buffered successors
+//   - Ahead of the expected sequence: buffered until the gap fills or is skipped
+//   - Behind the expected sequence (duplicate or arrived after being skipped):
+//     emitted as an error Result
+//   - Errors: passed through immediately, unless ordered by WithSequenceMetadata
+//
+// When to use:
+//   - Restoring order after parallel, unordered processing
+//   - Reassembling sequenced messages from unordered transports
+//   - Enforcing ordered application of change events
+//
+// Example:
+//
+//	// Restore order after unordered parallel enrichment
+//	reorder := streamz.NewReorder(func(e Event) uint64 { return e.Seq }, 1000, streamz.RealClock).
+//		WithGapTimeout(5 * time.Second)
+//
+//	ordered := reorder.Process(ctx, enriched)
+//
+// Parameters:
+//   - seqFn: Extracts the sequence number from an item
+//   - maxBuffer: Maximum number of out-of-order items held before skipping a gap
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new Reorder processor.
+func NewReorder[T any](seqFn func(T) uint64, maxBuffer int, clock Clock) *Reorder[T] {
+	return &Reorder[T]{
+		name:      "reorder",
+		seqFn:     seqFn,
+		maxBuffer: maxBuffer,
+		clock:     clock,
+	}
+}
+
+// WithGapTimeout sets how long to wait for a missing sequence before skipping it.
+// The timeout restarts whenever the expected sequence advances.
+// If not set, gaps are only skipped on buffer overflow or input close.
+func (r *Reorder[T]) WithGapTimeout(timeout time.Duration) *Reorder[T] {
+	r.gapTimeout = timeout
+	return r
+}
+
+// WithSequenceMetadata orders Results by their MetadataSequence value, as stamped
+// by a Sequencer, instead of calling seqFn, which may then be nil. Errors carrying
+// a sequence are ordered along with successes, so an item that failed in a parallel
+// stage keeps its place; errors without one pass through immediately, and
+// successes without one are emitted as error Results.
+func (r *Reorder[T]) WithSequenceMetadata() *Reorder[T] {
+	r.byMetadata = true
+	return r
+}
+
+// WithStartSequence sets the first expected sequence number.
+// If not set, defaults to 0.
+func (r *Reorder[T]) WithStartSequence(seq uint64) *Reorder[T] {
+	r.start = seq
+	return r
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "reorder".
+func (r *Reorder[T]) WithName(name string) *Reorder[T] {
+	r.name = name
+	return r
+}
+
+// Process emits items in sequence order, buffering out-of-order arrivals.
+// Buffered items are emitted in order, skipping any gaps, when the input closes.
+func (r *Reorder[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		next := r.start
+		buffer := make(map[uint64]Result[T])
+		var timer Timer
+		var timerC <-chan time.Time
+
+		stopTimer := func() {
+			if timer != nil {
+				timer.Stop()
+				timer = nil
+				timerC = nil
+			}
+		}
+
+		// restartTimer tracks how long the head-of-line gap has been waiting
+		restartTimer := func() {
+			stopTimer()
+			if len(buffer) > 0 && r.gapTimeout > 0 {
+				timer = r.clock.NewTimer(r.gapTimeout)
+				timerC = timer.C()
+			}
+		}
+
+		emit := func(result Result[T]) bool {
+			select {
+			case out <- result:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		// drain emits buffered items for as long as the sequence is contiguous
+		drain := func() bool {
+			for {
+				result, exists := buffer[next]
+				if !exists {
+					return true
+				}
+				delete(buffer, next)
+				next++
+				if !emit(result) {
+					return false
+				}
+			}
+		}
+
+		// skipGap jumps to the lowest buffered sequence and drains from there
+		skipGap := func() bool {
+			if len(buffer) == 0 {
+				return true
+			}
+			lowest := next
+			first := true
+			for seq := range buffer {
+				if first || seq < lowest {
+					lowest = seq
+					first = false
+				}
+			}
+			buffer[lowest] = buffer[lowest].WithMetadata(MetadataSequenceGap, lowest-next)
+			next = lowest
+			return drain()
+		}
+
+		for {
+			// Phase 1: Check gap timer first with higher priority
+			if timerC != nil {
+				select {
+				case <-timerC:
+					timer = nil
+					timerC = nil
+					if !skipGap() {
+						return
+					}
+					restartTimer()
+					continue
+				default:
+				}
+			}
+
+			// Phase 2: Process input/context
+			select {
+			case result, ok := <-in:
+				if !ok {
+					stopTimer()
+					for len(buffer) > 0 {
+						if !skipGap() {
+							return
+						}
+					}
+					return
+				}
+
+				seq, sequenced, err := r.sequence(result)
+				if err != nil {
+					if !emit(r.reject(result, err)) {
+						return
+					}
+					continue
+				}
+				if !sequenced {
+					if !emit(result) {
+						return
+					}
+					continue
+				}
+
+				switch {
+				case seq < next:
+					if !emit(r.reject(result, fmt.Errorf("sequence %d already passed, expected %d", seq, next))) {
+						return
+					}
+
+				case seq == next:
+					next++
+					if !emit(result) || !drain() {
+						return
+					}
+					restartTimer()
+
+				default:
+					if _, exists := buffer[seq]; exists {
+						if !emit(r.reject(result, fmt.Errorf("sequence %d already buffered", seq))) {
+							return
+						}
+						continue
+					}
+
+					buffer[seq] = result
+					if len(buffer) > r.maxBuffer {
+						if !skipGap() {
+							return
+						}
+						restartTimer()
+					} else if timer == nil {
+						restartTimer()
+					}
+				}
+
+			case <-timerC:
+				timer = nil
+				timerC = nil
+				if !skipGap() {
+					return
+				}
+				restartTimer()
+
+			case <-ctx.Done():
+				stopTimer()
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// sequence returns the sequence number of a Result and whether it has one.
+// An error is returned for a success lacking a valid sequence in metadata mode.
+func (r *Reorder[T]) sequence(result Result[T]) (uint64, bool, error) {
+	if !r.byMetadata {
+		if result.IsError() {
+			return 0, false, nil
+		}
+		return r.seqFn(result.Value()), true, nil
+	}
+
+	raw, exists := result.GetMetadata(MetadataSequence)
+	seq, valid := raw.(uint64)
+	switch {
+	case exists && valid:
+		return seq, true, nil
+	case result.IsError():
+		return 0, false, nil
+	case !exists:
+		return 0, false, fmt.Errorf("missing %s metadata", MetadataSequence)
+	default:
+		return 0, false, fmt.Errorf("%s metadata has type %T, want uint64", MetadataSequence, raw)
+	}
+}
+
+// reject reports a late or duplicate Result, keeping an upstream error as is.
+func (r *Reorder[T]) reject(result Result[T], err error) Result[T] {
+	if result.IsError() {
+		return result
+	}
+	return Result[T]{err: NewStreamError(result.Value(), err, r.name), metadata: result.metadata}
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (r *Reorder[T]) Name() string {
+	return r.name
+}
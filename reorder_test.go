@@ -0,0 +1,267 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+type sequenced struct {
+	Seq  uint64
+	Data string
+}
+
+func sequenceOf(s sequenced) uint64 { return s.Seq }
+
+func seq(n uint64) Result[sequenced] {
+	return NewSuccess(sequenced{Seq: n})
+}
+
+// receiveSeq reads one item from out, failing the test on timeout.
+func receiveSeq(t *testing.T, out <-chan Result[sequenced]) Result[sequenced] {
+	t.Helper()
+	select {
+	case result := <-out:
+		return result
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for output")
+		return Result[sequenced]{}
+	}
+}
+
+func expectNoOutput(t *testing.T, out <-chan Result[sequenced]) {
+	t.Helper()
+	select {
+	case result := <-out:
+		t.Fatalf("unexpected output: %+v", result)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestReorder_Name(t *testing.T) {
+	reorder := NewReorder(sequenceOf, 10, RealClock)
+	if reorder.Name() != "reorder" {
+		t.Errorf("expected name 'reorder', got %q", reorder.Name())
+	}
+	if reorder.WithName("resequence").Name() != "resequence" {
+		t.Errorf("expected name 'resequence', got %q", reorder.Name())
+	}
+}
+
+func TestReorder_InOrderPassesThrough(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[sequenced])
+	out := NewReorder(sequenceOf, 10, clockz.NewFakeClock()).Process(ctx, in)
+
+	for i := uint64(0); i < 3; i++ {
+		in <- seq(i)
+		if result := receiveSeq(t, out); result.Value().Seq != i {
+			t.Errorf("expected sequence %d immediately, got %d", i, result.Value().Seq)
+		}
+	}
+	close(in)
+
+	if _, ok := <-out; ok {
+		t.Error("expected output to be closed")
+	}
+}
+
+func TestReorder_OutOfOrderWithinBuffer(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[sequenced])
+	out := NewReorder(sequenceOf, 10, clockz.NewFakeClock()).Process(ctx, in)
+
+	in <- seq(2)
+	in <- seq(1)
+	expectNoOutput(t, out)
+
+	in <- seq(0)
+	for i := uint64(0); i < 3; i++ {
+		result := receiveSeq(t, out)
+		if result.Value().Seq != i {
+			t.Errorf("expected sequence %d, got %d", i, result.Value().Seq)
+		}
+		if _, found := result.GetMetadata(MetadataSequenceGap); found {
+			t.Errorf("unexpected gap metadata on sequence %d", i)
+		}
+	}
+	close(in)
+}
+
+func TestReorder_BufferOverflowSkipsGap(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[sequenced])
+	out := NewReorder(sequenceOf, 2, clockz.NewFakeClock()).Process(ctx, in)
+
+	in <- seq(1)
+	in <- seq(2)
+	expectNoOutput(t, out)
+
+	// Third buffered item exceeds maxBuffer, so sequence 0 is skipped
+	in <- seq(3)
+	first := receiveSeq(t, out)
+	if first.Value().Seq != 1 {
+		t.Fatalf("expected sequence 1 after skip, got %d", first.Value().Seq)
+	}
+	if gap, _ := first.GetMetadata(MetadataSequenceGap); gap != uint64(1) {
+		t.Errorf("expected gap of 1, got %v", gap)
+	}
+	for _, expected := range []uint64{2, 3} {
+		if result := receiveSeq(t, out); result.Value().Seq != expected {
+			t.Errorf("expected sequence %d, got %d", expected, result.Value().Seq)
+		}
+	}
+
+	// The skipped sequence arriving late is reported as an error
+	in <- seq(0)
+	late := receiveSeq(t, out)
+	if !late.IsError() || late.Error().Item.Seq != 0 {
+		t.Errorf("expected error for late sequence 0, got %+v", late)
+	}
+	close(in)
+}
+
+func TestReorder_GapTimeout(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	in := make(chan Result[sequenced])
+	out := NewReorder(sequenceOf, 10, clock).
+		WithGapTimeout(100*time.Millisecond).
+		Process(ctx, in)
+
+	in <- seq(2)
+	in <- seq(3)
+
+	// A pass-through error confirms the gap timer is armed
+	in <- NewError(sequenced{}, errors.New("sync"), "test")
+	if result := receiveSeq(t, out); !result.IsError() {
+		t.Fatalf("expected pass-through error, got %+v", result)
+	}
+
+	clock.Advance(50 * time.Millisecond)
+	clock.BlockUntilReady()
+	expectNoOutput(t, out)
+
+	clock.Advance(50 * time.Millisecond)
+	clock.BlockUntilReady()
+
+	first := receiveSeq(t, out)
+	if first.Value().Seq != 2 {
+		t.Fatalf("expected sequence 2 after gap timeout, got %d", first.Value().Seq)
+	}
+	if gap, _ := first.GetMetadata(MetadataSequenceGap); gap != uint64(2) {
+		t.Errorf("expected gap of 2, got %v", gap)
+	}
+	if result := receiveSeq(t, out); result.Value().Seq != 3 {
+		t.Errorf("expected sequence 3, got %d", result.Value().Seq)
+	}
+
+	// Ordering resumes from the new position
+	in <- seq(4)
+	if result := receiveSeq(t, out); result.Value().Seq != 4 {
+		t.Errorf("expected sequence 4, got %d", result.Value().Seq)
+	}
+	close(in)
+}
+
+func TestReorder_FlushOnClose(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[sequenced], 3)
+	in <- seq(5)
+	in <- seq(2)
+	in <- seq(3)
+	close(in)
+
+	var sequences []uint64
+	var gaps []interface{}
+	for result := range NewReorder(sequenceOf, 10, clockz.NewFakeClock()).Process(ctx, in) {
+		sequences = append(sequences, result.Value().Seq)
+		gap, _ := result.GetMetadata(MetadataSequenceGap)
+		gaps = append(gaps, gap)
+	}
+
+	if len(sequences) != 3 || sequences[0] != 2 || sequences[1] != 3 || sequences[2] != 5 {
+		t.Fatalf("expected [2 3 5], got %v", sequences)
+	}
+	if gaps[0] != uint64(2) || gaps[1] != nil || gaps[2] != uint64(1) {
+		t.Errorf("expected gaps [2 <nil> 1], got %v", gaps)
+	}
+}
+
+func TestReorder_StartSequenceAndDuplicates(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[sequenced])
+	out := NewReorder(sequenceOf, 10, clockz.NewFakeClock()).
+		WithStartSequence(100).
+		Process(ctx, in)
+
+	in <- seq(101)
+	in <- NewSuccess(sequenced{Seq: 101, Data: "dup"})
+	dup := receiveSeq(t, out)
+	if !dup.IsError() || dup.Error().Item.Data != "dup" {
+		t.Errorf("expected error for duplicate buffered sequence, got %+v", dup)
+	}
+
+	in <- seq(100)
+	for _, expected := range []uint64{100, 101} {
+		if result := receiveSeq(t, out); result.Value().Seq != expected {
+			t.Errorf("expected sequence %d, got %d", expected, result.Value().Seq)
+		}
+	}
+	close(in)
+}
+
+func TestReorder_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[sequenced])
+	out := NewReorder(sequenceOf, 10, clockz.NewFakeClock()).Process(ctx, in)
+
+	in <- seq(3)
+	cancel()
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Error("expected output to close without flushing after cancellation")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("output didn't close after cancellation")
+	}
+	close(in)
+}
+
+func TestReorder_SequenceMetadata(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[string], 5)
+	in <- NewSuccess("b").WithMetadata(MetadataSequence, uint64(1))
+	in <- NewSuccess("unstamped")
+	in <- NewSuccess("bad").WithMetadata(MetadataSequence, 2)
+	in <- NewError("", errors.New("unsequenced"), "upstream")
+	in <- NewSuccess("a").WithMetadata(MetadataSequence, uint64(0))
+	close(in)
+
+	var results []Result[string]
+	for result := range NewReorder[string](nil, 10, clockz.NewFakeClock()).WithSequenceMetadata().Process(ctx, in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 5 {
+		t.Fatalf("expected 5 results, got %d", len(results))
+	}
+	// Invalid and unsequenced items are emitted immediately, ahead of the buffered b
+	if !results[0].IsError() || results[0].Error().ProcessorName != "reorder" {
+		t.Errorf("expected missing sequence rejected, got %+v", results[0])
+	}
+	if !results[1].IsError() || results[1].Error().ProcessorName != "reorder" {
+		t.Errorf("expected mistyped sequence rejected, got %+v", results[1])
+	}
+	if !results[2].IsError() || results[2].Error().ProcessorName != "upstream" {
+		t.Errorf("expected unsequenced error passed through, got %+v", results[2])
+	}
+	if results[3].Value() != "a" || results[4].Value() != "b" {
+		t.Errorf("expected [a b] in sequence order, got %+v", results[3:])
+	}
+}
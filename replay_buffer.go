@@ -0,0 +1,176 @@
+package streamz
+
+import (
+	"context"
+	"sync"
+)
+
+// Replay metadata keys set by ReplayBuffer.
+const (
+	MetadataReplaySequence = "replay_sequence" // uint64 - position of the item in the replay log, starting at 1
+	MetadataReplayed       = "replayed"        // bool - item is a re-emission requested by Replay
+)
+
+// ReplayBuffer forwards a stream while keeping a bounded log of the most recent
+// items, so that after a flaky sink reconnects the items it may have missed can
+// be emitted again instead of lost.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type ReplayBuffer[T any] struct {
+	name     string
+	capacity int
+
+	mu      sync.Mutex
+	log     []Result[T] // ring buffer of emitted items
+	head    int         // index of the oldest item in log
+	size    int
+	pending []Result[T]
+	notify  chan struct{}
+}
+
+// NewReplayBuffer creates a processor that retains the last capacity emitted items.
+// Every item, success or error, is stamped with MetadataReplaySequence and
+// recorded as it is sent, so an item replayed while its first send is still
+// pending may be delivered twice. Replay queues retained items to be emitted
+// again on the same output, flagged with MetadataReplayed, ahead of any new input.
+// Items older than the last capacity are discarded and can no longer be replayed.
+//
+// When to use:
+//   - Feeding sinks that drop connections and must catch up on reconnect
+//   - Recovering the tail of a stream after a consumer restart
+//   - Keeping a short audit trail of recently emitted items
+//
+// Example:
+//
+//	replay := streamz.NewReplayBuffer[Event](1000)
+//	events := replay.Process(ctx, source)
+//
+//	var delivered any = uint64(0)
+//	for event := range events {
+//		if err := sink.Send(event.Value()); err != nil {
+//			sink.Reconnect()
+//			replay.Replay(delivered.(uint64) + 1)
+//			continue
+//		}
+//		delivered, _ = event.GetMetadata(streamz.MetadataReplaySequence)
+//	}
+//
+// Parameters:
+//   - capacity: Number of most recent items retained (must be positive)
+//
+// Returns a new ReplayBuffer processor.
+// Panics if capacity is less than 1.
+func NewReplayBuffer[T any](capacity int) *ReplayBuffer[T] {
+	if capacity < 1 {
+		panic("capacity must be positive")
+	}
+	return &ReplayBuffer[T]{
+		name:     "replay-buffer",
+		capacity: capacity,
+		log:      make([]Result[T], capacity),
+		notify:   make(chan struct{}, 1),
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "replay-buffer".
+func (r *ReplayBuffer[T]) WithName(name string) *ReplayBuffer[T] {
+	r.name = name
+	return r
+}
+
+// Replay queues every retained item whose MetadataReplaySequence is at least
+// from to be emitted again, in their original order, and returns how many were
+// queued. Use from 0 to replay the whole buffer.
+// Safe to call concurrently with Process.
+func (r *ReplayBuffer[T]) Replay(from uint64) int {
+	r.mu.Lock()
+	queued := 0
+	for i := 0; i < r.size; i++ {
+		item := r.log[(r.head+i)%r.capacity]
+		if seq, _ := item.GetMetadata(MetadataReplaySequence); seq.(uint64) >= from { //nolint:errcheck // stamped by Process
+			r.pending = append(r.pending, item.WithMetadata(MetadataReplayed, true))
+			queued++
+		}
+	}
+	r.mu.Unlock()
+
+	if queued > 0 {
+		select {
+		case r.notify <- struct{}{}:
+		default:
+		}
+	}
+	return queued
+}
+
+// record appends an emitted item to the log, evicting the oldest when full.
+func (r *ReplayBuffer[T]) record(item Result[T]) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.size < r.capacity {
+		r.log[(r.head+r.size)%r.capacity] = item
+		r.size++
+		return
+	}
+	r.log[r.head] = item
+	r.head = (r.head + 1) % r.capacity
+}
+
+// takePending removes and returns the items queued by Replay.
+func (r *ReplayBuffer[T]) takePending() []Result[T] {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pending := r.pending
+	r.pending = nil
+	return pending
+}
+
+// Process forwards items, recording each one and emitting replays on request.
+func (r *ReplayBuffer[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		var seq uint64
+
+		for {
+			for _, item := range r.takePending() {
+				select {
+				case out <- item:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+				seq++
+				item = item.WithMetadata(MetadataReplaySequence, seq)
+				r.record(item)
+
+				select {
+				case out <- item:
+				case <-ctx.Done():
+					return
+				}
+
+			case <-r.notify:
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (r *ReplayBuffer[T]) Name() string {
+	return r.name
+}
@@ -0,0 +1,121 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+// replaySequence returns the replay sequence stamped on an item.
+func replaySequence(t *testing.T, item Result[int]) uint64 {
+	t.Helper()
+	seq, found := item.GetMetadata(MetadataReplaySequence)
+	if !found {
+		t.Fatal("expected replay sequence metadata")
+	}
+	return seq.(uint64) //nolint:errcheck // stamped by ReplayBuffer
+}
+
+func TestReplayBuffer_Name(t *testing.T) {
+	replay := NewReplayBuffer[int](10)
+	if replay.Name() != "replay-buffer" {
+		t.Errorf("expected name 'replay-buffer', got %q", replay.Name())
+	}
+	if replay.WithName("sink-replay").Name() != "sink-replay" {
+		t.Errorf("expected name 'sink-replay', got %q", replay.Name())
+	}
+}
+
+func TestReplayBuffer_PanicsOnInvalidCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for capacity of 0")
+		}
+	}()
+	NewReplayBuffer[int](0)
+}
+
+func TestReplayBuffer_ForwardsItems(t *testing.T) {
+	in := make(chan Result[int], 3)
+	in <- NewSuccess(1).WithMetadata(MetadataSource, "api")
+	in <- NewError(2, errors.New("invalid"), "validator")
+	in <- NewSuccess(3)
+	close(in)
+
+	var results []Result[int]
+	for result := range NewReplayBuffer[int](10).Process(context.Background(), in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if results[0].Value() != 1 || !results[1].IsError() || results[2].Value() != 3 {
+		t.Errorf("expected items forwarded unchanged, got %+v", results)
+	}
+	if source, _, _ := results[0].GetStringMetadata(MetadataSource); source != "api" {
+		t.Errorf("expected metadata preserved, got source %q", source)
+	}
+	for i, result := range results {
+		if seq := replaySequence(t, result); seq != uint64(i+1) {
+			t.Errorf("expected sequence %d, got %d", i+1, seq)
+		}
+		if _, replayed := result.GetMetadata(MetadataReplayed); replayed {
+			t.Error("expected forwarded items not flagged as replayed")
+		}
+	}
+}
+
+func TestReplayBuffer_ReplaysRetainedItems(t *testing.T) {
+	replay := NewReplayBuffer[int](3)
+	in := make(chan Result[int])
+	defer close(in)
+	out := replay.Process(context.Background(), in)
+
+	for i := 1; i <= 5; i++ {
+		in <- NewSuccess(i)
+		<-out
+	}
+
+	// Items 1 and 2 fell out of the buffer
+	if queued := replay.Replay(0); queued != 3 {
+		t.Fatalf("expected 3 retained items queued, got %d", queued)
+	}
+	var replayed []int
+	for i := 0; i < 3; i++ {
+		select {
+		case result := <-out:
+			if flag, _ := result.GetMetadata(MetadataReplayed); flag != true {
+				t.Error("expected replayed items flagged")
+			}
+			replayed = append(replayed, result.Value())
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for replayed item")
+		}
+	}
+	if !slices.Equal(replayed, []int{3, 4, 5}) {
+		t.Errorf("expected replay of [3 4 5], got %v", replayed)
+	}
+
+	// Replay from a sequence skips earlier items, and evicted ones are gone
+	if queued := replay.Replay(5); queued != 1 {
+		t.Fatalf("expected 1 item from sequence 5, got %d", queued)
+	}
+	if result := <-out; result.Value() != 5 || replaySequence(t, result) != 5 {
+		t.Errorf("expected item 5 replayed, got %d", result.Value())
+	}
+	if queued := replay.Replay(6); queued != 0 {
+		t.Errorf("expected nothing after the last sequence, got %d", queued)
+	}
+
+	// Forwarding resumes after the replay, and replays are not logged again
+	in <- NewSuccess(6)
+	if result := <-out; result.Value() != 6 || replaySequence(t, result) != 6 {
+		t.Errorf("expected item 6 with sequence 6, got %d", result.Value())
+	}
+	if queued := replay.Replay(0); queued != 3 {
+		t.Errorf("expected buffer of 3 after replays, got %d", queued)
+	}
+}
@@ -95,20 +95,43 @@ func (r Result[T]) MapError(fn func(*StreamError[T]) *StreamError[T]) Result[T]
 	return result
 }
 
+// Recover attempts to convert an error Result into a success.
+// If fn returns (value, true), a successful Result holding value is returned.
+// If fn returns false, or this Result is already successful, it is returned unchanged.
+// Metadata is preserved through recovery.
+func (r Result[T]) Recover(fn func(*StreamError[T]) (T, bool)) Result[T] {
+	if r.err == nil {
+		return r // Propagate success with metadata
+	}
+
+	value, ok := fn(r.err)
+	if !ok {
+		return r // Keep unrecoverable error with metadata
+	}
+
+	result := NewSuccess(value)
+	if r.metadata != nil {
+		// Preserve metadata through recovery
+		result.metadata = r.metadata
+	}
+	return result
+}
+
 // Standard metadata keys for common use cases.
 const (
-	MetadataWindowStart = "window_start" // time.Time - window start time
-	MetadataWindowEnd   = "window_end"   // time.Time - window end time
-	MetadataWindowType  = "window_type"  // string - "tumbling", "sliding", "session"
-	MetadataWindowSize  = "window_size"  // time.Duration - window duration
-	MetadataWindowSlide = "window_slide" // time.Duration - slide interval (sliding only)
-	MetadataWindowGap   = "window_gap"   // time.Duration - activity gap (session only)
-	MetadataSessionKey  = "session_key"  // string - session identifier (session only)
-	MetadataSource      = "source"       // string - data source identifier
-	MetadataTimestamp   = "timestamp"    // time.Time - processing timestamp
-	MetadataProcessor   = "processor"    // string - processor that added metadata
-	MetadataRetryCount  = "retry_count"  // int - number of retries attempted
-	MetadataSessionID   = "session_id"   // string - session identifier
+	MetadataWindowStart   = "window_start"   // time.Time - window start time
+	MetadataWindowEnd     = "window_end"     // time.Time - window end time
+	MetadataWindowType    = "window_type"    // string - "tumbling", "sliding", "session"
+	MetadataWindowSize    = "window_size"    // time.Duration - window duration
+	MetadataWindowSlide   = "window_slide"   // time.Duration - slide interval (sliding only)
+	MetadataWindowGap     = "window_gap"     // time.Duration - activity gap (session only)
+	MetadataWindowPartial = "window_partial" // bool - early firing of a still-open window (tumbling only)
+	MetadataSessionKey    = "session_key"    // string - session identifier (session only)
+	MetadataSource        = "source"         // string - data source identifier
+	MetadataTimestamp     = "timestamp"      // time.Time - processing timestamp
+	MetadataProcessor     = "processor"      // string - processor that added metadata
+	MetadataRetryCount    = "retry_count"    // int - number of retries attempted
+	MetadataSessionID     = "session_id"     // string - session identifier
 )
 
 // WithMetadata returns a new Result with the specified metadata key-value pair.
@@ -143,6 +166,37 @@ func (r Result[T]) WithMetadata(key string, value interface{}) Result[T] {
 	}
 }
 
+// WithMetadataMap returns a new Result with all entries of m added to its metadata.
+// Entries with empty keys are ignored, and entries in m override existing keys.
+// The combined metadata is built in a single allocation, making this cheaper than
+// chaining WithMetadata when stamping several keys at once.
+// The original Result and m are unchanged.
+func (r Result[T]) WithMetadataMap(m map[string]interface{}) Result[T] {
+	var newMetadata map[string]interface{}
+	for key, value := range m {
+		if key == "" {
+			continue // Ignore empty keys, consistent with WithMetadata
+		}
+		if newMetadata == nil {
+			newMetadata = make(map[string]interface{}, len(r.metadata)+len(m))
+			for k, v := range r.metadata {
+				newMetadata[k] = v
+			}
+		}
+		newMetadata[key] = value
+	}
+
+	if newMetadata == nil {
+		return r // Nothing to add
+	}
+
+	return Result[T]{
+		value:    r.value,
+		err:      r.err,
+		metadata: newMetadata,
+	}
+}
+
 // GetMetadata retrieves a metadata value by key.
 // Returns the value and true if the key exists, nil and false otherwise.
 // The caller must type-assert the returned value to the expected type.
@@ -0,0 +1,143 @@
+package streamz
+
+import (
+	"context"
+)
+
+// ResultRouter routes whole Results to named outputs using predicates that see
+// the Result rather than the bare value, so routing can depend on metadata and
+// on success or error status. This complements Switch, whose predicate only
+// receives successful values.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type ResultRouter[T any] struct {
+	name       string
+	routes     []resultRoute[T]
+	bufferSize int
+}
+
+// resultRoute pairs a route predicate with its output channel.
+type resultRoute[T any] struct {
+	name      string
+	predicate func(Result[T]) bool
+	ch        chan Result[T]
+}
+
+// NewResultRouter creates a router whose routes are chosen by Result predicates.
+// Routes are evaluated in the order they were added and each item goes to the
+// first route whose predicate matches. Items matching no route, including any
+// for which a predicate panics, go to the default route returned by Process.
+//
+// When to use:
+//   - Routing by metadata set in enrichment stages (source, tenant, retry count)
+//   - Sending errors and successes to different sinks in one step
+//   - Escalating items that have been retried too often
+//
+// Example:
+//
+//	router := streamz.NewResultRouter[Order]()
+//	exhausted := router.AddRoute("exhausted", func(r streamz.Result[Order]) bool {
+//		retries, found, _ := r.GetIntMetadata(streamz.MetadataRetryCount)
+//		return found && retries > 3
+//	})
+//	fromAPI := router.AddRoute("api", func(r streamz.Result[Order]) bool {
+//		source, _, _ := r.GetStringMetadata(streamz.MetadataSource)
+//		return source == "api"
+//	})
+//
+//	rest := router.Process(ctx, orders)
+//
+// Returns a new ResultRouter processor.
+func NewResultRouter[T any]() *ResultRouter[T] {
+	return &ResultRouter[T]{
+		name: "result-router",
+	}
+}
+
+// WithBufferSize sets the buffer size of each route channel, including the default.
+// Must be called before routes are added.
+// If not set, defaults to 0 (unbuffered).
+func (r *ResultRouter[T]) WithBufferSize(size int) *ResultRouter[T] {
+	r.bufferSize = size
+	return r
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "result-router".
+func (r *ResultRouter[T]) WithName(name string) *ResultRouter[T] {
+	r.name = name
+	return r
+}
+
+// AddRoute adds a route after any existing ones and returns its output channel.
+// Routes must be added before Process is called. Every route channel must be
+// consumed, or routing blocks once it fills.
+func (r *ResultRouter[T]) AddRoute(name string, predicate func(Result[T]) bool) <-chan Result[T] {
+	ch := make(chan Result[T], r.bufferSize)
+	r.routes = append(r.routes, resultRoute[T]{name: name, predicate: predicate, ch: ch})
+	return ch
+}
+
+// RouteNames returns the route names in evaluation order.
+func (r *ResultRouter[T]) RouteNames() []string {
+	names := make([]string, len(r.routes))
+	for i, route := range r.routes {
+		names[i] = route.name
+	}
+	return names
+}
+
+// Process routes each Result to the first matching route and returns the default
+// route, which receives everything else. All route channels close when the input
+// closes or the context is canceled.
+func (r *ResultRouter[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	defaultCh := make(chan Result[T], r.bufferSize)
+	routes := r.routes
+
+	go func() {
+		defer func() {
+			for _, route := range routes {
+				close(route.ch)
+			}
+			close(defaultCh)
+		}()
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			target := defaultCh
+			for _, route := range routes {
+				if safeMatch(route.predicate, item) {
+					target = route.ch
+					break
+				}
+			}
+
+			select {
+			case target <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return defaultCh
+}
+
+// safeMatch evaluates a user predicate, treating a panic as no match.
+func safeMatch[V any](fn func(V) bool, v V) (matched bool) {
+	defer func() {
+		if recover() != nil {
+			matched = false
+		}
+	}()
+	return fn(v)
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (r *ResultRouter[T]) Name() string {
+	return r.name
+}
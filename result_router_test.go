@@ -0,0 +1,116 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+// collectRoute drains a route channel in the background.
+func collectRoute(wg *sync.WaitGroup, ch <-chan Result[int], into *[]Result[int]) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for r := range ch {
+			*into = append(*into, r)
+		}
+	}()
+}
+
+func TestResultRouter_Name(t *testing.T) {
+	router := NewResultRouter[int]()
+	if router.Name() != "result-router" {
+		t.Errorf("expected name 'result-router', got %q", router.Name())
+	}
+	if router.WithName("by-source").Name() != "by-source" {
+		t.Errorf("expected name 'by-source', got %q", router.Name())
+	}
+}
+
+func TestResultRouter_RoutesByMetadataAndStatus(t *testing.T) {
+	router := NewResultRouter[int]()
+	failed := router.AddRoute("failed", func(r Result[int]) bool {
+		return r.IsError()
+	})
+	exhausted := router.AddRoute("exhausted", func(r Result[int]) bool {
+		retries, found, _ := r.GetIntMetadata(MetadataRetryCount)
+		return found && retries > 3
+	})
+	fromAPI := router.AddRoute("api", func(r Result[int]) bool {
+		source, _, _ := r.GetStringMetadata(MetadataSource)
+		return source == "api"
+	})
+
+	if names := router.RouteNames(); len(names) != 3 || names[0] != "failed" || names[2] != "api" {
+		t.Errorf("expected routes in order, got %v", names)
+	}
+
+	in := make(chan Result[int], 6)
+	in <- NewSuccess(1).WithMetadata(MetadataSource, "api")
+	in <- NewSuccess(2).WithMetadata(MetadataRetryCount, 5)
+	in <- NewSuccess(3).WithMetadata(MetadataRetryCount, 1)
+	in <- NewError(4, errors.New("timeout"), "client").WithMetadata(MetadataSource, "api")
+	in <- NewSuccess(5).WithMetadata(MetadataSource, "batch")
+	in <- NewSuccess(6).WithMetadata(MetadataRetryCount, 4).WithMetadata(MetadataSource, "api")
+	close(in)
+
+	rest := router.Process(context.Background(), in)
+
+	var wg sync.WaitGroup
+	var gotFailed, gotExhausted, gotAPI, gotRest []Result[int]
+	collectRoute(&wg, failed, &gotFailed)
+	collectRoute(&wg, exhausted, &gotExhausted)
+	collectRoute(&wg, fromAPI, &gotAPI)
+	collectRoute(&wg, rest, &gotRest)
+	wg.Wait()
+
+	// The error carries source=api but the earlier "failed" route wins
+	if len(gotFailed) != 1 || !gotFailed[0].IsError() {
+		t.Errorf("expected the error on the failed route, got %v", gotFailed)
+	}
+	if len(gotExhausted) != 2 || gotExhausted[0].Value() != 2 || gotExhausted[1].Value() != 6 {
+		t.Errorf("expected items 2 and 6 on the exhausted route, got %v", gotExhausted)
+	}
+	if len(gotAPI) != 1 || gotAPI[0].Value() != 1 {
+		t.Errorf("expected item 1 on the api route, got %v", gotAPI)
+	}
+	if len(gotRest) != 2 || gotRest[0].Value() != 3 || gotRest[1].Value() != 5 {
+		t.Errorf("expected items 3 and 5 on the default route, got %v", gotRest)
+	}
+}
+
+func TestResultRouter_PanickingPredicate(t *testing.T) {
+	router := NewResultRouter[int]().WithBufferSize(1)
+	risky := router.AddRoute("risky", func(_ Result[int]) bool {
+		panic("bad predicate")
+	})
+
+	in := make(chan Result[int], 1)
+	in <- NewSuccess(1)
+	close(in)
+
+	rest := router.Process(context.Background(), in)
+	if result := <-rest; result.Value() != 1 {
+		t.Errorf("expected item on the default route, got %v", result)
+	}
+	if _, ok := <-risky; ok {
+		t.Error("expected nothing on the panicking route")
+	}
+}
+
+func TestResultRouter_CancelClosesRoutes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	router := NewResultRouter[int]()
+	route := router.AddRoute("all", func(_ Result[int]) bool { return true })
+
+	in := make(chan Result[int])
+	rest := router.Process(ctx, in)
+	cancel()
+
+	for range rest {
+	}
+	if _, ok := <-route; ok {
+		t.Error("expected route channel to be closed")
+	}
+}
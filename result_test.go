@@ -158,6 +158,89 @@ func TestResult_MapError(t *testing.T) {
 	}
 }
 
+func TestResult_Recover(t *testing.T) {
+	// Recover to a default value
+	errorResult := NewError(-1, errors.New("parse failed"), "parser")
+	recovered := errorResult.Recover(func(_ *StreamError[int]) (int, bool) {
+		return 0, true
+	})
+
+	if recovered.IsError() {
+		t.Fatal("Expected recovered Result to be successful")
+	}
+	if recovered.Value() != 0 {
+		t.Errorf("Expected recovered value 0, got %d", recovered.Value())
+	}
+
+	// Successful Results are returned unchanged without calling fn
+	called := false
+	success := NewSuccess(7).Recover(func(_ *StreamError[int]) (int, bool) {
+		called = true
+		return 0, true
+	})
+	if called {
+		t.Error("Expected recover function not to be called for success")
+	}
+	if success.Value() != 7 {
+		t.Errorf("Expected success value 7, got %d", success.Value())
+	}
+}
+
+func TestResult_RecoverByProcessorName(t *testing.T) {
+	recoverLookups := func(se *StreamError[string]) (string, bool) {
+		if se.ProcessorName == "cache-lookup" {
+			return "fallback", true
+		}
+		return "", false
+	}
+
+	lookup := NewError("key", errors.New("cache miss"), "cache-lookup").Recover(recoverLookups)
+	if lookup.IsError() || lookup.Value() != "fallback" {
+		t.Errorf("Expected cache-lookup error to recover to 'fallback', got %v", lookup)
+	}
+
+	originalErr := errors.New("connection refused")
+	write := NewError("key", originalErr, "db-writer").Recover(recoverLookups)
+	if !write.IsError() {
+		t.Fatal("Expected db-writer error to remain an error")
+	}
+	if !errors.Is(write.Error(), originalErr) {
+		t.Error("Expected unrecovered error to be left intact")
+	}
+	if write.Error().ProcessorName != "db-writer" || write.Error().Item != "key" {
+		t.Errorf("Expected original error details, got %v", write.Error())
+	}
+}
+
+func TestRecover_PreservesMetadata(t *testing.T) {
+	original := NewError(42, errors.New("test error"), "processor").
+		WithMetadata("context", "validation").
+		WithMetadata("retry_count", 3)
+
+	recovered := original.Recover(func(se *StreamError[int]) (int, bool) {
+		return se.Item * 2, true
+	})
+
+	if recovered.IsError() || recovered.Value() != 84 {
+		t.Fatalf("Expected recovered value 84, got %v", recovered)
+	}
+
+	context, found, err := recovered.GetStringMetadata("context")
+	if err != nil || !found || context != "validation" {
+		t.Errorf("Expected context 'validation', got %q (found=%v, err=%v)", context, found, err)
+	}
+	retries, found, err := recovered.GetIntMetadata("retry_count")
+	if err != nil || !found || retries != 3 {
+		t.Errorf("Expected retry count 3, got %d (found=%v, err=%v)", retries, found, err)
+	}
+
+	// Metadata also survives when recovery is declined
+	kept := original.Recover(func(_ *StreamError[int]) (int, bool) { return 0, false })
+	if _, found := kept.GetMetadata("context"); !found {
+		t.Error("Expected metadata preserved on unrecovered error")
+	}
+}
+
 func TestResult_TypeSafety(t *testing.T) {
 	// Test that Result[T] maintains type safety for different types
 
@@ -348,6 +431,94 @@ func TestWithMetadata_EmptyKey(t *testing.T) {
 	}
 }
 
+func TestWithMetadataMap(t *testing.T) {
+	original := NewSuccess(42).WithMetadata("existing", "keep").WithMetadata("override", "old")
+
+	enriched := original.WithMetadataMap(map[string]interface{}{
+		"region":   "eu-west-1",
+		"attempt":  3,
+		"override": "new",
+		"":         "ignored",
+	})
+
+	expected := map[string]interface{}{
+		"existing": "keep",
+		"override": "new",
+		"region":   "eu-west-1",
+		"attempt":  3,
+	}
+	if keys := enriched.MetadataKeys(); len(keys) != len(expected) {
+		t.Errorf("expected %d keys, got %v", len(expected), keys)
+	}
+	for key, want := range expected {
+		if got, ok := enriched.GetMetadata(key); !ok || got != want {
+			t.Errorf("expected %s=%v, got %v (exists=%v)", key, want, got, ok)
+		}
+	}
+	if _, ok := enriched.GetMetadata(""); ok {
+		t.Error("expected empty key to be skipped")
+	}
+	if enriched.Value() != 42 {
+		t.Errorf("expected value preserved, got %d", enriched.Value())
+	}
+
+	// Original is unchanged
+	if keys := original.MetadataKeys(); len(keys) != 2 {
+		t.Errorf("expected original to keep 2 keys, got %v", keys)
+	}
+	if value, _ := original.GetMetadata("override"); value != "old" {
+		t.Errorf("expected original override value 'old', got %v", value)
+	}
+}
+
+func TestWithMetadataMap_ErrorResult(t *testing.T) {
+	result := NewError(1, errors.New("boom"), "test").
+		WithMetadataMap(map[string]interface{}{"stage": "parse"})
+
+	if !result.IsError() || result.Error().Err.Error() != "boom" {
+		t.Errorf("expected error preserved, got %v", result.Error())
+	}
+	if stage, _ := result.GetMetadata("stage"); stage != "parse" {
+		t.Errorf("expected metadata on error result, got %v", stage)
+	}
+}
+
+func TestWithMetadataMap_NothingToAdd(t *testing.T) {
+	result := NewSuccess(1)
+
+	for _, m := range []map[string]interface{}{nil, {}, {"": "ignored"}} {
+		if updated := result.WithMetadataMap(m); updated.HasMetadata() {
+			t.Errorf("expected no metadata for %v, got %v", m, updated.MetadataKeys())
+		}
+	}
+}
+
+// metadataSink keeps allocation measurements honest by forcing results to escape.
+var metadataSink Result[int]
+
+func TestWithMetadataMap_FewerAllocations(t *testing.T) {
+	fields := map[string]interface{}{
+		"key1": "value1", "key2": "value2", "key3": "value3", "key4": "value4", "key5": "value5",
+	}
+	base := NewSuccess(1)
+
+	bulk := testing.AllocsPerRun(100, func() {
+		metadataSink = base.WithMetadataMap(fields)
+	})
+	chained := testing.AllocsPerRun(100, func() {
+		metadataSink = base.
+			WithMetadata("key1", "value1").
+			WithMetadata("key2", "value2").
+			WithMetadata("key3", "value3").
+			WithMetadata("key4", "value4").
+			WithMetadata("key5", "value5")
+	})
+
+	if bulk >= chained {
+		t.Errorf("expected fewer allocations than chained calls, got %v vs %v", bulk, chained)
+	}
+}
+
 func TestGetMetadata_NonExistent(t *testing.T) {
 	result := NewSuccess(42)
 
@@ -771,14 +942,23 @@ func BenchmarkMetadata_Operations(b *testing.B) {
 	})
 
 	b.Run("WithMetadata_Chain", func(b *testing.B) {
+		b.ReportAllocs()
 		for i := 0; i < b.N; i++ {
-			_ = NewSuccess(i).
+			metadataSink = NewSuccess(i).
 				WithMetadata("key1", "value1").
 				WithMetadata("key2", "value2").
 				WithMetadata("key3", "value3")
 		}
 	})
 
+	b.Run("WithMetadataMap", func(b *testing.B) {
+		fields := map[string]interface{}{"key1": "value1", "key2": "value2", "key3": "value3"}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			metadataSink = NewSuccess(i).WithMetadataMap(fields)
+		}
+	})
+
 	b.Run("TypedAccess", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
 			//nolint:errcheck // Benchmark doesn't need to check errors
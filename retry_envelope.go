@@ -0,0 +1,114 @@
+package streamz
+
+import (
+	"context"
+)
+
+// RetryEnvelope enforces a retry ceiling in recirculating pipelines, where a
+// Switch or ResultRouter loops failed items back to the start. Every pass
+// through the envelope is counted in MetadataRetryCount, and items that have
+// circulated too often are diverted to a dead-letter output instead of looping
+// forever.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type RetryEnvelope[T any] struct {
+	name       string
+	maxRetries int
+}
+
+// NewRetryEnvelope creates a processor that counts passes and diverts exhausted items.
+// Each Result, success or error, has its MetadataRetryCount incremented; an item
+// without the key, or with a non-int value, starts at 1. Items whose count is
+// within the maximum are forwarded on the first output, and items whose count
+// exceeds it are sent to the dead-letter output with the incremented count.
+//
+// Both outputs are sent to from the same goroutine, so both must be consumed.
+// The loop back to the input should be buffered or decoupled from the forward
+// output, or the cycle can deadlock.
+//
+// When to use:
+//   - Capping retries in pipelines that recirculate failures
+//   - Tracking how many passes an item took before succeeding
+//   - Diverting poison messages after repeated failures
+//
+// Example:
+//
+//	// Give every order at most three passes through the pipeline
+//	envelope := streamz.NewRetryEnvelope[Order]().WithMaxRetries(3)
+//
+//	retries := make(chan streamz.Result[Order], 100)
+//	attempts, exhausted := envelope.Process(ctx, fanin.Process(ctx, orders, retries))
+//	processed := processor.Process(ctx, attempts)
+//	go func() {
+//		for result := range processed {
+//			if result.IsError() {
+//				retries <- result
+//			}
+//		}
+//	}()
+//	go archive(exhausted)
+//
+// Returns a new RetryEnvelope processor.
+func NewRetryEnvelope[T any]() *RetryEnvelope[T] {
+	return &RetryEnvelope[T]{
+		name:       "retry-envelope",
+		maxRetries: 3,
+	}
+}
+
+// WithMaxRetries sets the highest MetadataRetryCount that is still forwarded.
+// Items counted beyond it go to the dead-letter output.
+// If not set, defaults to 3.
+func (e *RetryEnvelope[T]) WithMaxRetries(n int) *RetryEnvelope[T] {
+	e.maxRetries = n
+	return e
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "retry-envelope".
+func (e *RetryEnvelope[T]) WithName(name string) *RetryEnvelope[T] {
+	e.name = name
+	return e
+}
+
+// Process counts each pass and returns the forward channel and the dead-letter channel.
+// Both channels close when the input closes or the context is canceled.
+func (e *RetryEnvelope[T]) Process(ctx context.Context, in <-chan Result[T]) (forward <-chan Result[T], dead <-chan Result[T]) {
+	out := make(chan Result[T])
+	dlq := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+		defer close(dlq)
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			// A missing or mistyped count reads as zero, so the item starts at 1
+			count, _, _ := item.GetIntMetadata(MetadataRetryCount)
+			count++
+			item = item.WithMetadata(MetadataRetryCount, count)
+
+			target := out
+			if count > e.maxRetries {
+				target = dlq
+			}
+
+			select {
+			case target <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, dlq
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (e *RetryEnvelope[T]) Name() string {
+	return e.name
+}
@@ -0,0 +1,122 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// envelopePass sends one item through a RetryEnvelope and reports which output received it.
+func envelopePass(t *testing.T, in chan<- Result[int], forward, dead <-chan Result[int], item Result[int]) (result Result[int], forwarded bool) {
+	t.Helper()
+	in <- item
+	select {
+	case result := <-forward:
+		return result, true
+	case result := <-dead:
+		return result, false
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for item")
+		return Result[int]{}, false
+	}
+}
+
+func retryCount(t *testing.T, result Result[int]) int {
+	t.Helper()
+	count, found, err := result.GetIntMetadata(MetadataRetryCount)
+	if !found || err != nil {
+		t.Fatalf("expected int retry count, found %v, err %v", found, err)
+	}
+	return count
+}
+
+func TestRetryEnvelope_Name(t *testing.T) {
+	envelope := NewRetryEnvelope[int]()
+	if envelope.Name() != "retry-envelope" {
+		t.Errorf("expected name 'retry-envelope', got %q", envelope.Name())
+	}
+	if envelope.WithName("order-retries").Name() != "order-retries" {
+		t.Errorf("expected name 'order-retries', got %q", envelope.Name())
+	}
+}
+
+func TestRetryEnvelope_FirstPassStartsAtOne(t *testing.T) {
+	in := make(chan Result[int])
+	defer close(in)
+	forward, dead := NewRetryEnvelope[int]().Process(context.Background(), in)
+
+	result, forwarded := envelopePass(t, in, forward, dead, NewSuccess(7).WithMetadata(MetadataSource, "api"))
+	if !forwarded {
+		t.Fatal("expected first pass to be forwarded")
+	}
+	if count := retryCount(t, result); count != 1 {
+		t.Errorf("expected retry count 1, got %d", count)
+	}
+	if source, _, _ := result.GetStringMetadata(MetadataSource); source != "api" || result.Value() != 7 {
+		t.Errorf("expected item and metadata preserved, got %d from %q", result.Value(), source)
+	}
+
+	// A mistyped count restarts the item at 1
+	result, _ = envelopePass(t, in, forward, dead, NewSuccess(8).WithMetadata(MetadataRetryCount, "2"))
+	if count := retryCount(t, result); count != 1 {
+		t.Errorf("expected mistyped count to restart at 1, got %d", count)
+	}
+}
+
+func TestRetryEnvelope_UnderLimitForwardsIncremented(t *testing.T) {
+	in := make(chan Result[int])
+	defer close(in)
+	forward, dead := NewRetryEnvelope[int]().WithMaxRetries(3).Process(context.Background(), in)
+
+	item := NewError(7, errors.New("timeout"), "client").WithMetadata(MetadataRetryCount, 2)
+	result, forwarded := envelopePass(t, in, forward, dead, item)
+	if !forwarded {
+		t.Fatal("expected item under the limit to be forwarded")
+	}
+	if count := retryCount(t, result); count != 3 {
+		t.Errorf("expected retry count 3, got %d", count)
+	}
+	if !result.IsError() || result.Error().ProcessorName != "client" {
+		t.Errorf("expected the error preserved, got %+v", result)
+	}
+}
+
+func TestRetryEnvelope_AtLimitGoesToDeadLetter(t *testing.T) {
+	in := make(chan Result[int])
+	defer close(in)
+	forward, dead := NewRetryEnvelope[int]().WithMaxRetries(3).Process(context.Background(), in)
+
+	item := NewError(7, errors.New("timeout"), "client").WithMetadata(MetadataRetryCount, 3)
+	result, forwarded := envelopePass(t, in, forward, dead, item)
+	if forwarded {
+		t.Fatal("expected item at the limit to go to the dead-letter output")
+	}
+	if count := retryCount(t, result); count != 4 {
+		t.Errorf("expected retry count 4, got %d", count)
+	}
+}
+
+func TestRetryEnvelope_Recirculation(t *testing.T) {
+	in := make(chan Result[int])
+	defer close(in)
+	forward, dead := NewRetryEnvelope[int]().WithMaxRetries(2).Process(context.Background(), in)
+
+	// Loop the item back until the envelope diverts it
+	item := NewError(7, errors.New("always fails"), "client")
+	passes := 0
+	for {
+		result, forwarded := envelopePass(t, in, forward, dead, item)
+		passes++
+		if !forwarded {
+			break
+		}
+		if passes > 5 {
+			t.Fatal("expected the envelope to stop the loop")
+		}
+		item = result
+	}
+	if passes != 3 {
+		t.Errorf("expected 2 forwarded passes before diversion, got %d passes", passes)
+	}
+}
@@ -0,0 +1,195 @@
+package streamz
+
+import (
+	"context"
+	"time"
+)
+
+// Rolling extreme metadata keys set by RollingMinMax.
+const (
+	MetadataRollingMin = "rolling_min" // float64 - minimum over the current window, including this item
+	MetadataRollingMax = "rolling_max" // float64 - maximum over the current window, including this item
+	MetadataNewExtreme = "new_extreme" // bool - item is below the previous min or above the previous max
+)
+
+// RollingMinMax annotates a numeric stream with the minimum and maximum over a
+// rolling window and flags items that set a new extreme. It is a building block
+// for spike and anomaly detection: downstream processors can Filter or Switch on
+// MetadataNewExtreme, or compare values against the rolling range.
+//
+// The window is either the last n items (WithWindow) or the items seen within a
+// duration (WithTimeWindow). Without either, the window covers the whole stream.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type RollingMinMax struct {
+	name     string
+	count    int
+	duration time.Duration
+	clock    Clock
+}
+
+// rollingWindow holds monotonic deques of the values currently in the window:
+// minEntries is increasing and maxEntries is decreasing, so the extremes are
+// always at the front.
+type rollingWindow struct {
+	count      int
+	duration   time.Duration
+	minEntries []rollingEntry
+	maxEntries []rollingEntry
+}
+
+// rollingEntry is a windowed observation kept in a monotonic deque.
+type rollingEntry struct {
+	seq   uint64
+	at    time.Time
+	value float64
+}
+
+// NewRollingMinMax creates a processor that annotates values with rolling extremes.
+// Successful items are forwarded with MetadataRollingMin, MetadataRollingMax, and
+// MetadataNewExtreme. Errors pass through unchanged and do not enter the window.
+//
+// When to use:
+//   - Flagging latency or error-rate spikes
+//   - Detecting sensor readings outside their recent range
+//   - Tracking recent high/low marks for dashboards
+//
+// Example:
+//
+//	// Alert on response times outside the range of the last 100 requests
+//	tracker := streamz.NewRollingMinMax().WithWindow(100)
+//	annotated := tracker.Process(ctx, latencies)
+//
+//	for result := range annotated {
+//		if spike, _ := result.GetMetadata(streamz.MetadataNewExtreme); spike == true {
+//			alert(result.Value())
+//		}
+//	}
+//
+// Returns a new RollingMinMax processor.
+func NewRollingMinMax() *RollingMinMax {
+	return &RollingMinMax{
+		name:  "rolling-minmax",
+		clock: RealClock,
+	}
+}
+
+// WithWindow tracks extremes over the last n items.
+// Overrides any time window.
+func (r *RollingMinMax) WithWindow(n int) *RollingMinMax {
+	r.count = n
+	r.duration = 0
+	return r
+}
+
+// WithTimeWindow tracks extremes over items that arrived within d.
+// Overrides any count window.
+func (r *RollingMinMax) WithTimeWindow(d time.Duration, clock Clock) *RollingMinMax {
+	r.duration = d
+	r.clock = clock
+	r.count = 0
+	return r
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "rolling-minmax".
+func (r *RollingMinMax) WithName(name string) *RollingMinMax {
+	r.name = name
+	return r
+}
+
+// Process annotates each successful value with the rolling min and max.
+// The first value is always a new extreme.
+func (r *RollingMinMax) Process(ctx context.Context, in <-chan Result[float64]) <-chan Result[float64] {
+	out := make(chan Result[float64])
+
+	go func() {
+		defer close(out)
+
+		var seq uint64
+		window := &rollingWindow{count: r.count, duration: r.duration}
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+
+				if item.IsSuccess() {
+					var now time.Time
+					if r.duration > 0 {
+						now = r.clock.Now()
+					}
+					minValue, maxValue, newExtreme := window.observe(item.Value(), seq, now)
+					seq++
+
+					item = item.
+						WithMetadata(MetadataRollingMin, minValue).
+						WithMetadata(MetadataRollingMax, maxValue).
+						WithMetadata(MetadataNewExtreme, newExtreme)
+				}
+
+				select {
+				case out <- item:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// observe adds a value to the window and returns the window extremes, including
+// the value, and whether it lies outside the range of the window before it.
+func (w *rollingWindow) observe(value float64, seq uint64, now time.Time) (minValue, maxValue float64, newExtreme bool) {
+	w.evict(seq, now)
+
+	newExtreme = len(w.minEntries) == 0 ||
+		value < w.minEntries[0].value ||
+		value > w.maxEntries[0].value
+
+	entry := rollingEntry{seq: seq, at: now, value: value}
+
+	for len(w.minEntries) > 0 && w.minEntries[len(w.minEntries)-1].value >= value {
+		w.minEntries = w.minEntries[:len(w.minEntries)-1]
+	}
+	w.minEntries = append(w.minEntries, entry)
+
+	for len(w.maxEntries) > 0 && w.maxEntries[len(w.maxEntries)-1].value <= value {
+		w.maxEntries = w.maxEntries[:len(w.maxEntries)-1]
+	}
+	w.maxEntries = append(w.maxEntries, entry)
+
+	return w.minEntries[0].value, w.maxEntries[0].value, newExtreme
+}
+
+// evict drops entries that fall outside the window once seq is added.
+func (w *rollingWindow) evict(seq uint64, now time.Time) {
+	expired := func(e rollingEntry) bool {
+		switch {
+		case w.count > 0:
+			return seq-e.seq >= uint64(w.count) // #nosec G115 -- count checked > 0
+		case w.duration > 0:
+			return now.Sub(e.at) >= w.duration
+		default:
+			return false
+		}
+	}
+
+	for len(w.minEntries) > 0 && expired(w.minEntries[0]) {
+		w.minEntries = w.minEntries[1:]
+	}
+	for len(w.maxEntries) > 0 && expired(w.maxEntries[0]) {
+		w.maxEntries = w.maxEntries[1:]
+	}
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (r *RollingMinMax) Name() string {
+	return r.name
+}
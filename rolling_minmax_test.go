@@ -0,0 +1,111 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+type rollingStep struct {
+	advance    time.Duration
+	value      float64
+	min        float64
+	max        float64
+	newExtreme bool
+}
+
+// runRollingSteps sends each value and asserts the annotations on its output.
+func runRollingSteps(t *testing.T, tracker *RollingMinMax, clock *clockz.FakeClock, steps []rollingStep) {
+	t.Helper()
+	ctx := context.Background()
+	in := make(chan Result[float64])
+	out := tracker.Process(ctx, in)
+	defer close(in)
+
+	for i, step := range steps {
+		if clock != nil && step.advance > 0 {
+			clock.Advance(step.advance)
+		}
+		in <- NewSuccess(step.value)
+		result := <-out
+
+		minValue, _ := result.GetMetadata(MetadataRollingMin)
+		maxValue, _ := result.GetMetadata(MetadataRollingMax)
+		newExtreme, _ := result.GetMetadata(MetadataNewExtreme)
+
+		if minValue != step.min || maxValue != step.max || newExtreme != step.newExtreme {
+			t.Errorf("step %d (value %v): expected min=%v max=%v new=%v, got min=%v max=%v new=%v",
+				i, step.value, step.min, step.max, step.newExtreme, minValue, maxValue, newExtreme)
+		}
+	}
+}
+
+func TestRollingMinMax_Name(t *testing.T) {
+	tracker := NewRollingMinMax()
+	if tracker.Name() != "rolling-minmax" {
+		t.Errorf("expected name 'rolling-minmax', got %q", tracker.Name())
+	}
+	if tracker.WithName("latency-range").Name() != "latency-range" {
+		t.Errorf("expected name 'latency-range', got %q", tracker.Name())
+	}
+}
+
+func TestRollingMinMax_CountWindow(t *testing.T) {
+	runRollingSteps(t, NewRollingMinMax().WithWindow(3), nil, []rollingStep{
+		{value: 5, min: 5, max: 5, newExtreme: true},
+		{value: 3, min: 3, max: 5, newExtreme: true},
+		{value: 8, min: 3, max: 8, newExtreme: true},
+		{value: 4, min: 3, max: 8, newExtreme: false}, // 5 leaves the window
+		{value: 2, min: 2, max: 8, newExtreme: true},  // 3 leaves the window
+		{value: 6, min: 2, max: 6, newExtreme: true},  // 8 leaves, 6 exceeds {4, 2}
+		{value: 5, min: 2, max: 6, newExtreme: false}, // 4 leaves the window
+	})
+}
+
+func TestRollingMinMax_UnboundedWindow(t *testing.T) {
+	runRollingSteps(t, NewRollingMinMax(), nil, []rollingStep{
+		{value: 5, min: 5, max: 5, newExtreme: true},
+		{value: 1, min: 1, max: 5, newExtreme: true},
+		{value: 3, min: 1, max: 5, newExtreme: false},
+		{value: 5, min: 1, max: 5, newExtreme: false}, // ties are not new extremes
+		{value: 4, min: 1, max: 5, newExtreme: false},
+	})
+}
+
+func TestRollingMinMax_TimeWindow(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	runRollingSteps(t, NewRollingMinMax().WithTimeWindow(time.Second, clock), clock, []rollingStep{
+		{value: 10, min: 10, max: 10, newExtreme: true},
+		{advance: 400 * time.Millisecond, value: 20, min: 10, max: 20, newExtreme: true},
+		{advance: 400 * time.Millisecond, value: 15, min: 10, max: 20, newExtreme: false},
+		{advance: 400 * time.Millisecond, value: 12, min: 12, max: 20, newExtreme: true},  // 10 expired
+		{advance: 1300 * time.Millisecond, value: 13, min: 13, max: 13, newExtreme: true}, // all expired
+	})
+}
+
+func TestRollingMinMax_ErrorsPassThrough(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[float64], 3)
+	in <- NewSuccess(5.0)
+	in <- NewError(100.0, errors.New("bad reading"), "sensor")
+	in <- NewSuccess(7.0)
+	close(in)
+
+	var results []Result[float64]
+	for result := range NewRollingMinMax().Process(ctx, in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if !results[1].IsError() || results[1].HasMetadata() {
+		t.Errorf("expected error passed through without annotations, got %v", results[1])
+	}
+	if maxValue, _ := results[2].GetMetadata(MetadataRollingMax); maxValue != 7.0 {
+		t.Errorf("expected error value excluded from window, got max %v", maxValue)
+	}
+}
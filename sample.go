@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"encoding/binary"
 	"math"
+	mathrand "math/rand/v2"
 )
 
 // Sample randomly selects items from a stream based on a probability rate.
@@ -23,8 +24,12 @@ import (
 //
 //nolint:govet // fieldalignment: struct layout optimized for readability
 type Sample[T any] struct {
-	name string
-	rate float64
+	name          string
+	rate          float64
+	sampleErrors  bool
+	stratifyBy    func(T) string
+	seed          uint64
+	deterministic bool
 }
 
 // NewSample creates a processor that randomly selects items based on probability.
@@ -84,26 +89,65 @@ func (s *Sample[T]) WithName(name string) *Sample[T] {
 	return s
 }
 
+// WithErrorsAlwaysPass controls whether errors bypass sampling.
+// When false, errors are sampled at the same rate as successes (and are not stratified).
+// If not set, defaults to true: every error is passed through.
+func (s *Sample[T]) WithErrorsAlwaysPass(pass bool) *Sample[T] {
+	s.sampleErrors = !pass
+	return s
+}
+
+// WithStratifyBy samples each key independently so rare keys are not lost to chance.
+// The first successful item of every key is always kept and later items of that
+// key are sampled at the configured rate. Keys are remembered for the lifetime of
+// Process, so memory grows with the number of distinct keys.
+func (s *Sample[T]) WithStratifyBy(keyFn func(T) string) *Sample[T] {
+	s.stratifyBy = keyFn
+	return s
+}
+
+// WithSeed makes sampling decisions reproducible: each Process call draws from a
+// pseudo-random generator seeded with seed instead of crypto/rand.
+// Intended for tests and replayable experiments.
+func (s *Sample[T]) WithSeed(seed uint64) *Sample[T] {
+	s.seed = seed
+	s.deterministic = true
+	return s
+}
+
 // Process randomly selects successful items based on the configured rate.
 // Each successful item has an independent probability of being kept.
-// Error items are always passed through unchanged.
+// Error items are always passed through unchanged unless WithErrorsAlwaysPass(false).
 //
-// The selection uses crypto/rand for secure randomness.
+// The selection uses crypto/rand for secure randomness unless a seed is set.
 func (s *Sample[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
 	out := make(chan Result[T])
 
 	go func() {
 		defer close(out)
 
-		for item := range in {
-			select {
-			case <-ctx.Done():
+		random := cryptoFloat64
+		if s.deterministic {
+			random = mathrand.New(mathrand.NewPCG(s.seed, 0)).Float64 // #nosec G404 -- reproducible sampling requested via WithSeed
+		}
+
+		// Keys already represented in the sample, for stratified sampling
+		var seen map[string]bool
+		if s.stratifyBy != nil {
+			seen = make(map[string]bool)
+		}
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
 				return
-			default:
 			}
 
-			// Always pass through errors
+			// Pass through errors unless they are sampled too
 			if item.IsError() {
+				if s.sampleErrors && random() >= s.rate {
+					continue
+				}
 				select {
 				case out <- item:
 				case <-ctx.Done():
@@ -112,8 +156,17 @@ func (s *Sample[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Res
 				continue
 			}
 
-			// Sample successful items based on rate using crypto/rand
-			if cryptoFloat64() < s.rate {
+			keep := random() < s.rate
+			if seen != nil {
+				key := s.stratifyBy(item.Value())
+				if !seen[key] {
+					seen[key] = true
+					keep = true
+				}
+			}
+
+			// Sample successful items based on rate
+			if keep {
 				select {
 				case out <- item:
 				case <-ctx.Done():
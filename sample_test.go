@@ -474,3 +474,116 @@ func TestSample_Process_MonitoringSample(t *testing.T) {
 		t.Errorf("Expected name 'monitoring-sample', got %s", monitor.Name())
 	}
 }
+
+// sampleValues runs values through a sample and returns what was kept.
+func sampleValues[T any](sample *Sample[T], values []T) []T {
+	input := make(chan Result[T], len(values))
+	for _, v := range values {
+		input <- NewSuccess(v)
+	}
+	close(input)
+
+	var kept []T
+	for result := range sample.Process(context.Background(), input) {
+		kept = append(kept, result.Value())
+	}
+	return kept
+}
+
+func TestSample_WithSeed_Deterministic(t *testing.T) {
+	values := make([]int, 1000)
+	for i := range values {
+		values[i] = i
+	}
+
+	sample := NewSample[int](0.3).WithSeed(42)
+	first := sampleValues(sample, values)
+	second := sampleValues(sample, values)
+
+	if len(first) == 0 || len(first) == len(values) {
+		t.Fatalf("expected a partial sample, got %d of %d", len(first), len(values))
+	}
+	if len(first) != len(second) {
+		t.Fatalf("expected identical samples for the same seed, got %d and %d items", len(first), len(second))
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("expected identical samples for the same seed, differ at %d: %d vs %d", i, first[i], second[i])
+		}
+	}
+
+	other := sampleValues(NewSample[int](0.3).WithSeed(7), values)
+	same := len(other) == len(first)
+	for i := 0; same && i < len(first); i++ {
+		same = other[i] == first[i]
+	}
+	if same {
+		t.Error("expected a different seed to produce a different sample")
+	}
+}
+
+func TestSample_WithErrorsAlwaysPass(t *testing.T) {
+	errorsOut := func(sample *Sample[int]) int {
+		input := make(chan Result[int], 200)
+		for i := 0; i < 200; i++ {
+			input <- NewError(i, errors.New("failed"), "upstream")
+		}
+		close(input)
+
+		count := 0
+		for range sample.Process(context.Background(), input) {
+			count++
+		}
+		return count
+	}
+
+	if got := errorsOut(NewSample[int](0.1).WithSeed(1).WithErrorsAlwaysPass(true)); got != 200 {
+		t.Errorf("expected all 200 errors to pass, got %d", got)
+	}
+	if got := errorsOut(NewSample[int](0.0).WithErrorsAlwaysPass(false)); got != 0 {
+		t.Errorf("expected errors sampled away at rate 0, got %d", got)
+	}
+	if got := errorsOut(NewSample[int](0.5).WithSeed(1).WithErrorsAlwaysPass(false)); got == 0 || got == 200 {
+		t.Errorf("expected errors sampled at rate 0.5, got %d of 200", got)
+	}
+}
+
+func TestSample_WithStratifyBy_KeepsRareKeys(t *testing.T) {
+	type event struct {
+		Region string
+		ID     int
+	}
+
+	// 2000 events from a busy region with 3 from a rare one mixed in
+	var events []event
+	for i := 0; i < 2000; i++ {
+		events = append(events, event{"us-east", i})
+		if i%700 == 350 {
+			events = append(events, event{"ap-south", i})
+		}
+	}
+	countRegion := func(kept []event, region string) int {
+		n := 0
+		for _, e := range kept {
+			if e.Region == region {
+				n++
+			}
+		}
+		return n
+	}
+
+	uniform := sampleValues(NewSample[event](0.01).WithSeed(3), events)
+	if got := countRegion(uniform, "ap-south"); got != 0 {
+		t.Fatalf("expected this seed to lose the rare region under uniform sampling, kept %d", got)
+	}
+
+	stratified := sampleValues(NewSample[event](0.01).WithSeed(3).WithStratifyBy(func(e event) string {
+		return e.Region
+	}), events)
+	if got := countRegion(stratified, "ap-south"); got == 0 {
+		t.Error("expected stratified sampling to keep the rare region")
+	}
+	if got := countRegion(stratified, "us-east"); got < 5 || got > 50 {
+		t.Errorf("expected the busy region still sampled near 1%%, kept %d of 2000", got)
+	}
+}
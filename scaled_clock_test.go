@@ -0,0 +1,159 @@
+package streamz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+// expectTick fails unless ch delivers within a second.
+func expectTick(t *testing.T, ch <-chan time.Time, what string) {
+	t.Helper()
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		t.Fatalf("expected %s to fire", what)
+	}
+}
+
+// expectNoTick fails if ch delivers.
+func expectNoTick(t *testing.T, ch <-chan time.Time, what string) {
+	t.Helper()
+	select {
+	case <-ch:
+		t.Fatalf("expected %s not to fire yet", what)
+	default:
+	}
+}
+
+func TestScaledClock_PanicsOnInvalidScale(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for non-positive scale")
+		}
+	}()
+	NewScaledClock(RealClock, 0)
+}
+
+func TestScaledClock_Now(t *testing.T) {
+	base := clockz.NewFakeClock()
+	start := base.Now()
+	clock := NewScaledClock(base, 10)
+
+	base.Advance(100 * time.Millisecond)
+	if elapsed := clock.Now().Sub(start); elapsed != time.Second {
+		t.Errorf("expected 1s of virtual time, got %v", elapsed)
+	}
+	if since := clock.Since(start); since != time.Second {
+		t.Errorf("expected Since to report 1s, got %v", since)
+	}
+}
+
+func TestScaledClock_BatcherLatency(t *testing.T) {
+	base := clockz.NewFakeClock()
+	clock := NewScaledClock(base, 10)
+	batcher := NewBatcher[int](BatchConfig{MaxSize: 100, MaxLatency: time.Second}, clock)
+
+	in := make(chan Result[int])
+	defer close(in)
+	out := batcher.Process(context.Background(), in)
+
+	in <- NewSuccess(1)
+	waitForTimer(t, base)
+
+	base.Advance(99 * time.Millisecond)
+	base.BlockUntilReady()
+	select {
+	case batch := <-out:
+		t.Fatalf("expected no batch before maxLatency/scale, got %v", batch.Value())
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	base.Advance(time.Millisecond)
+	base.BlockUntilReady()
+	select {
+	case batch := <-out:
+		if len(batch.Value()) != 1 {
+			t.Errorf("expected batch of 1, got %v", batch.Value())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected latency flush after maxLatency/scale")
+	}
+}
+
+func TestScaledClock_TimerAndTickerConsistent(t *testing.T) {
+	base := clockz.NewFakeClock()
+	clock := NewScaledClock(base, 4)
+
+	timer := clock.NewTimer(time.Second)
+	ticker := clock.NewTicker(time.Second)
+	defer ticker.Stop()
+	after := clock.After(time.Second)
+
+	base.Advance(249 * time.Millisecond)
+	base.BlockUntilReady()
+	expectNoTick(t, timer.C(), "timer")
+	expectNoTick(t, ticker.C(), "ticker")
+	expectNoTick(t, after, "After")
+
+	base.Advance(time.Millisecond)
+	base.BlockUntilReady()
+	expectTick(t, timer.C(), "timer")
+	expectTick(t, ticker.C(), "ticker")
+	expectTick(t, after, "After")
+
+	// Reset and the next tick use the same scaling
+	reset := clock.NewTimer(time.Second)
+	reset.Reset(2 * time.Second)
+	base.Advance(250 * time.Millisecond)
+	base.BlockUntilReady()
+	expectTick(t, ticker.C(), "ticker")
+	expectNoTick(t, reset.C(), "reset timer")
+
+	base.Advance(250 * time.Millisecond)
+	base.BlockUntilReady()
+	expectTick(t, reset.C(), "reset timer")
+}
+
+func TestScaledClock_Timeouts(t *testing.T) {
+	base := clockz.NewFakeClock()
+	clock := NewScaledClock(base, 10)
+
+	timeout, cancelTimeout := clock.WithTimeout(context.Background(), time.Second)
+	defer cancelTimeout()
+	deadline, cancelDeadline := clock.WithDeadline(context.Background(), clock.Now().Add(2*time.Second))
+	defer cancelDeadline()
+
+	base.Advance(100 * time.Millisecond)
+	base.BlockUntilReady()
+	select {
+	case <-timeout.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected timeout after 1s of virtual time")
+	}
+	if deadline.Err() != nil {
+		t.Error("expected deadline not reached after 1s of virtual time")
+	}
+
+	base.Advance(100 * time.Millisecond)
+	base.BlockUntilReady()
+	select {
+	case <-deadline.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected deadline after 2s of virtual time")
+	}
+}
+
+func TestScaledClock_ShortDurationsStayPositive(t *testing.T) {
+	// 1ns of virtual time scales below 1ns of real time; a zero ticker period would panic
+	clock := NewScaledClock(RealClock, 1000)
+
+	ticker := clock.NewTicker(time.Nanosecond)
+	defer ticker.Stop()
+	expectTick(t, ticker.C(), "ticker")
+
+	timer := clock.NewTimer(time.Nanosecond)
+	expectTick(t, timer.C(), "timer")
+}
@@ -0,0 +1,125 @@
+package streamz
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// MetadataSchemaViolation flags error Results produced by SchemaGuard.
+const MetadataSchemaViolation = "schema_violation" // bool - item failed schema validation
+
+// SchemaGuard checks each item against a schema or shape and turns invalid items
+// into flagged error Results that keep the original item. Paired with a
+// DeadLetterQueue, it quarantines malformed records while valid ones continue.
+type SchemaGuard[T any] struct {
+	name       string
+	validate   func(T) error
+	violations atomic.Uint64
+}
+
+// NewSchemaGuard creates a processor that enforces a schema on successful items.
+// Valid items are forwarded unchanged. Invalid items become error Results carrying
+// the validation error, the original item, their metadata, and MetadataSchemaViolation
+// set to true. Upstream errors pass through unchanged and are not flagged.
+//
+// When to use:
+//   - Ingesting semi-structured JSON, CSV, or log data
+//   - Quarantining malformed records for later inspection or replay
+//   - Enforcing contracts at the boundary between teams or services
+//
+// Example:
+//
+//	guard := streamz.NewSchemaGuard(func(e Event) error {
+//		if e.ID == "" {
+//			return errors.New("missing id")
+//		}
+//		return nil
+//	})
+//
+//	dlq := streamz.NewDeadLetterQueue[Event](streamz.RealClock)
+//	valid, quarantined := dlq.Process(ctx, guard.Process(ctx, events))
+//
+//	go func() {
+//		for result := range quarantined {
+//			if streamz.IsSchemaViolation(result) {
+//				store.Quarantine(result.Error().Item, result.Error().Err)
+//			}
+//		}
+//	}()
+//
+// Parameters:
+//   - validate: Returns nil for valid items or an error describing the violation
+//
+// Returns a new SchemaGuard processor.
+func NewSchemaGuard[T any](validate func(T) error) *SchemaGuard[T] {
+	return &SchemaGuard[T]{
+		name:     "schema-guard",
+		validate: validate,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "schema-guard".
+func (g *SchemaGuard[T]) WithName(name string) *SchemaGuard[T] {
+	g.name = name
+	return g
+}
+
+// ViolationCount returns the number of items that failed validation.
+// Safe to call concurrently with Process.
+func (g *SchemaGuard[T]) ViolationCount() uint64 {
+	return g.violations.Load()
+}
+
+// Process forwards valid items and converts violations into flagged errors.
+func (g *SchemaGuard[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+
+				if item.IsSuccess() {
+					if err := g.validate(item.Value()); err != nil {
+						g.violations.Add(1)
+						item = Result[T]{
+							err:      NewStreamError(item.Value(), err, g.name),
+							metadata: item.metadata,
+						}
+						item = item.WithMetadata(MetadataSchemaViolation, true)
+					}
+				}
+
+				select {
+				case out <- item:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (g *SchemaGuard[T]) Name() string {
+	return g.name
+}
+
+// IsSchemaViolation reports whether a Result is an error produced by SchemaGuard.
+func IsSchemaViolation[T any](r Result[T]) bool {
+	if !r.IsError() {
+		return false
+	}
+	flagged, _ := r.GetMetadata(MetadataSchemaViolation)
+	return flagged == true
+}
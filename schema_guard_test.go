@@ -0,0 +1,150 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+type ingestRecord struct {
+	ID    string
+	Count int
+}
+
+var (
+	errMissingID     = errors.New("missing id")
+	errNegativeCount = errors.New("negative count")
+)
+
+func validateRecord(r ingestRecord) error {
+	if r.ID == "" {
+		return errMissingID
+	}
+	if r.Count < 0 {
+		return errNegativeCount
+	}
+	return nil
+}
+
+func TestSchemaGuard_Name(t *testing.T) {
+	guard := NewSchemaGuard(validateRecord)
+	if guard.Name() != "schema-guard" {
+		t.Errorf("expected name 'schema-guard', got %q", guard.Name())
+	}
+	if guard.WithName("event-schema").Name() != "event-schema" {
+		t.Errorf("expected name 'event-schema', got %q", guard.Name())
+	}
+}
+
+func TestSchemaGuard_ValidAndInvalid(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[ingestRecord], 4)
+	in <- NewSuccess(ingestRecord{ID: "a", Count: 1})
+	in <- NewSuccess(ingestRecord{Count: 2}).WithMetadata(MetadataSource, "kafka")
+	in <- NewSuccess(ingestRecord{ID: "c", Count: -1})
+	in <- NewError(ingestRecord{ID: "d"}, errors.New("decode failed"), "decoder")
+	close(in)
+
+	guard := NewSchemaGuard(validateRecord)
+	var results []Result[ingestRecord]
+	for result := range guard.Process(ctx, in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(results))
+	}
+
+	if !results[0].IsSuccess() || results[0].Value().ID != "a" || results[0].HasMetadata() {
+		t.Errorf("expected valid item forwarded unchanged, got %v", results[0])
+	}
+
+	missing := results[1]
+	if !IsSchemaViolation(missing) || !errors.Is(missing.Error(), errMissingID) {
+		t.Errorf("expected flagged missing-id violation, got %v", missing.Error())
+	}
+	if missing.Error().Item.Count != 2 || missing.Error().ProcessorName != "schema-guard" {
+		t.Errorf("expected original item preserved, got %+v", missing.Error())
+	}
+	if source, _, _ := missing.GetStringMetadata(MetadataSource); source != "kafka" {
+		t.Errorf("expected metadata preserved, got %q", source)
+	}
+
+	if !IsSchemaViolation(results[2]) || !errors.Is(results[2].Error(), errNegativeCount) {
+		t.Errorf("expected flagged negative-count violation, got %v", results[2].Error())
+	}
+
+	upstream := results[3]
+	if !upstream.IsError() || IsSchemaViolation(upstream) || upstream.Error().ProcessorName != "decoder" {
+		t.Errorf("expected upstream error unchanged and unflagged, got %v", upstream.Error())
+	}
+
+	if guard.ViolationCount() != 2 {
+		t.Errorf("expected 2 violations, got %d", guard.ViolationCount())
+	}
+}
+
+func TestSchemaGuard_WithDeadLetterQueue(t *testing.T) {
+	ctx := context.Background()
+	records := []ingestRecord{
+		{ID: "a", Count: 1},
+		{ID: "", Count: 1},
+		{ID: "b", Count: 2},
+		{ID: "c", Count: -5},
+		{ID: "d", Count: 0},
+	}
+
+	in := make(chan Result[ingestRecord], len(records))
+	for _, r := range records {
+		in <- NewSuccess(r)
+	}
+	close(in)
+
+	guard := NewSchemaGuard(validateRecord)
+	valid, quarantined := NewDeadLetterQueue[ingestRecord](RealClock).Process(ctx, guard.Process(ctx, in))
+
+	var validIDs []string
+	var quarantinedItems []ingestRecord
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for result := range valid {
+			validIDs = append(validIDs, result.Value().ID)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for result := range quarantined {
+			if !IsSchemaViolation(result) {
+				t.Errorf("expected only schema violations in quarantine, got %v", result.Error())
+				continue
+			}
+			quarantinedItems = append(quarantinedItems, result.Error().Item)
+		}
+	}()
+
+	wg.Wait()
+
+	if len(validIDs) != 3 || validIDs[0] != "a" || validIDs[1] != "b" || validIDs[2] != "d" {
+		t.Errorf("expected valid ids [a b d], got %v", validIDs)
+	}
+	if len(quarantinedItems) != 2 || quarantinedItems[0].Count != 1 || quarantinedItems[1].ID != "c" {
+		t.Errorf("expected the two invalid records quarantined, got %+v", quarantinedItems)
+	}
+}
+
+func TestIsSchemaViolation(t *testing.T) {
+	if IsSchemaViolation(NewSuccess(1).WithMetadata(MetadataSchemaViolation, true)) {
+		t.Error("expected success never to be a violation")
+	}
+	if IsSchemaViolation(NewError(1, errors.New("bad"), "test")) {
+		t.Error("expected unflagged error not to be a violation")
+	}
+	if !IsSchemaViolation(NewError(1, errors.New("bad"), "test").WithMetadata(MetadataSchemaViolation, true)) {
+		t.Error("expected flagged error to be a violation")
+	}
+}
@@ -0,0 +1,122 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type profile struct {
+	Version  int
+	Name     string
+	Email    string
+	Verified bool
+}
+
+func profileVersion(p profile) int { return p.Version }
+
+// profileMigrations upgrade version 1 to 2 (adds an email) and 2 to 3 (adds a verified flag).
+func profileMigrations() map[int]func(profile) profile {
+	return map[int]func(profile) profile{
+		1: func(p profile) profile {
+			p.Email = "user@" + p.Name + ".example"
+			p.Version = 2
+			return p
+		},
+		2: func(p profile) profile {
+			p.Verified = p.Email != ""
+			p.Version = 3
+			return p
+		},
+	}
+}
+
+func migrateOne(t *testing.T, migrate *SchemaMigrate[profile], item Result[profile]) Result[profile] {
+	t.Helper()
+	in := make(chan Result[profile], 1)
+	in <- item
+	close(in)
+
+	var results []Result[profile]
+	for result := range migrate.Process(context.Background(), in) {
+		results = append(results, result)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	return results[0]
+}
+
+func TestSchemaMigrate_Name(t *testing.T) {
+	migrate := NewSchemaMigrate(profileMigrations(), profileVersion, 3)
+	if migrate.Name() != "schema-migrate" {
+		t.Errorf("expected name 'schema-migrate', got %q", migrate.Name())
+	}
+	if migrate.WithName("profile-v3").Name() != "profile-v3" {
+		t.Errorf("expected name 'profile-v3', got %q", migrate.Name())
+	}
+}
+
+func TestSchemaMigrate_MigratesThroughEachStep(t *testing.T) {
+	migrate := NewSchemaMigrate(profileMigrations(), profileVersion, 3)
+	result := migrateOne(t, migrate, NewSuccess(profile{Version: 1, Name: "ada"}).WithMetadata(MetadataSource, "import"))
+
+	if result.IsError() {
+		t.Fatalf("unexpected error: %v", result.Error())
+	}
+	want := profile{Version: 3, Name: "ada", Email: "user@ada.example", Verified: true}
+	if result.Value() != want {
+		t.Errorf("expected %+v, got %+v", want, result.Value())
+	}
+	if version, _, _ := result.GetIntMetadata(MetadataSchemaVersion); version != 3 {
+		t.Errorf("expected schema version 3 stamped, got %d", version)
+	}
+	if source, _, _ := result.GetStringMetadata(MetadataSource); source != "import" {
+		t.Errorf("expected metadata preserved, got source %q", source)
+	}
+}
+
+func TestSchemaMigrate_TargetVersionUnchanged(t *testing.T) {
+	migrate := NewSchemaMigrate(profileMigrations(), profileVersion, 3)
+	current := profile{Version: 3, Name: "grace", Email: "grace@example.com"}
+	result := migrateOne(t, migrate, NewSuccess(current))
+
+	if result.IsError() || result.Value() != current {
+		t.Errorf("expected %+v unchanged, got %+v", current, result)
+	}
+	if version, _, _ := result.GetIntMetadata(MetadataSchemaVersion); version != 3 {
+		t.Errorf("expected schema version 3 stamped, got %d", version)
+	}
+}
+
+func TestSchemaMigrate_MissingStepErrors(t *testing.T) {
+	migrations := profileMigrations()
+	delete(migrations, 2)
+	migrate := NewSchemaMigrate(migrations, profileVersion, 3)
+
+	source := profile{Version: 1, Name: "ada"}
+	result := migrateOne(t, migrate, NewSuccess(source))
+	if !result.IsError() || !errors.Is(result.Error(), ErrNoMigrationPath) {
+		t.Fatalf("expected ErrNoMigrationPath, got %+v", result)
+	}
+	// The source item is preserved, not the partially migrated one
+	if result.Error().Item != source || result.Error().ProcessorName != "schema-migrate" {
+		t.Errorf("expected error to carry %+v, got %+v", source, result.Error())
+	}
+}
+
+func TestSchemaMigrate_NewerThanTargetErrors(t *testing.T) {
+	migrate := NewSchemaMigrate(profileMigrations(), profileVersion, 3)
+	result := migrateOne(t, migrate, NewSuccess(profile{Version: 4}))
+	if !result.IsError() || !errors.Is(result.Error(), ErrNoMigrationPath) {
+		t.Errorf("expected ErrNoMigrationPath for a version above target, got %+v", result)
+	}
+}
+
+func TestSchemaMigrate_ErrorsPassThrough(t *testing.T) {
+	migrate := NewSchemaMigrate(profileMigrations(), profileVersion, 3)
+	result := migrateOne(t, migrate, NewError(profile{Version: 1}, errors.New("decode failed"), "decoder"))
+	if !result.IsError() || result.Error().ProcessorName != "decoder" {
+		t.Errorf("expected upstream error unchanged, got %+v", result)
+	}
+}
@@ -0,0 +1,124 @@
+package streamz
+
+import (
+	"context"
+)
+
+// SelectiveFanOut sends each item to a chosen subset of its outputs, sitting
+// between FanOut, which sends every item everywhere, and Switch, which sends
+// each item to exactly one route. It suits multicast rules such as "send to
+// primary and audit, but keep PII items away from analytics".
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type SelectiveFanOut[T any] struct {
+	name     string
+	count    int
+	selectFn func(T) []int
+}
+
+// NewSelectiveFanOut creates a processor with n outputs that sends each item to
+// the outputs whose indices selectFn returns. An empty selection drops the item,
+// and selecting every index broadcasts it. Indices outside [0, n) are ignored and
+// repeated indices deliver only once. Errors are sent to every output.
+//
+// Items are delivered to their selected outputs in index order before the next
+// item is read, so every output must be consumed to avoid blocking.
+//
+// When to use:
+//   - Routing items to several but not all sinks
+//   - Keeping sensitive items out of specific branches
+//   - Subscribing consumers to overlapping subsets of a stream
+//
+// Example:
+//
+//	// 0: primary store, 1: analytics, 2: audit log
+//	fanout := streamz.NewSelectiveFanOut(3, func(e Event) []int {
+//		if e.ContainsPII {
+//			return []int{0, 2}
+//		}
+//		return []int{0, 1, 2}
+//	})
+//
+//	outputs := fanout.Process(ctx, events)
+//	go store(outputs[0])
+//	go analyze(outputs[1])
+//	go audit(outputs[2])
+//
+// Parameters:
+//   - n: Number of output channels to create
+//   - selectFn: Returns the indices of the outputs an item is sent to
+//
+// Returns a new SelectiveFanOut processor.
+func NewSelectiveFanOut[T any](n int, selectFn func(T) []int) *SelectiveFanOut[T] {
+	return &SelectiveFanOut[T]{
+		name:     "selective-fanout",
+		count:    n,
+		selectFn: selectFn,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "selective-fanout".
+func (f *SelectiveFanOut[T]) WithName(name string) *SelectiveFanOut[T] {
+	f.name = name
+	return f
+}
+
+// Process sends each item to its selected outputs.
+// All output channels are closed when the input closes or the context is canceled.
+func (f *SelectiveFanOut[T]) Process(ctx context.Context, in <-chan Result[T]) []<-chan Result[T] {
+	outs := make([]<-chan Result[T], f.count)
+	channels := make([]chan Result[T], f.count)
+	for i := range channels {
+		channels[i] = make(chan Result[T])
+		outs[i] = channels[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, ch := range channels {
+				close(ch)
+			}
+		}()
+
+		selected := make([]bool, f.count)
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsError() {
+				for i := range selected {
+					selected[i] = true
+				}
+			} else {
+				clear(selected)
+				for _, i := range f.selectFn(item.Value()) {
+					if i >= 0 && i < f.count {
+						selected[i] = true
+					}
+				}
+			}
+
+			for i, ch := range channels {
+				if !selected[i] {
+					continue
+				}
+				select {
+				case ch <- item:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return outs
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (f *SelectiveFanOut[T]) Name() string {
+	return f.name
+}
@@ -0,0 +1,142 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// selections maps test values to the outputs they select.
+var selections = map[int][]int{
+	1: {0, 2},
+	2: {},
+	3: {0, 1, 2},
+	4: {-1, 1, 7, 1},
+}
+
+func selectOutputs(v int) []int { return selections[v] }
+
+// runSelectiveFanOut sends items through a 3-way SelectiveFanOut and collects each output until it closes.
+func runSelectiveFanOut(t *testing.T, items ...Result[int]) [][]Result[int] {
+	t.Helper()
+	in := make(chan Result[int], len(items))
+	for _, item := range items {
+		in <- item
+	}
+	close(in)
+
+	outputs := NewSelectiveFanOut(3, selectOutputs).Process(context.Background(), in)
+	if len(outputs) != 3 {
+		t.Fatalf("expected 3 outputs, got %d", len(outputs))
+	}
+
+	var wg sync.WaitGroup
+	results := make([][]Result[int], len(outputs))
+	for i, out := range outputs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = collectResults(out, time.Second)
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+func outputValues(results []Result[int]) []int {
+	values := []int{}
+	for _, r := range results {
+		if r.IsSuccess() {
+			values = append(values, r.Value())
+		}
+	}
+	return values
+}
+
+func TestSelectiveFanOut_Name(t *testing.T) {
+	fanout := NewSelectiveFanOut(3, selectOutputs)
+	if fanout.Name() != "selective-fanout" {
+		t.Errorf("expected name 'selective-fanout', got %q", fanout.Name())
+	}
+	if fanout.WithName("multicast").Name() != "multicast" {
+		t.Errorf("expected name 'multicast', got %q", fanout.Name())
+	}
+}
+
+func TestSelectiveFanOut_SendsToSelectedOutputs(t *testing.T) {
+	results := runSelectiveFanOut(t, NewSuccess(1), NewSuccess(3))
+
+	want := [][]int{{1, 3}, {3}, {1, 3}}
+	for i := range want {
+		if got := outputValues(results[i]); !slices.Equal(got, want[i]) {
+			t.Errorf("output %d: expected %v, got %v", i, want[i], got)
+		}
+	}
+}
+
+func TestSelectiveFanOut_EmptySelectionDrops(t *testing.T) {
+	results := runSelectiveFanOut(t, NewSuccess(2))
+	for i, out := range results {
+		if len(out) != 0 {
+			t.Errorf("output %d: expected nothing, got %v", i, outputValues(out))
+		}
+	}
+}
+
+func TestSelectiveFanOut_IgnoresInvalidAndRepeatedIndices(t *testing.T) {
+	results := runSelectiveFanOut(t, NewSuccess(4))
+
+	want := [][]int{{}, {4}, {}}
+	for i := range want {
+		if got := outputValues(results[i]); !slices.Equal(got, want[i]) {
+			t.Errorf("output %d: expected %v, got %v", i, want[i], got)
+		}
+	}
+}
+
+func TestSelectiveFanOut_ErrorsBroadcast(t *testing.T) {
+	results := runSelectiveFanOut(t, NewError(2, errors.New("failed"), "mapper"))
+	for i, out := range results {
+		if len(out) != 1 || !out[0].IsError() {
+			t.Errorf("output %d: expected the error, got %+v", i, out)
+		}
+	}
+}
+
+func TestSelectiveFanOut_ClosesOutputsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[int])
+	outputs := NewSelectiveFanOut(3, selectOutputs).Process(ctx, in)
+	cancel()
+
+	for i, out := range outputs {
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Errorf("output %d: expected closed", i)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("output %d: timeout waiting for close", i)
+		}
+	}
+}
+
+func TestSelectiveFanOut_ClosesOutputsOnInputClose(t *testing.T) {
+	in := make(chan Result[int])
+	outputs := NewSelectiveFanOut(3, selectOutputs).Process(context.Background(), in)
+	close(in)
+
+	for i, out := range outputs {
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Errorf("output %d: expected closed", i)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("output %d: timeout waiting for close", i)
+		}
+	}
+}
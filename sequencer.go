@@ -0,0 +1,108 @@
+package streamz
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// MetadataSequence records the position of an item in the stream as stamped by a Sequencer.
+const MetadataSequence = "sequence" // uint64 - arrival order, gap-free from the start sequence
+
+// Sequencer stamps each Result with a monotonically increasing sequence number
+// in arrival order. Placed before a stage that loses ordering, such as a
+// Partition whose shards are processed in parallel and merged by a FanIn, it lets a Reorder configured with
+// WithSequenceMetadata restore the original order afterwards.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Sequencer[T any] struct {
+	name string
+	next atomic.Uint64
+}
+
+// NewSequencer creates a processor that stamps MetadataSequence on every Result,
+// errors included, so each position in the stream is accounted for downstream.
+// Sequences start at 0 unless configured with WithStartSequence, and overwrite
+// any sequence already present.
+//
+// The sequence reflects the order in which items are received from the input
+// channel. When several goroutines send to that channel, their relative order is
+// whatever the channel delivers, but numbers are still unique and gap-free. The
+// counter is shared by every call to Process, so two concurrent Process calls
+// interleave their numbering; use one Sequencer per stream.
+//
+// When to use:
+//   - Restoring order after parallel processing, together with Reorder
+//   - Detecting dropped items by looking for gaps downstream
+//   - Giving items a stable position for logging and replay
+//
+// Example:
+//
+//	sequenced := streamz.NewSequencer[Event]().Process(ctx, events)
+//	partition, _ := streamz.NewRoundRobinPartition[Event](8, 100)
+//	shards := partition.Process(ctx, sequenced)
+//	for i := range shards {
+//		shards[i] = enrich.Process(ctx, shards[i])
+//	}
+//	merged := streamz.NewFanIn[Event]().Process(ctx, shards...)
+//
+//	ordered := streamz.NewReorder[Event](nil, 1000, streamz.RealClock).
+//		WithSequenceMetadata().
+//		Process(ctx, merged)
+//
+// Returns a new Sequencer processor.
+func NewSequencer[T any]() *Sequencer[T] {
+	return &Sequencer[T]{
+		name: "sequencer",
+	}
+}
+
+// WithStartSequence sets the first sequence number stamped.
+// Must be called before Process. If not set, defaults to 0.
+func (s *Sequencer[T]) WithStartSequence(seq uint64) *Sequencer[T] {
+	s.next.Store(seq)
+	return s
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "sequencer".
+func (s *Sequencer[T]) WithName(name string) *Sequencer[T] {
+	s.name = name
+	return s
+}
+
+// Next returns the sequence number the next item will receive.
+// Safe to call concurrently with Process.
+func (s *Sequencer[T]) Next() uint64 {
+	return s.next.Load()
+}
+
+// Process stamps each Result with the next sequence number and forwards it.
+func (s *Sequencer[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			item = item.WithMetadata(MetadataSequence, s.next.Add(1)-1)
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (s *Sequencer[T]) Name() string {
+	return s.name
+}
@@ -0,0 +1,137 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+// sequenceNumber reads MetadataSequence from a Result, failing the test if absent.
+func sequenceNumber[T any](t *testing.T, r Result[T]) uint64 {
+	t.Helper()
+	raw, exists := r.GetMetadata(MetadataSequence)
+	seq, ok := raw.(uint64)
+	if !exists || !ok {
+		t.Fatalf("expected uint64 sequence metadata, got %v", raw)
+	}
+	return seq
+}
+
+func TestSequencer_Name(t *testing.T) {
+	sequencer := NewSequencer[int]()
+	if sequencer.Name() != "sequencer" {
+		t.Errorf("expected name 'sequencer', got %q", sequencer.Name())
+	}
+	if sequencer.WithName("stamp").Name() != "stamp" {
+		t.Errorf("expected name 'stamp', got %q", sequencer.Name())
+	}
+}
+
+func TestSequencer_StampsGapFreeSequence(t *testing.T) {
+	in := make(chan Result[int], 5)
+	in <- NewSuccess(10)
+	in <- NewError(0, errors.New("bad"), "upstream")
+	in <- NewSuccess(20).WithMetadata(MetadataSequence, uint64(99))
+	in <- NewSuccess(30)
+	in <- NewSuccess(40)
+	close(in)
+
+	sequencer := NewSequencer[int]().WithStartSequence(5)
+	results := collectResults(sequencer.Process(context.Background(), in), time.Second)
+	if len(results) != 5 {
+		t.Fatalf("expected 5 results, got %d", len(results))
+	}
+
+	// Errors are stamped too, and stale sequences are overwritten
+	for i, result := range results {
+		if got := sequenceNumber(t, result); got != uint64(5+i) {
+			t.Errorf("position %d: expected sequence %d, got %d", i, 5+i, got)
+		}
+	}
+	if sequencer.Next() != 10 {
+		t.Errorf("expected next sequence 10, got %d", sequencer.Next())
+	}
+}
+
+func TestSequencer_ConcurrentProducers(t *testing.T) {
+	const producers, perProducer = 4, 250
+
+	in := make(chan Result[int])
+	out := NewSequencer[int]().Process(context.Background(), in)
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				in <- NewSuccess(i)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(in)
+	}()
+
+	var want uint64
+	for result := range out {
+		if got := sequenceNumber(t, result); got != want {
+			t.Fatalf("expected sequence %d, got %d", want, got)
+		}
+		want++
+	}
+	if want != producers*perProducer {
+		t.Errorf("expected %d items, got %d", producers*perProducer, want)
+	}
+}
+
+func TestSequencer_ReorderRestoresOrder(t *testing.T) {
+	ctx := context.Background()
+	const count = 200
+
+	in := make(chan Result[int], count)
+	for i := 0; i < count; i++ {
+		if i%17 == 0 {
+			in <- NewError(i, errors.New("bad"), "upstream")
+			continue
+		}
+		in <- NewSuccess(i)
+	}
+	close(in)
+
+	// Shuffle the sequenced stream to simulate unordered parallel processing
+	sequenced := collectResults(NewSequencer[int]().Process(ctx, in), time.Second)
+	rng := rand.New(rand.NewSource(1)) // #nosec G404 -- deterministic shuffle
+	rng.Shuffle(len(sequenced), func(i, j int) {
+		sequenced[i], sequenced[j] = sequenced[j], sequenced[i]
+	})
+	shuffled := make(chan Result[int], count)
+	for _, result := range sequenced {
+		shuffled <- result
+	}
+	close(shuffled)
+
+	reorder := NewReorder[int](nil, count, clockz.NewFakeClock()).WithSequenceMetadata()
+	results := collectResults(reorder.Process(ctx, shuffled), time.Second)
+	if len(results) != count {
+		t.Fatalf("expected %d results, got %d", count, len(results))
+	}
+	for i, result := range results {
+		if got := sequenceNumber(t, result); got != uint64(i) {
+			t.Fatalf("position %d: expected sequence %d, got %d", i, i, got)
+		}
+		if i%17 == 0 {
+			if !result.IsError() {
+				t.Errorf("position %d: expected error kept in place, got %v", i, result)
+			}
+		} else if result.Value() != i {
+			t.Errorf("position %d: expected value %d, got %v", i, i, result)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package streamz
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Serialize applies a function to one item at a time, in arrival order, for side
+// effects that must never run concurrently, such as writes to a sink that is not
+// safe for concurrent use. It is the opposite of AsyncMapper: maximum safety and
+// no parallelism.
+//
+// Calls are serialized across every Process call on the same Serialize, so one
+// instance can guard a shared sink even when several upstream pipelines feed it.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Serialize[T any] struct {
+	name string
+	fn   func(context.Context, T) (T, error)
+	mu   sync.Mutex
+}
+
+// NewSerialize creates a processor that runs fn strictly one call at a time.
+// Results are emitted in arrival order and keep the metadata of their input.
+// A failed or panicking call becomes an error Result carrying the original item.
+// Upstream errors pass through unchanged without calling fn.
+//
+// When to use:
+//   - Writing to files, connections, or clients that are not goroutine-safe
+//   - Applying ordered side effects after a parallel stage
+//   - Guarding a shared resource used by several pipelines
+//
+// Example:
+//
+//	// Parallel enrichment, then strictly serial writes to a single file
+//	write := streamz.NewSerialize(func(ctx context.Context, r Record) (Record, error) {
+//		return r, encoder.Encode(r)
+//	})
+//
+//	written := write.Process(ctx, enricher.Process(ctx, records))
+//
+// Parameters:
+//   - fn: Function applied to each successful item, never concurrently
+//
+// Returns a new Serialize processor.
+func NewSerialize[T any](fn func(context.Context, T) (T, error)) *Serialize[T] {
+	return &Serialize[T]{
+		name: "serialize",
+		fn:   fn,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "serialize".
+func (s *Serialize[T]) WithName(name string) *Serialize[T] {
+	s.name = name
+	return s
+}
+
+// Process applies fn to each successful item in order, one call at a time.
+func (s *Serialize[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsSuccess() {
+				value, err := s.call(ctx, item.Value())
+				if err != nil {
+					item = Result[T]{err: NewStreamError(item.Value(), err, s.name), metadata: item.metadata}
+				} else {
+					item = Result[T]{value: value, metadata: item.metadata}
+				}
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// call runs fn under the instance lock, converting a panic into an error.
+func (s *Serialize[T]) call(ctx context.Context, value T) (result T, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("serialize panic: %v", r)
+		}
+	}()
+	return s.fn(ctx, value)
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (s *Serialize[T]) Name() string {
+	return s.name
+}
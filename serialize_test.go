@@ -0,0 +1,140 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// entryTracker records the maximum number of concurrent callers.
+type entryTracker struct {
+	active  atomic.Int32
+	maxSeen atomic.Int32
+}
+
+func (e *entryTracker) enter() {
+	n := e.active.Add(1)
+	for {
+		seen := e.maxSeen.Load()
+		if n <= seen || e.maxSeen.CompareAndSwap(seen, n) {
+			return
+		}
+	}
+}
+
+func (e *entryTracker) exit() { e.active.Add(-1) }
+
+func TestSerialize_Name(t *testing.T) {
+	serialize := NewSerialize(func(_ context.Context, n int) (int, error) { return n, nil })
+	if serialize.Name() != "serialize" {
+		t.Errorf("expected name 'serialize', got %q", serialize.Name())
+	}
+	if serialize.WithName("file-writer").Name() != "file-writer" {
+		t.Errorf("expected name 'file-writer', got %q", serialize.Name())
+	}
+}
+
+func TestSerialize_NeverConcurrentAndOrdered(t *testing.T) {
+	ctx := context.Background()
+	tracker := &entryTracker{}
+	var calls []int
+
+	serialize := NewSerialize(func(_ context.Context, n int) (int, error) {
+		tracker.enter()
+		defer tracker.exit()
+		calls = append(calls, n)
+		time.Sleep(50 * time.Microsecond)
+		return n * 10, nil
+	})
+
+	// Parallel upstream: several producers feeding separate Process calls
+	const producers, perProducer = 4, 50
+	var wg sync.WaitGroup
+	outputs := make([][]int, producers)
+	for p := 0; p < producers; p++ {
+		in := make(chan Result[int])
+		out := serialize.Process(ctx, in)
+		wg.Add(2)
+		go func(base int) {
+			defer wg.Done()
+			defer close(in)
+			for i := 0; i < perProducer; i++ {
+				in <- NewSuccess(base + i)
+			}
+		}(p * 1000)
+		go func(p int) {
+			defer wg.Done()
+			for result := range out {
+				outputs[p] = append(outputs[p], result.Value())
+			}
+		}(p)
+	}
+	wg.Wait()
+
+	if peak := tracker.maxSeen.Load(); peak != 1 {
+		t.Errorf("expected fn never entered concurrently, saw %d concurrent calls", peak)
+	}
+	if len(calls) != producers*perProducer {
+		t.Errorf("expected %d calls, got %d", producers*perProducer, len(calls))
+	}
+	for p, values := range outputs {
+		for i, v := range values {
+			if expected := (p*1000 + i) * 10; v != expected {
+				t.Fatalf("producer %d: expected %d at %d, got %d", p, expected, i, v)
+			}
+		}
+	}
+}
+
+func TestSerialize_ErrorsAndPanics(t *testing.T) {
+	errRejected := errors.New("rejected")
+	serialize := NewSerialize(func(_ context.Context, n int) (int, error) {
+		switch n {
+		case 2:
+			return 0, errRejected
+		case 3:
+			panic("sink exploded")
+		}
+		return n, nil
+	})
+
+	in := make(chan Result[int], 5)
+	in <- NewSuccess(1)
+	in <- NewSuccess(2).WithMetadata(MetadataSource, "api")
+	in <- NewSuccess(3)
+	in <- NewError(4, errors.New("upstream"), "parser")
+	in <- NewSuccess(5)
+	close(in)
+
+	var results []Result[int]
+	for result := range serialize.Process(context.Background(), in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 5 {
+		t.Fatalf("expected 5 results, got %d", len(results))
+	}
+	if results[0].Value() != 1 || results[4].Value() != 5 {
+		t.Errorf("expected successes around the failures, got %v and %v", results[0], results[4])
+	}
+
+	failed := results[1]
+	if !failed.IsError() || !errors.Is(failed.Error(), errRejected) || failed.Error().Item != 2 {
+		t.Errorf("expected rejection error carrying item 2, got %v", failed)
+	}
+	if source, _, _ := failed.GetStringMetadata(MetadataSource); source != "api" {
+		t.Errorf("expected metadata preserved on error, got %q", source)
+	}
+
+	panicked := results[2]
+	if !panicked.IsError() || panicked.Error().Item != 3 || panicked.Error().ProcessorName != "serialize" {
+		t.Errorf("expected panic surfaced as error for item 3, got %v", panicked)
+	}
+
+	if !results[3].IsError() || results[3].Error().ProcessorName != "parser" {
+		t.Errorf("expected upstream error passed through, got %v", results[3])
+	}
+}
@@ -0,0 +1,112 @@
+package streamz
+
+import (
+	"context"
+)
+
+// SessionDedupe removes duplicate items within a session and forgets every key
+// when a new session begins, modeling per-session idempotency: a key may occur
+// once per session, such as one login step per login flow.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type SessionDedupe[T any] struct {
+	name           string
+	keyFn          func(T) string
+	isSessionStart func(T) bool
+	dropMarkers    bool
+}
+
+// NewSessionDedupe creates a processor that deduplicates items until the next session marker.
+// An item for which isSessionStart reports true clears the set of seen keys and
+// is forwarded without being deduplicated itself; every other item is forwarded
+// only if its key has not been seen since the last marker. Keys seen before the
+// first marker belong to an implicit initial session.
+// Errors are passed through unchanged and never affect the seen keys.
+//
+// When to use:
+//   - Suppressing repeated steps within a login or checkout flow
+//   - Deduplicating events per connection, reset on reconnect
+//   - Idempotent processing scoped to a batch delimited by markers
+//
+// Example:
+//
+//	// One event per step per login flow
+//	dedupe := streamz.NewSessionDedupe(
+//		func(e AuthEvent) string { return e.Step },
+//		func(e AuthEvent) bool { return e.Step == "login_started" },
+//	)
+//
+//	steps := dedupe.Process(ctx, authEvents)
+//
+// Parameters:
+//   - keyFn: Extracts the deduplication key from an item
+//   - isSessionStart: Reports whether an item marks the start of a new session
+//
+// Returns a new SessionDedupe processor.
+func NewSessionDedupe[T any](keyFn func(T) string, isSessionStart func(T) bool) *SessionDedupe[T] {
+	return &SessionDedupe[T]{
+		name:           "session-dedupe",
+		keyFn:          keyFn,
+		isSessionStart: isSessionStart,
+	}
+}
+
+// WithDropMarkers consumes session-start markers instead of forwarding them.
+// Markers still reset the seen keys. If not set, markers are forwarded.
+func (d *SessionDedupe[T]) WithDropMarkers() *SessionDedupe[T] {
+	d.dropMarkers = true
+	return d
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "session-dedupe".
+func (d *SessionDedupe[T]) WithName(name string) *SessionDedupe[T] {
+	d.name = name
+	return d
+}
+
+// Process forwards the first occurrence of each key in each session.
+func (d *SessionDedupe[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		seen := make(map[string]struct{})
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsSuccess() {
+				if d.isSessionStart(item.Value()) {
+					clear(seen)
+					if d.dropMarkers {
+						continue
+					}
+				} else {
+					key := d.keyFn(item.Value())
+					if _, dup := seen[key]; dup {
+						continue
+					}
+					seen[key] = struct{}{}
+				}
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (d *SessionDedupe[T]) Name() string {
+	return d.name
+}
@@ -0,0 +1,88 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func isSessionMarker(s string) bool { return s == "START" }
+
+// collectSessionDedupe runs items through a SessionDedupe and returns the forwarded values.
+func collectSessionDedupe(dedupe *SessionDedupe[string], items ...Result[string]) []Result[string] {
+	in := make(chan Result[string], len(items))
+	for _, item := range items {
+		in <- item
+	}
+	close(in)
+
+	var results []Result[string]
+	for result := range dedupe.Process(context.Background(), in) {
+		results = append(results, result)
+	}
+	return results
+}
+
+func resultValues(results []Result[string]) []string {
+	values := make([]string, len(results))
+	for i, r := range results {
+		values[i] = r.Value()
+	}
+	return values
+}
+
+func TestSessionDedupe_Name(t *testing.T) {
+	dedupe := NewSessionDedupe(identityKey, isSessionMarker)
+	if dedupe.Name() != "session-dedupe" {
+		t.Errorf("expected name 'session-dedupe', got %q", dedupe.Name())
+	}
+	if dedupe.WithName("login-dedupe").Name() != "login-dedupe" {
+		t.Errorf("expected name 'login-dedupe', got %q", dedupe.Name())
+	}
+}
+
+func TestSessionDedupe_SuppressesDuplicatesWithinSession(t *testing.T) {
+	results := collectSessionDedupe(NewSessionDedupe(identityKey, isSessionMarker),
+		NewSuccess("START"), NewSuccess("a"), NewSuccess("b"), NewSuccess("a"), NewSuccess("b"), NewSuccess("c"))
+
+	got := resultValues(results)
+	want := []string{"START", "a", "b", "c"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestSessionDedupe_MarkerResetsSeenKeys(t *testing.T) {
+	results := collectSessionDedupe(NewSessionDedupe(identityKey, isSessionMarker),
+		NewSuccess("a"), NewSuccess("a"), NewSuccess("START"), NewSuccess("a"), NewSuccess("a"), NewSuccess("START"), NewSuccess("a"))
+
+	got := resultValues(results)
+	want := []string{"a", "START", "a", "START", "a"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestSessionDedupe_DropMarkers(t *testing.T) {
+	results := collectSessionDedupe(NewSessionDedupe(identityKey, isSessionMarker).WithDropMarkers(),
+		NewSuccess("START"), NewSuccess("a"), NewSuccess("a"), NewSuccess("START"), NewSuccess("a"))
+
+	got := resultValues(results)
+	if len(got) != 2 || got[0] != "a" || got[1] != "a" {
+		t.Errorf("expected [a a] with markers dropped, got %v", got)
+	}
+}
+
+func TestSessionDedupe_ErrorsPassThrough(t *testing.T) {
+	failure := errors.New("decode failed")
+	results := collectSessionDedupe(NewSessionDedupe(identityKey, isSessionMarker),
+		NewSuccess("a"), NewError("a", failure, "decoder"), NewError("a", failure, "decoder"), NewSuccess("a"))
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if results[0].IsError() || !results[1].IsError() || !results[2].IsError() {
+		t.Errorf("expected one success followed by both errors, got %+v", results)
+	}
+}
@@ -0,0 +1,149 @@
+package streamz
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Spacer guarantees a minimum gap between consecutive emissions by delaying
+// items rather than dropping them. Items that arrive faster than the gap allows
+// wait in an internal backlog and are released one per gap, in arrival order,
+// for downstreams that reject closely spaced calls.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Spacer[T any] struct {
+	name    string
+	minGap  time.Duration
+	clock   Clock
+	backlog atomic.Int64
+}
+
+// NewSpacer creates a processor that emits successful items at least minGap apart.
+// The gap is measured from the moment the previous item was handed downstream,
+// so a slow consumer never causes two items to be emitted back to back. The
+// first item, and any item arriving after a quiet period, is emitted at once.
+//
+// Unlike Throttle, nothing is dropped; the backlog is unbounded and grows for
+// as long as input outpaces one item per gap. Errors are not spaced: they pass
+// through as soon as they arrive, ahead of any backlog. When the input closes,
+// the backlog is still released at the configured spacing before the output
+// closes; cancellation discards it.
+//
+// When to use:
+//   - Calling APIs that reject requests sent too close together
+//   - Pacing writes to hardware or legacy systems with fixed cycle times
+//   - Smoothing bursty producers into an evenly spaced stream
+//
+// Example:
+//
+//	// At most one SMS every 250ms, whatever the burst size
+//	spacer := streamz.NewSpacer[Message](250*time.Millisecond, streamz.RealClock)
+//	paced := spacer.Process(ctx, messages)
+//
+// Parameters:
+//   - minGap: Minimum time between consecutive emissions
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new Spacer processor.
+func NewSpacer[T any](minGap time.Duration, clock Clock) *Spacer[T] {
+	return &Spacer[T]{
+		name:   "spacer",
+		minGap: minGap,
+		clock:  clock,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "spacer".
+func (s *Spacer[T]) WithName(name string) *Spacer[T] {
+	s.name = name
+	return s
+}
+
+// Backlog returns the number of items waiting to be released.
+// Safe to call concurrently with Process.
+func (s *Spacer[T]) Backlog() int {
+	return int(s.backlog.Load())
+}
+
+// Process releases successful items no closer together than the minimum gap.
+func (s *Spacer[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+		defer s.backlog.Store(0)
+
+		var queue []Result[T]
+		var lastEmit time.Time
+		emitted := false
+		var timer Timer
+		var timerC <-chan time.Time
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+
+		for {
+			if in == nil && len(queue) == 0 {
+				return
+			}
+
+			// Offer the head of the backlog only once its gap has elapsed
+			var sendC chan<- Result[T]
+			var head Result[T]
+			if len(queue) > 0 && timer == nil {
+				if wait := s.minGap - s.clock.Since(lastEmit); !emitted || wait <= 0 {
+					sendC = out
+					head = queue[0]
+				} else {
+					timer = s.clock.NewTimer(wait)
+					timerC = timer.C()
+				}
+			}
+
+			select {
+			case item, ok := <-in:
+				if !ok {
+					in = nil
+					continue
+				}
+
+				if item.IsError() {
+					select {
+					case out <- item:
+					case <-ctx.Done():
+						return
+					}
+					continue
+				}
+
+				queue = append(queue, item)
+				s.backlog.Add(1)
+
+			case sendC <- head:
+				queue[0] = Result[T]{}
+				queue = queue[1:]
+				s.backlog.Add(-1)
+				lastEmit = s.clock.Now()
+				emitted = true
+
+			case <-timerC:
+				timer = nil
+				timerC = nil
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (s *Spacer[T]) Name() string {
+	return s.name
+}
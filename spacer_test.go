@@ -0,0 +1,139 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+// releaseNext advances the clock through one gap and returns the item released.
+func releaseNext(t *testing.T, clock *clockz.FakeClock, out <-chan Result[int], gap time.Duration) Result[int] {
+	t.Helper()
+	waitForTimer(t, clock)
+	clock.Advance(gap - time.Millisecond)
+	clock.BlockUntilReady()
+	expectNoItem(t, out)
+
+	clock.Advance(time.Millisecond)
+	clock.BlockUntilReady()
+	select {
+	case result := <-out:
+		return result
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for spaced item")
+		return Result[int]{}
+	}
+}
+
+func TestSpacer_Name(t *testing.T) {
+	spacer := NewSpacer[int](time.Second, RealClock)
+	if spacer.Name() != "spacer" {
+		t.Errorf("expected name 'spacer', got %q", spacer.Name())
+	}
+	if spacer.WithName("sms-pacer").Name() != "sms-pacer" {
+		t.Errorf("expected name 'sms-pacer', got %q", spacer.Name())
+	}
+}
+
+func TestSpacer_ReleasesBurstOnePerGap(t *testing.T) {
+	const gap = 100 * time.Millisecond
+	clock := clockz.NewFakeClock()
+	spacer := NewSpacer[int](gap, clock)
+
+	in := make(chan Result[int], 5)
+	for i := 0; i < 4; i++ {
+		in <- NewSuccess(i)
+	}
+	out := spacer.Process(context.Background(), in)
+
+	// The first item goes out at once
+	if values := receiveN(t, out, 1); values[0] != 0 {
+		t.Fatalf("expected 0 first, got %v", values)
+	}
+
+	for want := 1; want < 4; want++ {
+		if result := releaseNext(t, clock, out, gap); result.Value() != want {
+			t.Fatalf("expected %d released in order, got %v", want, result)
+		}
+	}
+
+	// After a quiet period the next item is not delayed
+	clock.Advance(time.Second)
+	in <- NewSuccess(4)
+	if values := receiveN(t, out, 1); values[0] != 4 {
+		t.Errorf("expected 4 immediately after quiet period, got %v", values)
+	}
+	close(in)
+	if _, ok := <-out; ok {
+		t.Error("expected output closed")
+	}
+	if spacer.Backlog() != 0 {
+		t.Errorf("expected empty backlog, got %d", spacer.Backlog())
+	}
+}
+
+func TestSpacer_ErrorsSkipBacklogAndCloseDrains(t *testing.T) {
+	const gap = 100 * time.Millisecond
+	clock := clockz.NewFakeClock()
+	spacer := NewSpacer[int](gap, clock)
+
+	in := make(chan Result[int])
+	out := spacer.Process(context.Background(), in)
+
+	in <- NewSuccess(1)
+	receiveN(t, out, 1)
+	in <- NewSuccess(2)
+	in <- NewSuccess(3)
+
+	// Errors overtake the spaced backlog
+	in <- NewError(0, errors.New("bad"), "upstream")
+	if result := <-out; !result.IsError() {
+		t.Fatalf("expected error passed through first, got %v", result)
+	}
+	if spacer.Backlog() != 2 {
+		t.Errorf("expected backlog of 2, got %d", spacer.Backlog())
+	}
+
+	// Closing the input still releases the backlog at the configured spacing
+	close(in)
+	if result := releaseNext(t, clock, out, gap); result.Value() != 2 {
+		t.Errorf("expected 2, got %v", result)
+	}
+	if result := releaseNext(t, clock, out, gap); result.Value() != 3 {
+		t.Errorf("expected 3, got %v", result)
+	}
+	if _, ok := <-out; ok {
+		t.Error("expected output closed after backlog drained")
+	}
+}
+
+func TestSpacer_CancellationDropsBacklog(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	spacer := NewSpacer[int](time.Second, clock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[int])
+	out := spacer.Process(ctx, in)
+
+	in <- NewSuccess(1)
+	receiveN(t, out, 1)
+	in <- NewSuccess(2)
+	in <- NewSuccess(3)
+	waitForTimer(t, clock)
+
+	cancel()
+	select {
+	case result, ok := <-out:
+		if ok {
+			t.Errorf("expected backlog dropped on cancellation, got %v", result)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected output closed after cancellation")
+	}
+	if spacer.Backlog() != 0 {
+		t.Errorf("expected backlog cleared, got %d", spacer.Backlog())
+	}
+}
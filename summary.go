@@ -0,0 +1,113 @@
+package streamz
+
+import (
+	"context"
+)
+
+// MetadataCompletionStatus records how the stream behind a Summary ended.
+const MetadataCompletionStatus = "completion_status" // string - CompletionStatusCompleted or CompletionStatusCanceled
+
+// Summary forwards a stream unchanged while folding every Result into an
+// accumulator, then reports the accumulated value once the stream ends. The
+// summary travels on its own channel, keeping pipeline reporting out of the item
+// stream.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Summary[T, S any] struct {
+	name   string
+	reduce func(acc S, item Result[T]) S
+}
+
+// NewSummary creates a processor that summarizes a stream with reduce.
+// Every Result, success or error, is passed to reduce, starting from the zero
+// value of S, before it is forwarded. When processing ends, exactly one success
+// Result carrying the summary is sent on the summary channel, tagged with
+// MetadataCompletionStatus: CompletionStatusCompleted when the input closed, or
+// CompletionStatusCanceled when the context was canceled first, in which case
+// the summary covers only the items seen so far.
+//
+// When to use:
+//   - Reporting totals, error counts, and ranges at the end of a batch job
+//   - Producing run statistics for logs or audit records
+//   - Checking data-quality expectations once a file has been processed
+//
+// Example:
+//
+//	type Stats struct {
+//		Count, Errors int
+//		MaxAmount     float64
+//	}
+//
+//	summary := streamz.NewSummary(func(s Stats, r streamz.Result[Order]) Stats {
+//		s.Count++
+//		if r.IsError() {
+//			s.Errors++
+//		} else {
+//			s.MaxAmount = max(s.MaxAmount, r.Value().Amount)
+//		}
+//		return s
+//	})
+//
+//	orders, stats := summary.Process(ctx, orders)
+//	for order := range orders {
+//		...
+//	}
+//	report := <-stats
+//	log.Printf("processed %d orders, %d failed", report.Value().Count, report.Value().Errors)
+//
+// Parameters:
+//   - reduce: Folds one Result into the accumulated summary
+//
+// Returns a new Summary processor.
+func NewSummary[T, S any](reduce func(acc S, item Result[T]) S) *Summary[T, S] {
+	return &Summary[T, S]{
+		name:   "summary",
+		reduce: reduce,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "summary".
+func (s *Summary[T, S]) WithName(name string) *Summary[T, S] {
+	s.name = name
+	return s
+}
+
+// Process forwards every item and returns the item channel and the summary channel.
+// The summary channel is buffered, so the summary never blocks on being read, and
+// it closes after the summary is sent. The item channel closes before the summary is sent.
+func (s *Summary[T, S]) Process(ctx context.Context, in <-chan Result[T]) (<-chan Result[T], <-chan Result[S]) {
+	out := make(chan Result[T])
+	summary := make(chan Result[S], 1)
+
+	go func() {
+		var acc S
+		defer func() {
+			summary <- NewSuccess(acc).WithMetadata(MetadataCompletionStatus, completionStatus(ctx))
+			close(summary)
+		}()
+		defer close(out)
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			acc = s.reduce(acc, item)
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, summary
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (s *Summary[T, S]) Name() string {
+	return s.name
+}
@@ -0,0 +1,113 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type runStats struct {
+	Count, Errors int
+	Min, Max      int
+}
+
+func reduceRunStats(s runStats, r Result[int]) runStats {
+	s.Count++
+	if r.IsError() {
+		s.Errors++
+		return s
+	}
+	if s.Count-s.Errors == 1 || r.Value() < s.Min {
+		s.Min = r.Value()
+	}
+	if s.Count-s.Errors == 1 || r.Value() > s.Max {
+		s.Max = r.Value()
+	}
+	return s
+}
+
+func TestSummary_Name(t *testing.T) {
+	summary := NewSummary(reduceRunStats)
+	if summary.Name() != "summary" {
+		t.Errorf("expected name 'summary', got %q", summary.Name())
+	}
+	if summary.WithName("run-report").Name() != "run-report" {
+		t.Errorf("expected name 'run-report', got %q", summary.Name())
+	}
+}
+
+func TestSummary_MixedStream(t *testing.T) {
+	in := make(chan Result[int], 5)
+	in <- NewSuccess(7)
+	in <- NewSuccess(-3)
+	in <- NewError(0, errors.New("bad"), "parser")
+	in <- NewSuccess(12)
+	in <- NewSuccess(4)
+	close(in)
+
+	out, stats := NewSummary(reduceRunStats).Process(context.Background(), in)
+
+	var forwarded []Result[int]
+	for result := range out {
+		forwarded = append(forwarded, result)
+	}
+	if len(forwarded) != 5 || forwarded[0].Value() != 7 || !forwarded[2].IsError() {
+		t.Errorf("expected all items forwarded unchanged, got %v", forwarded)
+	}
+
+	result := <-stats
+	want := runStats{Count: 5, Errors: 1, Min: -3, Max: 12}
+	if result.Value() != want {
+		t.Errorf("expected summary %+v, got %+v", want, result.Value())
+	}
+	if status, _, _ := result.GetStringMetadata(MetadataCompletionStatus); status != CompletionStatusCompleted {
+		t.Errorf("expected status %q, got %q", CompletionStatusCompleted, status)
+	}
+	if _, ok := <-stats; ok {
+		t.Error("expected summary channel closed after one summary")
+	}
+}
+
+func TestSummary_EmptyStream(t *testing.T) {
+	in := make(chan Result[int])
+	close(in)
+
+	out, stats := NewSummary(reduceRunStats).Process(context.Background(), in)
+	for range out {
+		t.Error("expected no items")
+	}
+
+	if result := <-stats; result.Value() != (runStats{}) {
+		t.Errorf("expected zero summary, got %+v", result.Value())
+	}
+}
+
+func TestSummary_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[int])
+
+	out, stats := NewSummary(reduceRunStats).Process(ctx, in)
+	in <- NewSuccess(1)
+	<-out
+	in <- NewSuccess(2)
+	<-out
+	cancel()
+
+	select {
+	case result := <-stats:
+		want := runStats{Count: 2, Min: 1, Max: 2}
+		if result.Value() != want {
+			t.Errorf("expected partial summary %+v, got %+v", want, result.Value())
+		}
+		if status, _, _ := result.GetStringMetadata(MetadataCompletionStatus); status != CompletionStatusCanceled {
+			t.Errorf("expected status %q, got %q", CompletionStatusCanceled, status)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected partial summary after cancellation")
+	}
+
+	if _, ok := <-out; ok {
+		t.Error("expected item channel closed after cancellation")
+	}
+}
@@ -10,14 +10,30 @@ import (
 // Switch routes Result[T] to multiple output channels based on predicate evaluation.
 // Errors bypass predicate evaluation and go directly to the error channel.
 // Successful values are evaluated by the predicate to determine routing.
+//
+// Route removal is safe during active processing. RemoveRoute stops new items
+// from reaching the route, lets items already buffered in the route channel
+// drain to its consumer, and then closes the channel. An item blocked mid-send
+// to the removed route is re-routed as if the route had never existed: it goes
+// to the default route when one is configured, otherwise it is dropped.
 type Switch[T any, K comparable] struct {
-	predicate  func(T) K            // Evaluates successful values only (8 bytes pointer)
-	routes     map[K]chan Result[T] // Route key to output channel mapping (8 bytes pointer)
-	errorChan  chan Result[T]       // Dedicated error channel (8 bytes pointer)
-	defaultKey *K                   // Optional default route for unknown keys (8 bytes pointer)
-	name       string               // 16 bytes (pointer + len)
-	mu         sync.RWMutex         // 24 bytes
-	bufferSize int                  // 8 bytes (aligned)
+	predicate  func(T) K             // Evaluates successful values only (8 bytes pointer)
+	routes     map[K]*switchRoute[T] // Route key to output route mapping (8 bytes pointer)
+	errorChan  chan Result[T]        // Dedicated error channel (8 bytes pointer)
+	defaultKey *K                    // Optional default route for unknown keys (8 bytes pointer)
+	name       string                // 16 bytes (pointer + len)
+	mu         sync.RWMutex          // 24 bytes
+	bufferSize int                   // 8 bytes (aligned)
+	closed     bool                  // Set once Process has closed all route channels
+}
+
+// switchRoute pairs a route channel with the signals needed to remove it safely.
+// The removed channel is closed by RemoveRoute to abort in-flight sends, and
+// inflight tracks senders so the output channel is only closed once they finish.
+type switchRoute[T any] struct {
+	ch       chan Result[T]
+	removed  chan struct{}
+	inflight sync.WaitGroup
 }
 
 // SwitchConfig configures Switch behavior.
@@ -31,7 +47,7 @@ func NewSwitch[T any, K comparable](predicate func(T) K, config SwitchConfig[K])
 	return &Switch[T, K]{
 		name:       "switch",
 		predicate:  predicate,
-		routes:     make(map[K]chan Result[T]),
+		routes:     make(map[K]*switchRoute[T]),
 		errorChan:  make(chan Result[T], config.BufferSize),
 		defaultKey: config.DefaultKey,
 		bufferSize: config.BufferSize,
@@ -53,10 +69,11 @@ func (s *Switch[T, K]) Process(ctx context.Context, in <-chan Result[T]) (routes
 	go func() {
 		defer func() {
 			s.mu.Lock()
-			for _, ch := range s.routes {
-				close(ch)
+			for _, route := range s.routes {
+				close(route.ch)
 			}
 			close(s.errorChan)
+			s.closed = true
 			s.mu.Unlock()
 		}()
 
@@ -116,15 +133,20 @@ func (s *Switch[T, K]) routeResult(ctx context.Context, result Result[T]) {
 }
 
 // routeToChannel handles routing to specific channels with proper error handling.
+// The in-flight counter is registered while the read lock is held so RemoveRoute
+// cannot close the channel underneath an active send.
 func (s *Switch[T, K]) routeToChannel(ctx context.Context, key K, result Result[T]) {
 	s.mu.RLock()
-	ch, exists := s.routes[key]
+	route, exists := s.routes[key]
+	if exists {
+		route.inflight.Add(1)
+	}
 	s.mu.RUnlock()
 
 	// Complete route-not-found behavior
 	if !exists {
-		if s.defaultKey != nil {
-			// Recursive call to handle default route (which must exist)
+		if s.defaultKey != nil && *s.defaultKey != key {
+			// Recursive call to handle default route
 			s.routeToChannel(ctx, *s.defaultKey, result)
 			return
 		}
@@ -138,13 +160,28 @@ func (s *Switch[T, K]) routeToChannel(ctx context.Context, key K, result Result[
 		WithMetadata(MetadataProcessor, "switch").
 		WithMetadata(MetadataTimestamp, time.Now())
 
-	// Send with context cancellation support
+	// A route removed since the lookup must not take new items, even with
+	// buffer space left, so check removal before offering the send
+	select {
+	case <-route.removed:
+		route.inflight.Done()
+		s.routeToChannel(ctx, key, result)
+		return
+	default:
+	}
+
+	// Send with context cancellation and route removal support
 	select {
-	case ch <- enhanced:
+	case route.ch <- enhanced:
 		// Successfully routed
+		route.inflight.Done()
+	case <-route.removed:
+		// Route removed mid-send - re-route as an unknown key
+		route.inflight.Done()
+		s.routeToChannel(ctx, key, result)
 	case <-ctx.Done():
 		// Context canceled, stop processing
-		return
+		route.inflight.Done()
 	}
 }
 
@@ -166,9 +203,9 @@ func (s *Switch[T, K]) sendToErrorChannel(ctx context.Context, result Result[T])
 // getOrCreateRoute handles lazy channel creation with proper locking.
 func (s *Switch[T, K]) getOrCreateRoute(key K) chan Result[T] {
 	s.mu.RLock()
-	if ch, exists := s.routes[key]; exists {
+	if route, exists := s.routes[key]; exists {
 		s.mu.RUnlock()
-		return ch
+		return route.ch
 	}
 	s.mu.RUnlock()
 
@@ -176,14 +213,17 @@ func (s *Switch[T, K]) getOrCreateRoute(key K) chan Result[T] {
 	defer s.mu.Unlock()
 
 	// Double-check after acquiring write lock
-	if ch, exists := s.routes[key]; exists {
-		return ch
+	if route, exists := s.routes[key]; exists {
+		return route.ch
 	}
 
 	// Create new channel with configured buffer size
-	ch := make(chan Result[T], s.bufferSize)
-	s.routes[key] = ch
-	return ch
+	route := &switchRoute[T]{
+		ch:      make(chan Result[T], s.bufferSize),
+		removed: make(chan struct{}),
+	}
+	s.routes[key] = route
+	return route.ch
 }
 
 // AddRoute explicitly creates a route for the given key.
@@ -193,17 +233,32 @@ func (s *Switch[T, K]) AddRoute(key K) <-chan Result[T] {
 }
 
 // RemoveRoute removes a route and closes its channel.
+// It is safe to call while Process is routing items. No new items are sent to
+// the route once it is removed; items already buffered in the channel remain
+// readable until drained, after which the consumer observes the close. An item
+// still waiting for buffer space is re-routed to the default route, or dropped
+// when no default route is configured. Only an item whose send completes at the
+// same instant as the removal may land on either route; it is delivered to
+// exactly one of them.
 func (s *Switch[T, K]) RemoveRoute(key K) bool {
 	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	ch, exists := s.routes[key]
+	route, exists := s.routes[key]
 	if !exists {
+		s.mu.Unlock()
 		return false
 	}
-
 	delete(s.routes, key)
-	close(ch)
+	if s.closed {
+		// Process already closed the route channel during shutdown
+		s.mu.Unlock()
+		return true
+	}
+	close(route.removed)
+	s.mu.Unlock()
+
+	// Wait for in-flight sends to observe removal before closing the channel
+	route.inflight.Wait()
+	close(route.ch)
 	return true
 }
 
@@ -539,6 +539,200 @@ func TestSwitch_RouteManagement(t *testing.T) {
 	}
 }
 
+func TestSwitch_RemoveRouteDuringProcessing(t *testing.T) {
+	predicate := func(order Order) int {
+		return order.Priority
+	}
+
+	defaultKey := 0
+	sw := NewSwitch(predicate, SwitchConfig[int]{
+		BufferSize: 3,
+		DefaultKey: &defaultKey,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	input := make(chan Result[Order])
+	_, _ = sw.Process(ctx, input)
+
+	ch1 := sw.AddRoute(1)
+	defaultCh := sw.AddRoute(defaultKey)
+
+	// Fill the route buffer, then send one more item that blocks mid-send
+	for i := 0; i < 3; i++ {
+		input <- NewSuccess(Order{ID: fmt.Sprintf("buffered-%d", i), Priority: 1})
+	}
+	input <- NewSuccess(Order{ID: "in-flight", Priority: 1})
+
+	if !sw.RemoveRoute(1) {
+		t.Fatal("RemoveRoute should return true for existing route")
+	}
+
+	// Buffered items flush to the original consumer before the close
+	for i := 0; i < 3; i++ {
+		select {
+		case result, ok := <-ch1:
+			if !ok {
+				t.Fatalf("Channel closed before buffered item %d was drained", i)
+			}
+			expected := fmt.Sprintf("buffered-%d", i)
+			if result.Value().ID != expected {
+				t.Errorf("Expected %s, got %s", expected, result.Value().ID)
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatalf("Timeout waiting for buffered item %d", i)
+		}
+	}
+
+	select {
+	case _, ok := <-ch1:
+		if ok {
+			t.Error("Removed route should be closed after buffered items drain")
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("Removed route should be closed after buffered items drain")
+	}
+
+	// The in-flight item is re-routed to the default route
+	select {
+	case result := <-defaultCh:
+		if result.Value().ID != "in-flight" {
+			t.Errorf("Expected in-flight item on default route, got %s", result.Value().ID)
+		}
+		if route, _ := result.GetMetadata("route"); route != defaultKey {
+			t.Errorf("Expected route metadata %d, got %v", defaultKey, route)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("Timeout waiting for in-flight item on default route")
+	}
+
+	close(input)
+}
+
+func TestSwitch_RemoveRouteConcurrentRouting(t *testing.T) {
+	predicate := func(order Order) int {
+		return order.Priority
+	}
+
+	defaultKey := 0
+	sw := NewSwitch(predicate, SwitchConfig[int]{
+		BufferSize: 2,
+		DefaultKey: &defaultKey,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	input := make(chan Result[Order])
+	_, _ = sw.Process(ctx, input)
+
+	ch1 := sw.AddRoute(1)
+	defaultCh := sw.AddRoute(defaultKey)
+
+	const total = 500
+	var received sync.Map
+	var wg sync.WaitGroup
+
+	consume := func(ch <-chan Result[Order]) {
+		defer wg.Done()
+		for result := range ch {
+			if _, dup := received.LoadOrStore(result.Value().ID, true); dup {
+				t.Errorf("Item %s delivered more than once", result.Value().ID)
+			}
+		}
+	}
+
+	wg.Add(2)
+	go consume(ch1)
+	go consume(defaultCh)
+
+	go func() {
+		defer close(input)
+		for i := 0; i < total; i++ {
+			if i == total/2 {
+				go sw.RemoveRoute(1)
+			}
+			input <- NewSuccess(Order{ID: fmt.Sprintf("order-%d", i), Priority: 1})
+		}
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timeout waiting for routes to close")
+	}
+
+	count := 0
+	received.Range(func(_, _ any) bool {
+		count++
+		return true
+	})
+	if count != total {
+		t.Errorf("Expected every item delivered exactly once (%d), got %d", total, count)
+	}
+}
+
+func TestSwitch_RemoveRouteRacingSendDeliversOnce(t *testing.T) {
+	predicate := func(order Order) int {
+		return order.Priority
+	}
+
+	for i := 0; i < 100; i++ {
+		defaultKey := 0
+		sw := NewSwitch(predicate, SwitchConfig[int]{
+			BufferSize: 1,
+			DefaultKey: &defaultKey,
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		input := make(chan Result[Order])
+		_, _ = sw.Process(ctx, input)
+
+		ch1 := sw.AddRoute(1)
+		defaultCh := sw.AddRoute(defaultKey)
+
+		// The route has buffer space, so the item lands on whichever side of
+		// the removal it is routed, but never on both or neither
+		removed := make(chan struct{})
+		go func() {
+			defer close(removed)
+			sw.RemoveRoute(1)
+		}()
+		input <- NewSuccess(Order{ID: "racing", Priority: 1})
+		<-removed
+
+		got := 0
+		for result := range ch1 {
+			if result.Value().ID == "racing" {
+				got++
+			}
+		}
+		// Items are routed in order, so the marker follows any re-routed item
+		input <- NewSuccess(Order{ID: "marker", Priority: defaultKey})
+		for result := range defaultCh {
+			if result.Value().ID == "marker" {
+				break
+			}
+			if result.Value().ID == "racing" {
+				got++
+			}
+		}
+		if got != 1 {
+			t.Fatalf("iteration %d: expected item delivered exactly once, got %d", i, got)
+		}
+
+		close(input)
+		cancel()
+	}
+}
+
 func TestSwitch_BackpressureIsolation(t *testing.T) {
 	predicate := func(order Order) int {
 		return order.Priority
@@ -95,11 +95,10 @@ func (t *Tap[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result
 	go func() {
 		defer close(out)
 
-		for item := range in {
-			select {
-			case <-ctx.Done():
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
 				return
-			default:
 			}
 
 			// Execute side effect function with panic recovery
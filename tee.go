@@ -0,0 +1,180 @@
+package streamz
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// Tee copies every Result to a side writer, such as a debug log file, while
+// forwarding it unchanged. Writes happen on a separate goroutine behind a bounded
+// queue, so a slow writer never slows the main stream; when the queue is full,
+// the copy is dropped and counted instead.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Tee[T any] struct {
+	name        string
+	w           io.Writer
+	encode      func(Result[T]) []byte
+	bufferSize  int
+	mu          sync.Mutex
+	done        chan struct{}
+	started     bool
+	dropped     atomic.Uint64
+	writeErrors atomic.Uint64
+}
+
+// NewTee creates a processor that writes encode(result) to w for every Result,
+// success or error, and forwards the Result unchanged. Encodings that are empty
+// are not written. Writers with a Flush method, like bufio.Writer, are flushed
+// once the queue has drained after the input closes.
+//
+// Side writes are best effort: copies that do not fit in the queue are dropped
+// (see DroppedCount), and failed writes are counted (see WriteErrorCount) rather
+// than reported on the stream.
+//
+// When to use:
+//   - Capturing a production stream to a debug file without affecting it
+//   - Mirroring traffic to a socket for inspection
+//   - Recording inputs of a flaky stage for later replay
+//
+// Example:
+//
+//	file, _ := os.Create("debug.jsonl")
+//	tee := streamz.NewTee(bufio.NewWriter(file), func(r streamz.Result[Order]) []byte {
+//		data, _ := json.Marshal(r.Value())
+//		return append(data, '\n')
+//	}).WithBufferSize(4096)
+//
+//	orders = tee.Process(ctx, orders)
+//	...
+//	<-tee.Done()
+//	file.Close()
+//
+// Parameters:
+//   - w: Side writer receiving the encoded copies
+//   - encode: Converts a Result to the bytes written for it
+//
+// Returns a new Tee processor.
+func NewTee[T any](w io.Writer, encode func(Result[T]) []byte) *Tee[T] {
+	return &Tee[T]{
+		name:       "tee",
+		w:          w,
+		encode:     encode,
+		bufferSize: 1024,
+		done:       make(chan struct{}),
+	}
+}
+
+// WithBufferSize sets how many encoded copies may wait for the writer before
+// further copies are dropped. Values below 1 are treated as 1.
+// If not set, defaults to 1024.
+func (t *Tee[T]) WithBufferSize(size int) *Tee[T] {
+	t.bufferSize = max(size, 1)
+	return t
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "tee".
+func (t *Tee[T]) WithName(name string) *Tee[T] {
+	t.name = name
+	return t
+}
+
+// DroppedCount returns the number of copies dropped because the writer fell behind.
+// Safe to call concurrently with Process.
+func (t *Tee[T]) DroppedCount() uint64 {
+	return t.dropped.Load()
+}
+
+// WriteErrorCount returns the number of side writes, including the final flush, that failed.
+// Safe to call concurrently with Process.
+func (t *Tee[T]) WriteErrorCount() uint64 {
+	return t.writeErrors.Load()
+}
+
+// Done returns a channel that closes once the side writer has finished: after
+// the queue drains and the writer is flushed when the input closes, or after the
+// write in progress when the context is canceled. A writer that blocks forever
+// keeps it open. Each Process call has its own writer; Done reports on the most
+// recent one, so call it after Process when a Tee is reused.
+// Safe to call concurrently with Process.
+func (t *Tee[T]) Done() <-chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.done
+}
+
+// Process forwards every Result and queues its encoded copy for the side writer.
+func (t *Tee[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+	queue := make(chan []byte, t.bufferSize)
+
+	// The first call uses the channel made by NewTee so Done works before Process
+	t.mu.Lock()
+	if t.started {
+		t.done = make(chan struct{})
+	}
+	t.started = true
+	done := t.done
+	t.mu.Unlock()
+
+	go t.write(ctx, queue, done)
+
+	go func() {
+		defer close(out)
+		defer close(queue)
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if data := t.encode(item); len(data) > 0 {
+				select {
+				case queue <- data:
+				default:
+					t.dropped.Add(1)
+				}
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// write drains the queue into the writer until it closes or the context is
+// canceled, then closes done.
+func (t *Tee[T]) write(ctx context.Context, queue <-chan []byte, done chan struct{}) {
+	defer close(done)
+
+	for {
+		select {
+		case data, ok := <-queue:
+			if !ok {
+				if f, canFlush := t.w.(flusher); canFlush && f.Flush() != nil {
+					t.writeErrors.Add(1)
+				}
+				return
+			}
+			if _, err := t.w.Write(data); err != nil {
+				t.writeErrors.Add(1)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (t *Tee[T]) Name() string {
+	return t.name
+}
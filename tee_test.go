@@ -0,0 +1,156 @@
+package streamz
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func encodeResultLine(r Result[int]) []byte {
+	if r.IsError() {
+		return []byte("error: " + r.Error().Err.Error() + "\n")
+	}
+	return []byte(strconv.Itoa(r.Value()) + "\n")
+}
+
+// blockingWriter holds every write until released.
+type blockingWriter struct {
+	release chan struct{}
+	buf     syncBuffer
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	<-w.release
+	return w.buf.Write(p)
+}
+
+// waitDone waits for the tee's side writer to finish.
+func waitDone(t *testing.T, tee *Tee[int]) {
+	t.Helper()
+	select {
+	case <-tee.Done():
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for side writer")
+	}
+}
+
+func TestTee_Name(t *testing.T) {
+	tee := NewTee(&bytes.Buffer{}, encodeResultLine)
+	if tee.Name() != "tee" {
+		t.Errorf("expected name 'tee', got %q", tee.Name())
+	}
+	if tee.WithName("debug-log").Name() != "debug-log" {
+		t.Errorf("expected name 'debug-log', got %q", tee.Name())
+	}
+}
+
+func TestTee_WritesCopiesAndForwardsUnchanged(t *testing.T) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	tee := NewTee(w, encodeResultLine)
+
+	in := make(chan Result[int], 4)
+	in <- NewSuccess(1).WithMetadata(MetadataSource, "api")
+	in <- NewError(2, errors.New("bad"), "upstream")
+	in <- NewSuccess(3)
+	in <- NewSuccess(4)
+	close(in)
+
+	results := collectResults(tee.Process(context.Background(), in), time.Second)
+	if len(results) != 4 {
+		t.Fatalf("expected 4 forwarded results, got %d", len(results))
+	}
+	if results[0].Value() != 1 || !results[1].IsError() || results[2].Value() != 3 || results[3].Value() != 4 {
+		t.Errorf("expected stream unchanged, got %v", results)
+	}
+	if source, _, _ := results[0].GetStringMetadata(MetadataSource); source != "api" {
+		t.Errorf("expected metadata preserved, got %q", source)
+	}
+
+	// The buffered writer is flushed once the side writer finishes
+	waitDone(t, tee)
+	if got, want := buf.String(), "1\nerror: bad\n3\n4\n"; got != want {
+		t.Errorf("expected side output %q, got %q", want, got)
+	}
+	if tee.DroppedCount() != 0 || tee.WriteErrorCount() != 0 {
+		t.Errorf("expected no drops or write errors, got %d and %d", tee.DroppedCount(), tee.WriteErrorCount())
+	}
+}
+
+func TestTee_DropsWhenWriterFallsBehind(t *testing.T) {
+	const count = 10
+	w := &blockingWriter{release: make(chan struct{})}
+	tee := NewTee[int](w, encodeResultLine).WithBufferSize(1)
+
+	in := make(chan Result[int])
+	out := tee.Process(context.Background(), in)
+
+	// The main stream keeps flowing while the writer is stuck
+	for i := 0; i < count; i++ {
+		in <- NewSuccess(i)
+		select {
+		case result := <-out:
+			if result.Value() != i {
+				t.Fatalf("expected %d forwarded, got %v", i, result)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("main stream stalled behind a blocked writer")
+		}
+	}
+	close(in)
+
+	// At most one copy is being written and one is queued
+	if dropped := tee.DroppedCount(); dropped < count-2 {
+		t.Errorf("expected at least %d drops, got %d", count-2, dropped)
+	}
+
+	close(w.release)
+	waitDone(t, tee)
+
+	lines := strings.Count(w.buf.String(), "\n")
+	if uint64(lines)+tee.DroppedCount() != count {
+		t.Errorf("expected written %d + dropped %d to equal %d", lines, tee.DroppedCount(), count)
+	}
+}
+
+func TestTee_CountsWriteErrors(t *testing.T) {
+	tee := NewTee[int](failingWriter{}, encodeResultLine)
+
+	in := make(chan Result[int], 2)
+	in <- NewSuccess(1)
+	in <- NewSuccess(2)
+	close(in)
+
+	if results := collectResults(tee.Process(context.Background(), in), time.Second); len(results) != 2 {
+		t.Fatalf("expected 2 forwarded results, got %d", len(results))
+	}
+	waitDone(t, tee)
+	if tee.WriteErrorCount() != 2 {
+		t.Errorf("expected 2 write errors, got %d", tee.WriteErrorCount())
+	}
+}
+
+func TestTee_ProcessTwice(t *testing.T) {
+	var buf syncBuffer
+	tee := NewTee[int](&buf, encodeResultLine)
+
+	for round := 1; round <= 2; round++ {
+		in := make(chan Result[int], 1)
+		in <- NewSuccess(round)
+		close(in)
+
+		if results := collectResults(tee.Process(context.Background(), in), time.Second); len(results) != 1 {
+			t.Fatalf("round %d: expected 1 forwarded result, got %d", round, len(results))
+		}
+		waitDone(t, tee)
+	}
+
+	if got := buf.String(); got != "1\n2\n" {
+		t.Errorf("expected both runs written, got %q", got)
+	}
+}
@@ -2,12 +2,35 @@
 package testing
 
 import (
+	"context"
+	"runtime"
 	"testing"
 	"time"
 
+	"github.com/zoobzio/clockz"
 	streamz "github.com/zoobzio/streamz"
 )
 
+const (
+	// maxIdleSteps bounds how many consecutive clock steps RunUntilIdle takes
+	// without the processor emitting, so a processor that never closes its
+	// output fails the test instead of hanging it.
+	maxIdleSteps = 1000
+
+	// timerPoll is how often RunUntilIdle re-checks the fake clock for timers
+	// armed while it waits on the output. It only paces the wait; it never
+	// decides that the processor is idle.
+	timerPoll = time.Millisecond
+
+	// stallTimeout is how long RunUntilIdle waits with no pending timers and no
+	// output before reporting that the output never closed.
+	stallTimeout = time.Second
+
+	// leakTimeout is how long AssertNoLeakOnContextCancel waits for goroutines
+	// to exit after cancellation before reporting a leak.
+	leakTimeout = time.Second
+)
+
 // CollectResultsWithTimeout collects all results from a channel with a timeout.
 // This is a shared utility function for integration tests to avoid duplication.
 func CollectResultsWithTimeout[T any](t *testing.T, ch <-chan streamz.Result[T], timeout time.Duration) []streamz.Result[T] {
@@ -103,3 +126,108 @@ func AssertAllErrors[T any](t *testing.T, results []streamz.Result[T]) {
 		}
 	}
 }
+
+// RunUntilIdle drives a fake clock forward in fixed steps until a processor's output closes,
+// returning everything it emitted. Whenever the processor has a pending timer, the
+// clock is advanced by step and pending timer deliveries are completed; otherwise
+// RunUntilIdle waits for the processor to emit, close, or arm a new timer. Idleness
+// is therefore decided by the output closing, never by wall-clock time: the caller
+// must close the processor's input once it has sent everything, and the processor
+// then runs its timers to completion and closes.
+//
+// The test fails if the output is still open after many steps without output, or
+// if it stays open with no pending timers, which usually means the input was not
+// closed.
+//
+// This replaces ad hoc real-time sleeps between clock.Advance calls:
+//
+//	out := spacer.Process(ctx, in)
+//	in <- streamz.NewSuccess(1)
+//	in <- streamz.NewSuccess(2)
+//	close(in)
+//	results := RunUntilIdle(t, clock, out, 10*time.Millisecond)
+func RunUntilIdle[T any](t testing.TB, clock *clockz.FakeClock, out <-chan streamz.Result[T], step time.Duration) []streamz.Result[T] {
+	t.Helper()
+
+	var results []streamz.Result[T]
+	poll := time.NewTicker(timerPoll)
+	defer poll.Stop()
+	stalled := time.Now().Add(stallTimeout)
+
+	idleSteps := 0
+	for {
+		// Take anything already emitted before moving time
+		select {
+		case result, ok := <-out:
+			if !ok {
+				return results
+			}
+			results = append(results, result)
+			idleSteps = 0
+			stalled = time.Now().Add(stallTimeout)
+			continue
+		default:
+		}
+
+		if clock.HasWaiters() {
+			if idleSteps == maxIdleSteps {
+				t.Errorf("output still open after %d clock steps without output", maxIdleSteps)
+				return results
+			}
+			clock.Advance(step)
+			clock.BlockUntilReady()
+			idleSteps++
+			stalled = time.Now().Add(stallTimeout)
+			continue
+		}
+
+		select {
+		case result, ok := <-out:
+			if !ok {
+				return results
+			}
+			results = append(results, result)
+			idleSteps = 0
+			stalled = time.Now().Add(stallTimeout)
+		case <-poll.C:
+			if time.Now().After(stalled) {
+				t.Errorf("output did not close and no timers are pending; close the processor's input before RunUntilIdle")
+				return results
+			}
+		}
+	}
+}
+
+// AssertNoLeakOnContextCancel verifies that a processor's goroutines exit when
+// its context is canceled, even though its input channel is never closed.
+// start must launch the processor with the given context and an input that stays
+// open; it should not consume the outputs. After cancellation the goroutine count
+// must return to its level from before start was called.
+//
+//	AssertNoLeakOnContextCancel(t, func(ctx context.Context) {
+//		in := make(chan streamz.Result[int])
+//		streamz.NewBuffer[int](10).Process(ctx, in)
+//	})
+func AssertNoLeakOnContextCancel(t testing.TB, start func(ctx context.Context)) {
+	t.Helper()
+
+	baseline := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	start(ctx)
+	cancel()
+
+	deadline := time.Now().Add(leakTimeout)
+	for {
+		running := runtime.NumGoroutine()
+		if running <= baseline {
+			return
+		}
+		if time.Now().After(deadline) {
+			buf := make([]byte, 1<<16)
+			n := runtime.Stack(buf, true)
+			t.Errorf("goroutines leaked after context cancellation: %d running, baseline %d\n%s", running, baseline, buf[:n])
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -1,10 +1,12 @@
 package testing
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
 
+	"github.com/zoobzio/clockz"
 	streamz "github.com/zoobzio/streamz"
 )
 
@@ -216,3 +218,161 @@ func TestAssertAllErrors(t *testing.T) {
 		}
 	})
 }
+
+func TestRunUntilIdle(t *testing.T) {
+	t.Run("drives batcher to close", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		clock := clockz.NewFakeClock()
+		batcher := streamz.NewBatcher[string](streamz.BatchConfig{
+			MaxSize:    10,
+			MaxLatency: 100 * time.Millisecond,
+		}, clock)
+
+		in := make(chan streamz.Result[string])
+		out := batcher.Process(ctx, in)
+
+		in <- streamz.NewSuccess("a")
+		in <- streamz.NewSuccess("b")
+		in <- streamz.NewSuccess("c")
+		in <- streamz.NewError("bad", errors.New("test error"), "test")
+		close(in)
+
+		results := RunUntilIdle(t, clock, out, 10*time.Millisecond)
+
+		if len(results) != 2 {
+			t.Fatalf("expected error and one batch, got %d results", len(results))
+		}
+		if !results[0].IsError() {
+			t.Errorf("expected pass-through error first, got %v", results[0])
+		}
+		batch := results[1].Value()
+		if len(batch) != 3 || batch[0] != "a" || batch[1] != "b" || batch[2] != "c" {
+			t.Errorf("expected batch [a b c], got %v", batch)
+		}
+		if clock.HasWaiters() {
+			t.Error("expected no pending timers once closed")
+		}
+	})
+
+	t.Run("drives debounce to close", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		clock := clockz.NewFakeClock()
+		debounce := streamz.NewDebounce[string](200*time.Millisecond, clock)
+
+		in := make(chan streamz.Result[string])
+		out := debounce.Process(ctx, in)
+
+		in <- streamz.NewSuccess("rapid1")
+		in <- streamz.NewSuccess("rapid2")
+		in <- streamz.NewSuccess("rapid3")
+		in <- streamz.NewError("error1", errors.New("validation error"), "validator")
+		close(in)
+
+		results := RunUntilIdle(t, clock, out, 50*time.Millisecond)
+
+		if len(results) != 2 {
+			t.Fatalf("expected error and one debounced value, got %d results", len(results))
+		}
+		if !results[0].IsError() {
+			t.Errorf("expected error to pass through first, got %v", results[0])
+		}
+		if results[1].IsError() || results[1].Value() != "rapid3" {
+			t.Errorf("expected debounced value rapid3, got %v", results[1])
+		}
+	})
+
+	t.Run("runs timers after input closes", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+		clock := clockz.NewFakeClockAt(start)
+		spacer := streamz.NewSpacer[int](time.Second, clock)
+
+		in := make(chan streamz.Result[int], 5)
+		for i := 1; i <= 5; i++ {
+			in <- streamz.NewSuccess(i)
+		}
+		close(in)
+
+		// The spacer releases its backlog one gap apart before closing
+		results := RunUntilIdle(t, clock, spacer.Process(ctx, in), 100*time.Millisecond)
+
+		if len(results) != 5 {
+			t.Fatalf("expected 5 results, got %d", len(results))
+		}
+		for i, result := range results {
+			if result.Value() != i+1 {
+				t.Errorf("result %d: expected %d, got %d", i, i+1, result.Value())
+			}
+		}
+		if elapsed := clock.Since(start); elapsed != 4*time.Second {
+			t.Errorf("expected clock driven exactly through 4 gaps, got %v", elapsed)
+		}
+	})
+
+	t.Run("returns when output closes", func(t *testing.T) {
+		clock := clockz.NewFakeClock()
+		ch := make(chan streamz.Result[int], 2)
+		ch <- streamz.NewSuccess(1)
+		ch <- streamz.NewSuccess(2)
+		close(ch)
+
+		results := RunUntilIdle(t, clock, ch, time.Second)
+
+		if len(results) != 2 {
+			t.Errorf("expected 2 results, got %d", len(results))
+		}
+	})
+
+	t.Run("reports output left open", func(t *testing.T) {
+		clock := clockz.NewFakeClock()
+		ch := make(chan streamz.Result[int])
+
+		rec := &recordingTB{TB: t}
+		RunUntilIdle(rec, clock, ch, time.Second)
+
+		if !rec.failed {
+			t.Error("expected open output to be reported")
+		}
+	})
+}
+
+// recordingTB captures failures reported by a helper under test.
+type recordingTB struct {
+	testing.TB
+	failed bool
+}
+
+func (*recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(string, ...any) {
+	r.failed = true
+}
+
+func TestAssertNoLeakOnContextCancel(t *testing.T) {
+	t.Run("passes when goroutines exit", func(t *testing.T) {
+		AssertNoLeakOnContextCancel(t, func(ctx context.Context) {
+			in := make(chan streamz.Result[int])
+			streamz.NewBuffer[int](10).Process(ctx, in)
+		})
+	})
+
+	t.Run("reports goroutines that ignore cancellation", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		rec := &recordingTB{TB: t}
+		AssertNoLeakOnContextCancel(rec, func(context.Context) {
+			go func() { <-release }()
+		})
+
+		if !rec.failed {
+			t.Error("expected leak to be reported")
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package integration
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+	"github.com/zoobzio/streamz"
+	helpers "github.com/zoobzio/streamz/testing"
+)
+
+// TestProcessors_NoLeakOnContextCancel verifies that processors stop when their
+// context is canceled even though the producer never closes the input channel.
+// Each processor is started without consumers, both idle on an empty input and
+// with an item queued so that it may be blocked on output when canceled.
+func TestProcessors_NoLeakOnContextCancel(t *testing.T) {
+	tests := []struct {
+		name  string
+		start func(ctx context.Context, in chan streamz.Result[int])
+	}{
+		{"Buffer", func(ctx context.Context, in chan streamz.Result[int]) {
+			streamz.NewBuffer[int](10).Process(ctx, in)
+		}},
+		{"Batcher", func(ctx context.Context, in chan streamz.Result[int]) {
+			streamz.NewBatcher[int](streamz.BatchConfig{MaxSize: 10, MaxLatency: time.Second}, clockz.NewFakeClock()).Process(ctx, in)
+		}},
+		{"Throttle", func(ctx context.Context, in chan streamz.Result[int]) {
+			streamz.NewThrottle[int](time.Second, clockz.NewFakeClock()).Process(ctx, in)
+		}},
+		{"Debounce", func(ctx context.Context, in chan streamz.Result[int]) {
+			streamz.NewDebounce[int](time.Second, clockz.NewFakeClock()).Process(ctx, in)
+		}},
+		{"Dedupe", func(ctx context.Context, in chan streamz.Result[int]) {
+			streamz.NewDedupe(func(v int) string { return strconv.Itoa(v) }, clockz.NewFakeClock()).Process(ctx, in)
+		}},
+		{"Partition", func(ctx context.Context, in chan streamz.Result[int]) {
+			partition, err := streamz.NewRoundRobinPartition[int](3, 0)
+			if err != nil {
+				t.Fatalf("failed to create partition: %v", err)
+			}
+			partition.Process(ctx, in)
+		}},
+		{"Switch", func(ctx context.Context, in chan streamz.Result[int]) {
+			sw := streamz.NewSwitchSimple(func(v int) string {
+				if v%2 == 0 {
+					return "even"
+				}
+				return "odd"
+			})
+			sw.AddRoute("odd")
+			sw.Process(ctx, in)
+		}},
+		{"Filter", func(ctx context.Context, in chan streamz.Result[int]) {
+			streamz.NewFilter(func(int) bool { return true }).Process(ctx, in)
+		}},
+		{"Mapper", func(ctx context.Context, in chan streamz.Result[int]) {
+			streamz.NewMapper(func(_ context.Context, v int) (int, error) { return v, nil }).Process(ctx, in)
+		}},
+		{"AsyncMapper", func(ctx context.Context, in chan streamz.Result[int]) {
+			streamz.NewAsyncMapper(func(_ context.Context, v int) (int, error) { return v, nil }).WithWorkers(2).Process(ctx, in)
+		}},
+		{"Tap", func(ctx context.Context, in chan streamz.Result[int]) {
+			streamz.NewTap(func(streamz.Result[int]) {}).Process(ctx, in)
+		}},
+		{"Sample", func(ctx context.Context, in chan streamz.Result[int]) {
+			streamz.NewSample[int](1.0).Process(ctx, in)
+		}},
+		{"FanIn", func(ctx context.Context, in chan streamz.Result[int]) {
+			streamz.NewFanIn[int]().Process(ctx, in, make(chan streamz.Result[int]))
+		}},
+		{"FanOut", func(ctx context.Context, in chan streamz.Result[int]) {
+			streamz.NewFanOut[int](2).Process(ctx, in)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Run("idle", func(t *testing.T) {
+				helpers.AssertNoLeakOnContextCancel(t, func(ctx context.Context) {
+					tt.start(ctx, make(chan streamz.Result[int]))
+				})
+			})
+
+			t.Run("item pending", func(t *testing.T) {
+				helpers.AssertNoLeakOnContextCancel(t, func(ctx context.Context) {
+					in := make(chan streamz.Result[int], 1)
+					in <- streamz.NewSuccess(1)
+					tt.start(ctx, in)
+				})
+			})
+		})
+	}
+}
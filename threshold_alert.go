@@ -0,0 +1,149 @@
+package streamz
+
+import (
+	"context"
+	"time"
+)
+
+// ThresholdEvent describes a burst detected by ThresholdAlert.
+type ThresholdEvent struct {
+	First  time.Time     // Arrival of the oldest matching item still in the window
+	At     time.Time     // Arrival of the matching item that crossed the threshold
+	Count  int           // Matching items within the window, including the one at At
+	Window time.Duration // Length of the sliding window
+}
+
+// ThresholdAlert watches a stream for bursts: it emits an alert whenever more
+// than a threshold of matching items arrive within a sliding time window. It is
+// a reusable spike detector for error floods, failed logins, and similar events.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type ThresholdAlert[T any] struct {
+	name     string
+	pred     func(T) bool
+	count    int
+	window   time.Duration
+	cooldown time.Duration
+	clock    Clock
+}
+
+// NewThresholdAlert creates a processor that alerts when more than count items
+// matching pred arrive within any window-long span of time. Each alert is a
+// ThresholdEvent success Result. After an alert, further alerts are suppressed
+// until the cooldown elapses; matching items keep being counted meanwhile, so a
+// burst that is still ongoing fires again as soon as the cooldown ends.
+//
+// The output carries only alerts: matching and non-matching items are consumed.
+// Upstream errors are forwarded as error Results without being counted.
+//
+// When to use:
+//   - Detecting error spikes in a log or event stream
+//   - Flagging brute-force login attempts
+//   - Alerting on bursts of retries, timeouts, or rejections
+//
+// Example:
+//
+//	// Alert when more than 50 errors are logged within 10 seconds, at most once a minute
+//	spikes := streamz.NewThresholdAlert(func(e LogEntry) bool {
+//		return e.Level == "ERROR"
+//	}, 50, 10*time.Second, streamz.RealClock).WithCooldown(time.Minute)
+//
+//	for alert := range spikes.Process(ctx, entries) {
+//		if alert.IsSuccess() {
+//			pager.Send(fmt.Sprintf("%d errors in %v", alert.Value().Count, alert.Value().Window))
+//		}
+//	}
+//
+// Parameters:
+//   - pred: Reports whether an item counts toward the threshold
+//   - count: Number of matching items tolerated per window; one more fires an alert
+//   - window: Length of the sliding window
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new ThresholdAlert processor.
+func NewThresholdAlert[T any](pred func(T) bool, count int, window time.Duration, clock Clock) *ThresholdAlert[T] {
+	return &ThresholdAlert[T]{
+		name:     "threshold-alert",
+		pred:     pred,
+		count:    count,
+		window:   window,
+		cooldown: window,
+		clock:    clock,
+	}
+}
+
+// WithCooldown sets how long alerts are suppressed after one fires.
+// A cooldown of zero alerts on every matching item while over the threshold.
+// If not set, defaults to the window length.
+func (a *ThresholdAlert[T]) WithCooldown(d time.Duration) *ThresholdAlert[T] {
+	if d >= 0 {
+		a.cooldown = d
+	}
+	return a
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "threshold-alert".
+func (a *ThresholdAlert[T]) WithName(name string) *ThresholdAlert[T] {
+	a.name = name
+	return a
+}
+
+// Process counts matching items over the sliding window and emits an alert for each burst.
+func (a *ThresholdAlert[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[ThresholdEvent] {
+	out := make(chan Result[ThresholdEvent])
+
+	go func() {
+		defer close(out)
+
+		var matches []time.Time
+		var quietUntil time.Time
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsError() {
+				select {
+				case out <- NewError(ThresholdEvent{}, item.Error().Err, item.Error().ProcessorName):
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+
+			if !a.pred(item.Value()) {
+				continue
+			}
+
+			now := a.clock.Now()
+			matches = append(matches, now)
+			expired := 0
+			for expired < len(matches) && !matches[expired].After(now.Add(-a.window)) {
+				expired++
+			}
+			matches = matches[expired:]
+
+			if len(matches) <= a.count || now.Before(quietUntil) {
+				continue
+			}
+			quietUntil = now.Add(a.cooldown)
+
+			alert := ThresholdEvent{First: matches[0], At: now, Count: len(matches), Window: a.window}
+			select {
+			case out <- NewSuccess(alert):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (a *ThresholdAlert[T]) Name() string {
+	return a.name
+}
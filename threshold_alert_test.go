@@ -0,0 +1,129 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+type logEntry struct {
+	Level   string
+	Message string
+}
+
+func isErrorEntry(e logEntry) bool { return e.Level == "ERROR" }
+
+func TestThresholdAlert_Name(t *testing.T) {
+	alert := NewThresholdAlert(isErrorEntry, 3, time.Second, RealClock)
+	if alert.Name() != "threshold-alert" {
+		t.Errorf("expected name 'threshold-alert', got %q", alert.Name())
+	}
+	if alert.WithName("error-spike").Name() != "error-spike" {
+		t.Errorf("expected name 'error-spike', got %q", alert.Name())
+	}
+}
+
+func TestThresholdAlert_BurstFiresOnce(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	start := clock.Now()
+	in := make(chan Result[logEntry])
+	out := NewThresholdAlert(isErrorEntry, 3, 10*time.Second, clock).Process(context.Background(), in)
+	errorLine := NewSuccess(logEntry{Level: "ERROR"})
+
+	for i := 0; i < 3; i++ {
+		if alerts := sendWithBarrier(t, in, out, errorLine); len(alerts) != 0 {
+			t.Fatalf("expected no alert at or below the threshold, fired on error %d", i+1)
+		}
+		sendWithBarrier(t, in, out, NewSuccess(logEntry{Level: "INFO"}))
+		clock.Advance(time.Second)
+	}
+
+	alerts := sendWithBarrier(t, in, out, errorLine)
+	if len(alerts) != 1 {
+		t.Fatal("expected alert once the threshold was exceeded")
+	}
+	for i := 0; i < 5; i++ {
+		if more := sendWithBarrier(t, in, out, errorLine); len(more) != 0 {
+			t.Fatal("expected a burst to fire a single alert")
+		}
+	}
+	close(in)
+
+	alert := alerts[0].Value()
+	if alert.Count != 4 {
+		t.Errorf("expected count 4, got %d", alert.Count)
+	}
+	if !alert.First.Equal(start) || !alert.At.Equal(start.Add(3*time.Second)) {
+		t.Errorf("expected burst from %v to %v, got %v to %v", start, start.Add(3*time.Second), alert.First, alert.At)
+	}
+	if alert.Window != 10*time.Second {
+		t.Errorf("expected window 10s, got %v", alert.Window)
+	}
+}
+
+func TestThresholdAlert_SpreadOutStaysQuiet(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[logEntry])
+	out := NewThresholdAlert(isErrorEntry, 3, 10*time.Second, clock).Process(context.Background(), in)
+
+	// Ten errors, but never more than three within any 10s span
+	for i := 0; i < 10; i++ {
+		if alerts := sendWithBarrier(t, in, out, NewSuccess(logEntry{Level: "ERROR"})); len(alerts) != 0 {
+			t.Fatalf("expected no alert, fired on error %d", i+1)
+		}
+		clock.Advance(4 * time.Second)
+	}
+	close(in)
+}
+
+func TestThresholdAlert_CooldownSuppressesRepeats(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[logEntry])
+	out := NewThresholdAlert(isErrorEntry, 2, 10*time.Second, clock).WithCooldown(time.Minute).Process(context.Background(), in)
+	errorLine := NewSuccess(logEntry{Level: "ERROR"})
+
+	var alerts []Result[ThresholdEvent]
+	burst := func() int {
+		before := len(alerts)
+		for i := 0; i < 3; i++ {
+			alerts = append(alerts, sendWithBarrier(t, in, out, errorLine)...)
+		}
+		return len(alerts) - before
+	}
+
+	if burst() != 1 {
+		t.Fatal("expected first burst to alert")
+	}
+
+	// A second burst within the cooldown is suppressed
+	clock.Advance(30 * time.Second)
+	if burst() != 0 {
+		t.Fatal("expected alert suppressed during cooldown")
+	}
+
+	// Once the cooldown elapses, the next burst alerts again
+	clock.Advance(30 * time.Second)
+	if burst() != 1 {
+		t.Fatal("expected alert after cooldown elapsed")
+	}
+	close(in)
+
+	if len(alerts) != 2 {
+		t.Errorf("expected 2 alerts, got %d", len(alerts))
+	}
+}
+
+func TestThresholdAlert_ErrorsForwarded(t *testing.T) {
+	in := make(chan Result[logEntry], 1)
+	in <- NewError(logEntry{}, errors.New("malformed line"), "parser")
+	close(in)
+
+	alert := NewThresholdAlert(isErrorEntry, 1, time.Second, clockz.NewFakeClock())
+	result := <-alert.Process(context.Background(), in)
+	if !result.IsError() || result.Error().ProcessorName != "parser" {
+		t.Errorf("expected upstream error forwarded, got %v", result)
+	}
+}
@@ -0,0 +1,119 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+type timedEvent struct {
+	ID string
+	At time.Time
+}
+
+func eventTime(e timedEvent) time.Time { return e.At }
+
+func TestTimeGate_Name(t *testing.T) {
+	gate := NewTimeGate[int](time.Time{}, time.Time{}, nil)
+	if gate.Name() != "time-gate" {
+		t.Errorf("expected name 'time-gate', got %q", gate.Name())
+	}
+	if gate.WithName("business-hours").Name() != "business-hours" {
+		t.Errorf("expected name 'business-hours', got %q", gate.Name())
+	}
+}
+
+func TestTimeGate_EventTimeBoundaries(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+
+	events := []timedEvent{
+		{ID: "early", At: start.Add(-time.Hour)},
+		{ID: "just-before", At: start.Add(-time.Nanosecond)},
+		{ID: "at-start", At: start},
+		{ID: "midday", At: start.Add(3 * time.Hour)},
+		{ID: "at-end", At: end},
+		{ID: "just-after", At: end.Add(time.Nanosecond)},
+		{ID: "late", At: end.Add(time.Hour)},
+	}
+
+	in := make(chan Result[timedEvent], len(events)+1)
+	for _, e := range events {
+		in <- NewSuccess(e)
+	}
+	in <- NewError(timedEvent{ID: "broken"}, errors.New("decode failed"), "decoder")
+	close(in)
+
+	gate := NewTimeGate(start, end, eventTime)
+	var ids []string
+	errorCount := 0
+	for result := range gate.Process(ctx, in) {
+		if result.IsError() {
+			errorCount++
+			continue
+		}
+		ids = append(ids, result.Value().ID)
+	}
+
+	expected := []string{"at-start", "midday", "at-end"}
+	if len(ids) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, ids)
+	}
+	for i := range expected {
+		if ids[i] != expected[i] {
+			t.Errorf("expected %q at %d, got %q", expected[i], i, ids[i])
+		}
+	}
+	if errorCount != 1 {
+		t.Errorf("expected error to pass through, got %d errors", errorCount)
+	}
+
+	stats := gate.Stats()
+	if stats.Before != 2 || stats.Within != 3 || stats.After != 2 {
+		t.Errorf("expected before=2 within=3 after=2, got %+v", stats)
+	}
+}
+
+func TestTimeGate_ClockFallback(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	start := clock.Now().Add(time.Minute)
+	end := start.Add(time.Minute)
+
+	in := make(chan Result[int])
+	gate := NewTimeGate[int](start, end, nil).WithClock(clock)
+	out := gate.Process(ctx, in)
+
+	// A pass-through error confirms each item has been classified
+	sync := func() {
+		in <- NewError(0, errors.New("sync"), "test")
+		<-out
+	}
+
+	in <- NewSuccess(1) // before the window
+	sync()
+
+	clock.Advance(90 * time.Second)
+	in <- NewSuccess(2) // inside the window
+	if result := <-out; result.Value() != 2 {
+		t.Errorf("expected item 2 to pass, got %v", result)
+	}
+
+	clock.Advance(time.Minute)
+	in <- NewSuccess(3) // after the window
+	sync()
+	close(in)
+
+	if _, ok := <-out; ok {
+		t.Error("expected output to be closed")
+	}
+
+	stats := gate.Stats()
+	if stats.Before != 1 || stats.Within != 1 || stats.After != 1 {
+		t.Errorf("expected before=1 within=1 after=1, got %+v", stats)
+	}
+}
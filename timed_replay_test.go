@@ -0,0 +1,128 @@
+package streamz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+func capturedItems(offsets ...time.Duration) []TimedItem[int] {
+	base := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	items := make([]TimedItem[int], len(offsets))
+	for i, offset := range offsets {
+		items[i] = TimedItem[int]{Value: i, Timestamp: base.Add(offset)}
+	}
+	return items
+}
+
+func expectNoReplay(t *testing.T, out <-chan Result[int]) {
+	t.Helper()
+	select {
+	case result := <-out:
+		t.Fatalf("expected no item yet, got %v", result)
+	default:
+	}
+}
+
+func expectReplay(t *testing.T, out <-chan Result[int], value int) Result[int] {
+	t.Helper()
+	select {
+	case result := <-out:
+		if result.Value() != value {
+			t.Fatalf("expected item %d, got %v", value, result)
+		}
+		return result
+	case <-time.After(time.Second):
+		t.Fatalf("timed out waiting for item %d", value)
+	}
+	return Result[int]{}
+}
+
+func TestTimedReplay_Name(t *testing.T) {
+	replay := NewTimedReplay[int](nil, RealClock, 1)
+	if replay.Name() != "timed-replay" {
+		t.Errorf("expected name 'timed-replay', got %q", replay.Name())
+	}
+	if replay.WithName("traffic").Name() != "traffic" {
+		t.Errorf("expected name 'traffic', got %q", replay.Name())
+	}
+}
+
+func TestTimedReplay_ScaledTiming(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	items := capturedItems(0, time.Second, 3*time.Second)
+	out := NewTimedReplay(items, clock, 2).Process(context.Background())
+
+	first := expectReplay(t, out, 0)
+	if ts, _, _ := first.GetTimeMetadata(MetadataTimestamp); !ts.Equal(items[0].Timestamp) {
+		t.Errorf("expected captured timestamp %v, got %v", items[0].Timestamp, ts)
+	}
+
+	// Second item is 1s after the first, replayed at 2x: due at 500ms
+	waitForTimer(t, clock)
+	clock.Advance(499 * time.Millisecond)
+	expectNoReplay(t, out)
+	clock.Advance(time.Millisecond)
+	clock.BlockUntilReady()
+	expectReplay(t, out, 1)
+
+	// Third item is due at 1.5s
+	waitForTimer(t, clock)
+	clock.Advance(999 * time.Millisecond)
+	expectNoReplay(t, out)
+	clock.Advance(time.Millisecond)
+	clock.BlockUntilReady()
+	expectReplay(t, out, 2)
+
+	if _, ok := <-out; ok {
+		t.Error("expected output to close after the last item")
+	}
+}
+
+func TestTimedReplay_ZeroSpeedImmediate(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	items := capturedItems(0, time.Hour, 2*time.Hour)
+
+	count := 0
+	for range NewTimedReplay(items, clock, 0).Process(context.Background()) {
+		count++
+	}
+	if count != 3 {
+		t.Errorf("expected all 3 items without advancing the clock, got %d", count)
+	}
+}
+
+func TestTimedReplay_OutOfOrderNotDelayed(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	items := capturedItems(0, 2*time.Second, time.Second)
+	out := NewTimedReplay(items, clock, 1).Process(context.Background())
+
+	expectReplay(t, out, 0)
+	waitForTimer(t, clock)
+	clock.Advance(2 * time.Second)
+	clock.BlockUntilReady()
+	expectReplay(t, out, 1)
+	expectReplay(t, out, 2)
+}
+
+func TestTimedReplay_CancelMidStream(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx, cancel := context.WithCancel(context.Background())
+	items := capturedItems(0, time.Second, 2*time.Second)
+	out := NewTimedReplay(items, clock, 1).Process(ctx)
+
+	expectReplay(t, out, 0)
+	waitForTimer(t, clock)
+	cancel()
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Error("expected no further items after cancellation")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected output to close after cancellation")
+	}
+}
@@ -0,0 +1,101 @@
+package streamz
+
+import (
+	"context"
+)
+
+// Timestamper stamps MetadataTimestamp on every Result as it enters the pipeline,
+// providing the ingestion time that latency measurement and time-based processors
+// rely on.
+type Timestamper[T any] struct {
+	name      string
+	clock     Clock
+	overwrite bool
+}
+
+// NewTimestamper creates a processor that records the current time in MetadataTimestamp.
+// Both successful values and errors are stamped. An existing timestamp is kept
+// unless WithOverwrite(true) is set, so the earliest stamp wins by default.
+//
+// When to use:
+//   - Marking ingestion time at the start of a pipeline
+//   - Measuring end-to-end latency against a later clock reading
+//   - Supplying arrival times to time-based gating or windowing
+//
+// Example:
+//
+//	stamped := streamz.NewTimestamper[Event](streamz.RealClock).Process(ctx, events)
+//
+//	for result := range process(ctx, stamped) {
+//		if ingested, found, _ := result.GetTimeMetadata(streamz.MetadataTimestamp); found {
+//			latency.Observe(time.Since(ingested).Seconds())
+//		}
+//	}
+//
+// Parameters:
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new Timestamper processor.
+func NewTimestamper[T any](clock Clock) *Timestamper[T] {
+	return &Timestamper[T]{
+		name:  "timestamper",
+		clock: clock,
+	}
+}
+
+// WithOverwrite replaces existing timestamps instead of preserving them.
+// If not set, defaults to false.
+func (s *Timestamper[T]) WithOverwrite(overwrite bool) *Timestamper[T] {
+	s.overwrite = overwrite
+	return s
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "timestamper".
+func (s *Timestamper[T]) WithName(name string) *Timestamper[T] {
+	s.name = name
+	return s
+}
+
+// Process forwards every Result with MetadataTimestamp set.
+func (s *Timestamper[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+
+				if s.overwrite || !hasTimestamp(item) {
+					item = item.WithMetadata(MetadataTimestamp, s.clock.Now())
+				}
+
+				select {
+				case out <- item:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (s *Timestamper[T]) Name() string {
+	return s.name
+}
+
+// hasTimestamp reports whether result already carries MetadataTimestamp.
+func hasTimestamp[T any](result Result[T]) bool {
+	_, found := result.GetMetadata(MetadataTimestamp)
+	return found
+}
@@ -0,0 +1,100 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+func stampedTime(t *testing.T, result Result[int]) time.Time {
+	t.Helper()
+	ts, found, err := result.GetTimeMetadata(MetadataTimestamp)
+	if err != nil || !found {
+		t.Fatalf("expected timestamp metadata, found=%v err=%v", found, err)
+	}
+	return ts
+}
+
+func TestTimestamper_Name(t *testing.T) {
+	stamper := NewTimestamper[int](RealClock)
+	if stamper.Name() != "timestamper" {
+		t.Errorf("expected name 'timestamper', got %q", stamper.Name())
+	}
+	if stamper.WithName("ingest-time").Name() != "ingest-time" {
+		t.Errorf("expected name 'ingest-time', got %q", stamper.Name())
+	}
+}
+
+func TestTimestamper_StampsClockTime(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	in := make(chan Result[int])
+	out := NewTimestamper[int](clock).Process(ctx, in)
+
+	in <- NewSuccess(1)
+	if ts := stampedTime(t, <-out); !ts.Equal(clock.Now()) {
+		t.Errorf("expected timestamp %v, got %v", clock.Now(), ts)
+	}
+
+	clock.Advance(time.Minute)
+	in <- NewSuccess(2)
+	if ts := stampedTime(t, <-out); !ts.Equal(clock.Now()) {
+		t.Errorf("expected timestamp %v after advance, got %v", clock.Now(), ts)
+	}
+	close(in)
+
+	if _, ok := <-out; ok {
+		t.Error("expected output to be closed")
+	}
+}
+
+func TestTimestamper_ExistingTimestamp(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	earlier := clock.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		overwrite bool
+		expected  time.Time
+	}{
+		{name: "preserved by default", overwrite: false, expected: earlier},
+		{name: "overwritten when configured", overwrite: true, expected: clock.Now()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := make(chan Result[int], 1)
+			in <- NewSuccess(1).WithMetadata(MetadataTimestamp, earlier)
+			close(in)
+
+			stamper := NewTimestamper[int](clock).WithOverwrite(tt.overwrite)
+			for result := range stamper.Process(context.Background(), in) {
+				if ts := stampedTime(t, result); !ts.Equal(tt.expected) {
+					t.Errorf("expected timestamp %v, got %v", tt.expected, ts)
+				}
+			}
+		})
+	}
+}
+
+func TestTimestamper_StampsErrors(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[int], 1)
+	in <- NewError(1, errors.New("parse failed"), "parser")
+	close(in)
+
+	var results []Result[int]
+	for result := range NewTimestamper[int](clock).Process(context.Background(), in) {
+		results = append(results, result)
+	}
+
+	if len(results) != 1 || !results[0].IsError() {
+		t.Fatalf("expected one error result, got %v", results)
+	}
+	if ts := stampedTime(t, results[0]); !ts.Equal(clock.Now()) {
+		t.Errorf("expected error stamped at %v, got %v", clock.Now(), ts)
+	}
+}
@@ -0,0 +1,199 @@
+package streamz
+
+import (
+	"cmp"
+	"container/heap"
+	"context"
+	"slices"
+	"time"
+)
+
+// TopK keeps the k highest-scored items of each tumbling time window and emits
+// them as one batch when the window closes, for reports such as "the ten
+// slowest requests per minute" without buffering every item.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type TopK[T any] struct {
+	name    string
+	k       int
+	scoreFn func(T) float64
+	window  time.Duration
+	clock   Clock
+}
+
+// scoredItem is a windowed item with its score and arrival order.
+type scoredItem[T any] struct {
+	value T
+	score float64
+	seq   uint64
+}
+
+// topKHeap is a min-heap whose root is the item to evict next: the lowest score,
+// and among equal scores the latest arrival.
+type topKHeap[T any] []scoredItem[T]
+
+func (h topKHeap[T]) Len() int { return len(h) }
+
+func (h topKHeap[T]) Less(i, j int) bool {
+	if h[i].score != h[j].score {
+		return h[i].score < h[j].score
+	}
+	return h[i].seq > h[j].seq
+}
+
+func (h topKHeap[T]) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *topKHeap[T]) Push(x any) {
+	*h = append(*h, x.(scoredItem[T])) //nolint:errcheck // only scoredItem values are pushed
+}
+
+func (h *topKHeap[T]) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
+// NewTopK creates a processor that emits the top k items of each window by score.
+// Windows are consecutive and non-overlapping, starting when Process is called.
+// At each window close, the retained items are emitted as a single Result[[]T]
+// in descending score order, with equal scores in arrival order, carrying the
+// window metadata (see GetWindowMetadata). Windows with no items emit nothing,
+// and a partial window is flushed when the input closes.
+//
+// Only k items are held per window, so memory stays bounded regardless of volume.
+// Errors pass through immediately as error Results and are never ranked.
+//
+// When to use:
+//   - Reporting the slowest requests or largest payloads per interval
+//   - Surfacing the most active users or hottest keys per window
+//   - Sampling the most significant events for dashboards
+//
+// Example:
+//
+//	// Ten slowest requests per minute
+//	slowest := streamz.NewTopK(10, func(r Request) float64 {
+//		return r.Duration.Seconds()
+//	}, time.Minute, streamz.RealClock)
+//
+//	for result := range slowest.Process(ctx, requests) {
+//		if result.IsSuccess() {
+//			meta, _ := streamz.GetWindowMetadata(result)
+//			report.Slowest(meta.Start, result.Value())
+//		}
+//	}
+//
+// Parameters:
+//   - k: Number of items to keep per window (must be positive)
+//   - scoreFn: Scores an item; higher scores rank first
+//   - window: Duration of each window
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new TopK processor.
+// Panics if k is less than 1.
+func NewTopK[T any](k int, scoreFn func(T) float64, window time.Duration, clock Clock) *TopK[T] {
+	if k < 1 {
+		panic("top-k k must be positive")
+	}
+
+	return &TopK[T]{
+		name:    "top-k",
+		k:       k,
+		scoreFn: scoreFn,
+		window:  window,
+		clock:   clock,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "top-k".
+func (t *TopK[T]) WithName(name string) *TopK[T] {
+	t.name = name
+	return t
+}
+
+// Process ranks successful items per window and emits each window's top k at its close.
+func (t *TopK[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[[]T] {
+	out := make(chan Result[[]T])
+
+	go func() {
+		defer close(out)
+
+		ticker := t.clock.NewTicker(t.window)
+		defer ticker.Stop()
+
+		start := t.clock.Now()
+		current := WindowMetadata{Start: start, End: start.Add(t.window), Type: "tumbling", Size: t.window}
+		top := make(topKHeap[T], 0, t.k)
+		var seq uint64
+
+		emit := func() bool {
+			if len(top) == 0 {
+				return true
+			}
+			ranked := slices.Clone(top)
+			slices.SortFunc(ranked, func(a, b scoredItem[T]) int {
+				if c := cmp.Compare(b.score, a.score); c != 0 {
+					return c
+				}
+				return cmp.Compare(a.seq, b.seq)
+			})
+			values := make([]T, len(ranked))
+			for i, item := range ranked {
+				values[i] = item.value
+			}
+			top = top[:0]
+
+			select {
+			case out <- AddWindowMetadata(NewSuccess(values), current):
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					emit()
+					return
+				}
+
+				if item.IsError() {
+					select {
+					case out <- NewError(make([]T, 0), item.Error().Err, item.Error().ProcessorName):
+					case <-ctx.Done():
+						return
+					}
+					continue
+				}
+
+				seq++
+				scored := scoredItem[T]{value: item.Value(), score: t.scoreFn(item.Value()), seq: seq}
+				if len(top) < t.k {
+					heap.Push(&top, scored)
+				} else if scored.score > top[0].score {
+					top[0] = scored
+					heap.Fix(&top, 0)
+				}
+
+			case <-ticker.C():
+				if !emit() {
+					return
+				}
+				current = WindowMetadata{Start: current.End, End: current.End.Add(t.window), Type: "tumbling", Size: t.window}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (t *TopK[T]) Name() string {
+	return t.name
+}
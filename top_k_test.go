@@ -0,0 +1,127 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+type request struct {
+	Path     string
+	Duration time.Duration
+}
+
+func requestSeconds(r request) float64 { return r.Duration.Seconds() }
+
+func requestPaths(requests []request) []string {
+	paths := make([]string, len(requests))
+	for i, r := range requests {
+		paths[i] = r.Path
+	}
+	return paths
+}
+
+func TestTopK_Name(t *testing.T) {
+	top := NewTopK(3, requestSeconds, time.Minute, RealClock)
+	if top.Name() != "top-k" {
+		t.Errorf("expected name 'top-k', got %q", top.Name())
+	}
+	if top.WithName("slowest").Name() != "slowest" {
+		t.Errorf("expected name 'slowest', got %q", top.Name())
+	}
+}
+
+func TestTopK_PanicsOnInvalidK(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for k of 0")
+		}
+	}()
+	NewTopK(0, requestSeconds, time.Minute, RealClock)
+}
+
+func TestTopK_EmitsTopItemsPerWindow(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	start := clock.Now()
+	top := NewTopK(3, requestSeconds, time.Minute, clock)
+
+	in := make(chan Result[request])
+	out := top.Process(context.Background(), in)
+
+	for _, r := range []request{
+		{"/a", 120 * time.Millisecond},
+		{"/b", 900 * time.Millisecond},
+		{"/c", 40 * time.Millisecond},
+		{"/d", 700 * time.Millisecond},
+		{"/e", 300 * time.Millisecond},
+		{"/f", 700 * time.Millisecond},
+		{"/g", 10 * time.Millisecond},
+	} {
+		in <- NewSuccess(r)
+	}
+	// The unbuffered send above only returns once the previous item is ranked
+	in <- NewError(request{Path: "/h"}, errors.New("timeout"), "client")
+	if result := <-out; !result.IsError() {
+		t.Fatal("expected error passed through immediately")
+	}
+
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+
+	result := <-out
+	if result.IsError() {
+		t.Fatalf("unexpected error: %v", result.Error())
+	}
+	// Equal scores keep arrival order
+	if got := requestPaths(result.Value()); !slices.Equal(got, []string{"/b", "/d", "/f"}) {
+		t.Errorf("expected top 3 [/b /d /f], got %v", got)
+	}
+
+	meta, err := GetWindowMetadata(result)
+	if err != nil {
+		t.Fatalf("expected window metadata: %v", err)
+	}
+	if !meta.Start.Equal(start) || !meta.End.Equal(start.Add(time.Minute)) {
+		t.Errorf("expected window [%v, %v), got [%v, %v)", start, start.Add(time.Minute), meta.Start, meta.End)
+	}
+	if meta.Type != "tumbling" || meta.Size != time.Minute {
+		t.Errorf("expected tumbling window of 1m, got %s of %v", meta.Type, meta.Size)
+	}
+
+	// The next window starts empty and is flushed when input closes
+	in <- NewSuccess(request{"/i", 50 * time.Millisecond})
+	close(in)
+
+	result = <-out
+	if got := requestPaths(result.Value()); !slices.Equal(got, []string{"/i"}) {
+		t.Errorf("expected flushed window [/i], got %v", got)
+	}
+	meta, _ = GetWindowMetadata(result)
+	if !meta.Start.Equal(start.Add(time.Minute)) {
+		t.Errorf("expected second window to start at %v, got %v", start.Add(time.Minute), meta.Start)
+	}
+	if _, ok := <-out; ok {
+		t.Error("expected output closed")
+	}
+}
+
+func TestTopK_EmptyWindowEmitsNothing(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	top := NewTopK(3, requestSeconds, time.Minute, clock)
+
+	in := make(chan Result[request])
+	out := top.Process(context.Background(), in)
+
+	waitForTimer(t, clock)
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+	close(in)
+
+	if result, ok := <-out; ok {
+		t.Errorf("expected no output for empty window, got %v", result)
+	}
+}
@@ -0,0 +1,162 @@
+package streamz
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// TTLCache keeps the latest value per key while forwarding items unchanged, like
+// KeyedState, but forgets keys that have not been updated within a time-to-live.
+// It models a short-lived enrichment cache whose entries should not outlive the
+// data they describe.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type TTLCache[T any] struct {
+	name      string
+	keyFn     func(T) string
+	ttl       time.Duration
+	clock     Clock
+	mu        sync.RWMutex
+	entries   map[string]ttlEntry[T]
+	lastSweep time.Time
+}
+
+// ttlEntry is a cached value and the time it was last updated.
+type ttlEntry[T any] struct {
+	value   T
+	updated time.Time
+}
+
+// NewTTLCache creates a processor that caches the latest successful value per key.
+// Each update refreshes the key's lifetime; a key not updated for ttl is expired
+// and no longer returned by Get. Expired entries are removed from memory as
+// processing continues. Errors pass through without affecting the cache.
+//
+// When to use:
+//   - Caching reference data from a change stream for enrichment lookups
+//   - Tracking currently active sessions, devices, or users
+//   - Serving recent state that must not go stale silently
+//
+// Example:
+//
+//	// Devices unseen for 5 minutes are considered offline
+//	status := streamz.NewTTLCache(func(h Heartbeat) string {
+//		return h.DeviceID
+//	}, 5*time.Minute, streamz.RealClock)
+//	heartbeats = status.Process(ctx, heartbeats)
+//
+//	if hb, online := status.Get("device-42"); online {
+//		fmt.Println("last seen with firmware", hb.Firmware)
+//	}
+//
+// Parameters:
+//   - keyFn: Extracts the key an item updates
+//   - ttl: Inactivity period after which a key expires
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new TTLCache processor.
+func NewTTLCache[T any](keyFn func(T) string, ttl time.Duration, clock Clock) *TTLCache[T] {
+	return &TTLCache[T]{
+		name:    "ttl-cache",
+		keyFn:   keyFn,
+		ttl:     ttl,
+		clock:   clock,
+		entries: make(map[string]ttlEntry[T]),
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "ttl-cache".
+func (c *TTLCache[T]) WithName(name string) *TTLCache[T] {
+	c.name = name
+	return c
+}
+
+// Get returns the latest value for key if it was updated within the TTL.
+// Safe to call concurrently with Process.
+func (c *TTLCache[T]) Get(key string) (T, bool) {
+	now := c.clock.Now()
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.entries[key]
+	if !ok || c.expired(entry, now) {
+		var zero T
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// Len returns the number of unexpired keys.
+// Safe to call concurrently with Process.
+func (c *TTLCache[T]) Len() int {
+	now := c.clock.Now()
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	live := 0
+	for _, entry := range c.entries {
+		if !c.expired(entry, now) {
+			live++
+		}
+	}
+	return live
+}
+
+// Process caches each successful item under its key and forwards every item unchanged.
+func (c *TTLCache[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsSuccess() {
+				c.store(item.Value())
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// store records value under its key, sweeping expired entries at most once per TTL.
+func (c *TTLCache[T]) store(value T) {
+	now := c.clock.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[c.keyFn(value)] = ttlEntry[T]{value: value, updated: now}
+
+	if now.Sub(c.lastSweep) < c.ttl {
+		return
+	}
+	c.lastSweep = now
+	for key, entry := range c.entries {
+		if c.expired(entry, now) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// expired reports whether entry has outlived the TTL at now.
+func (c *TTLCache[T]) expired(entry ttlEntry[T], now time.Time) bool {
+	return now.Sub(entry.updated) >= c.ttl
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (c *TTLCache[T]) Name() string {
+	return c.name
+}
@@ -0,0 +1,119 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+type heartbeat struct {
+	Device   string
+	Firmware string
+}
+
+func heartbeatDevice(h heartbeat) string { return h.Device }
+
+func TestTTLCache_Name(t *testing.T) {
+	cache := NewTTLCache(heartbeatDevice, time.Minute, RealClock)
+	if cache.Name() != "ttl-cache" {
+		t.Errorf("expected name 'ttl-cache', got %q", cache.Name())
+	}
+	if cache.WithName("device-status").Name() != "device-status" {
+		t.Errorf("expected name 'device-status', got %q", cache.Name())
+	}
+}
+
+func TestTTLCache_Expiry(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	cache := NewTTLCache(heartbeatDevice, time.Minute, clock)
+
+	in := make(chan Result[heartbeat])
+	out := cache.Process(ctx, in)
+	send := func(h heartbeat) {
+		in <- NewSuccess(h)
+		<-out
+	}
+
+	send(heartbeat{Device: "a", Firmware: "1.0"})
+	send(heartbeat{Device: "b", Firmware: "2.0"})
+
+	clock.Advance(59 * time.Second)
+	if hb, ok := cache.Get("a"); !ok || hb.Firmware != "1.0" {
+		t.Errorf("expected key a before TTL, got %v (%v)", hb, ok)
+	}
+
+	// Refreshing b within the TTL extends its lifetime
+	send(heartbeat{Device: "b", Firmware: "2.1"})
+
+	clock.Advance(time.Second)
+	if _, ok := cache.Get("a"); ok {
+		t.Error("expected key a expired after TTL without updates")
+	}
+	if hb, ok := cache.Get("b"); !ok || hb.Firmware != "2.1" {
+		t.Errorf("expected refreshed key b, got %v (%v)", hb, ok)
+	}
+	if cache.Len() != 1 {
+		t.Errorf("expected 1 live key, got %d", cache.Len())
+	}
+
+	clock.Advance(59 * time.Second)
+	if _, ok := cache.Get("b"); ok {
+		t.Error("expected key b expired one TTL after its refresh")
+	}
+	if _, ok := cache.Get("missing"); ok {
+		t.Error("expected unknown key not found")
+	}
+	close(in)
+}
+
+func TestTTLCache_SweepsExpiredEntries(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	cache := NewTTLCache(heartbeatDevice, time.Minute, clock)
+
+	in := make(chan Result[heartbeat])
+	out := cache.Process(context.Background(), in)
+	send := func(h heartbeat) {
+		in <- NewSuccess(h)
+		<-out
+	}
+
+	send(heartbeat{Device: "a"})
+	send(heartbeat{Device: "b"})
+	clock.Advance(2 * time.Minute)
+	send(heartbeat{Device: "c"})
+	close(in)
+
+	cache.mu.RLock()
+	stored := len(cache.entries)
+	cache.mu.RUnlock()
+	if stored != 1 {
+		t.Errorf("expected expired entries removed from memory, got %d stored", stored)
+	}
+}
+
+func TestTTLCache_ErrorsIgnored(t *testing.T) {
+	in := make(chan Result[heartbeat], 2)
+	in <- NewError(heartbeat{Device: "a"}, errors.New("bad"), "parser")
+	in <- NewSuccess(heartbeat{Device: "b"})
+	close(in)
+
+	cache := NewTTLCache(heartbeatDevice, time.Minute, clockz.NewFakeClock())
+	count := 0
+	for range cache.Process(context.Background(), in) {
+		count++
+	}
+
+	if count != 2 {
+		t.Errorf("expected both items forwarded, got %d", count)
+	}
+	if _, ok := cache.Get("a"); ok {
+		t.Error("expected error not cached")
+	}
+	if _, ok := cache.Get("b"); !ok {
+		t.Error("expected success cached")
+	}
+}
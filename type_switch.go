@@ -0,0 +1,179 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ErrNoTypeRoute is reported for values whose dynamic type has no route in a TypeSwitch.
+var ErrNoTypeRoute = errors.New("no route for type")
+
+// TypeSwitch routes a heterogeneous Result[any] stream by the dynamic Go type of
+// each value, delivering every type to its own compile-time-typed channel. It is
+// the type-based counterpart to Switch, which routes on a comparable key.
+//
+// Routes are registered with AddTypeRoute. A value is matched on its exact dynamic
+// type, so routes should use concrete types: an interface type parameter never
+// matches, and T and *T are distinct routes.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type TypeSwitch struct {
+	name       string
+	routes     map[reflect.Type]*typeRoute
+	bufferSize int
+	mu         sync.RWMutex
+	closed     bool
+}
+
+// typeRoute adapts a typed route channel to the untyped routing loop.
+type typeRoute struct {
+	ch    any // chan Result[T], kept for AddTypeRoute to return on repeat calls
+	send  func(ctx context.Context, result Result[any]) bool
+	close func()
+}
+
+// NewTypeSwitch creates a processor that routes values by their concrete type.
+// Errors bypass type matching and go to the error channel, as do values with no
+// matching route, which are converted to errors wrapping ErrNoTypeRoute.
+//
+// When to use:
+//   - Dispatching decoded events to per-type handlers
+//   - Splitting a mixed message bus into typed pipelines
+//   - Replacing type switches scattered across consumers
+//
+// Example:
+//
+//	router := streamz.NewTypeSwitch().WithBufferSize(100)
+//	created := streamz.AddTypeRoute[OrderCreated](router)
+//	canceled := streamz.AddTypeRoute[OrderCanceled](router)
+//
+//	errs := router.Process(ctx, events)
+//
+//	go handleCreated(created)   // <-chan Result[OrderCreated]
+//	go handleCanceled(canceled) // <-chan Result[OrderCanceled]
+//	for err := range errs {
+//		log.Printf("unroutable event: %v", err.Error())
+//	}
+//
+// Returns a new TypeSwitch processor.
+func NewTypeSwitch() *TypeSwitch {
+	return &TypeSwitch{
+		name:   "type-switch",
+		routes: make(map[reflect.Type]*typeRoute),
+	}
+}
+
+// WithBufferSize sets the buffer size of each route channel and the error channel.
+// Must be called before Process and before routes are added.
+// If not set, defaults to 0 (unbuffered).
+func (s *TypeSwitch) WithBufferSize(size int) *TypeSwitch {
+	s.bufferSize = size
+	return s
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "type-switch".
+func (s *TypeSwitch) WithName(name string) *TypeSwitch {
+	s.name = name
+	return s
+}
+
+// AddTypeRoute registers a route for values of dynamic type T and returns its channel.
+// Calling it again for the same T returns the existing channel. Routes may be added
+// while Process is running; a route added after processing has finished is closed.
+func AddTypeRoute[T any](s *TypeSwitch) <-chan Result[T] {
+	key := reflect.TypeFor[T]()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if route, exists := s.routes[key]; exists {
+		return route.ch.(chan Result[T]) //nolint:errcheck // keyed by T, so always chan Result[T]
+	}
+
+	ch := make(chan Result[T], s.bufferSize)
+	s.routes[key] = &typeRoute{
+		ch: ch,
+		send: func(ctx context.Context, result Result[any]) bool {
+			typed := Result[T]{value: result.value.(T), metadata: result.metadata} //nolint:errcheck // routed by dynamic type T
+			select {
+			case ch <- typed:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		},
+		close: func() { close(ch) },
+	}
+	if s.closed {
+		close(ch)
+	}
+	return ch
+}
+
+// Process routes each value to the channel registered for its dynamic type.
+// Returns the error channel, which receives upstream errors and unmatched values.
+// All route channels and the error channel close when processing completes or
+// the context is canceled.
+func (s *TypeSwitch) Process(ctx context.Context, in <-chan Result[any]) <-chan Result[any] {
+	errorChan := make(chan Result[any], s.bufferSize)
+
+	go func() {
+		defer func() {
+			s.mu.Lock()
+			for _, route := range s.routes {
+				route.close()
+			}
+			s.closed = true
+			s.mu.Unlock()
+			close(errorChan)
+		}()
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsError() {
+				select {
+				case errorChan <- item:
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+
+			s.mu.RLock()
+			route, exists := s.routes[reflect.TypeOf(item.Value())]
+			s.mu.RUnlock()
+
+			if exists {
+				if !route.send(ctx, item) {
+					return
+				}
+				continue
+			}
+
+			unmatched := Result[any]{
+				err:      NewStreamError(item.Value(), fmt.Errorf("%w %T", ErrNoTypeRoute, item.Value()), s.name),
+				metadata: item.metadata,
+			}
+			select {
+			case errorChan <- unmatched:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return errorChan
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (s *TypeSwitch) Name() string {
+	return s.name
+}
@@ -0,0 +1,129 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+type orderPlaced struct {
+	ID string
+}
+
+func TestTypeSwitch_Name(t *testing.T) {
+	router := NewTypeSwitch()
+	if router.Name() != "type-switch" {
+		t.Errorf("expected name 'type-switch', got %q", router.Name())
+	}
+	if router.WithName("event-router").Name() != "event-router" {
+		t.Errorf("expected name 'event-router', got %q", router.Name())
+	}
+}
+
+func TestTypeSwitch_RoutesByType(t *testing.T) {
+	ctx := context.Background()
+	router := NewTypeSwitch()
+	ints := AddTypeRoute[int](router)
+	strs := AddTypeRoute[string](router)
+	orders := AddTypeRoute[orderPlaced](router)
+
+	if again := AddTypeRoute[int](router); again != ints {
+		t.Error("expected repeat registration to return the existing channel")
+	}
+
+	in := make(chan Result[any], 8)
+	in <- NewSuccess[any](1)
+	in <- NewSuccess[any]("a")
+	in <- NewSuccess[any](orderPlaced{ID: "o-1"}).WithMetadata(MetadataSource, "checkout")
+	in <- NewSuccess[any](2)
+	in <- NewSuccess[any](3.5)
+	in <- NewSuccess[any](&orderPlaced{ID: "o-2"})
+	in <- NewError[any]("bad", errors.New("decode failed"), "decoder")
+	close(in)
+
+	errs := router.Process(ctx, in)
+
+	var wg sync.WaitGroup
+	var gotInts []int
+	var gotStrs []string
+	var gotOrders []Result[orderPlaced]
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		for r := range ints {
+			gotInts = append(gotInts, r.Value())
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for r := range strs {
+			gotStrs = append(gotStrs, r.Value())
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for r := range orders {
+			gotOrders = append(gotOrders, r)
+		}
+	}()
+
+	var gotErrs []Result[any]
+	for r := range errs {
+		gotErrs = append(gotErrs, r)
+	}
+	wg.Wait()
+
+	if len(gotInts) != 2 || gotInts[0] != 1 || gotInts[1] != 2 {
+		t.Errorf("expected ints [1 2], got %v", gotInts)
+	}
+	if len(gotStrs) != 1 || gotStrs[0] != "a" {
+		t.Errorf("expected strings [a], got %v", gotStrs)
+	}
+	if len(gotOrders) != 1 || gotOrders[0].Value().ID != "o-1" {
+		t.Fatalf("expected one order, got %v", gotOrders)
+	}
+	if source, _, _ := gotOrders[0].GetStringMetadata(MetadataSource); source != "checkout" {
+		t.Errorf("expected metadata preserved, got %q", source)
+	}
+
+	// float64 and *orderPlaced have no route; the upstream error passes through
+	if len(gotErrs) != 3 {
+		t.Fatalf("expected 3 error results, got %d", len(gotErrs))
+	}
+	unmatched := 0
+	for _, r := range gotErrs {
+		if !r.IsError() {
+			t.Errorf("expected error result, got %v", r)
+			continue
+		}
+		if errors.Is(r.Error(), ErrNoTypeRoute) {
+			unmatched++
+			if r.Error().ProcessorName != "type-switch" {
+				t.Errorf("expected processor name 'type-switch', got %q", r.Error().ProcessorName)
+			}
+		}
+	}
+	if unmatched != 2 {
+		t.Errorf("expected 2 unmatched-type errors, got %d", unmatched)
+	}
+}
+
+func TestTypeSwitch_CancelClosesRoutes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	router := NewTypeSwitch()
+	ints := AddTypeRoute[int](router)
+
+	in := make(chan Result[any])
+	errs := router.Process(ctx, in)
+	cancel()
+
+	for range errs {
+	}
+	if _, ok := <-ints; ok {
+		t.Error("expected route channel to be closed")
+	}
+	if _, ok := <-AddTypeRoute[string](router); ok {
+		t.Error("expected route added after completion to be closed")
+	}
+}
@@ -0,0 +1,163 @@
+package streamz
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// ErrStaleVersion is wrapped by the error Results UpsertGuard emits for stale
+// updates when configured with WithRejectStale.
+var ErrStaleVersion = errors.New("stale version")
+
+// UpsertGuard keeps only newer updates in change-data-capture and upsert
+// streams: an update is forwarded only if its version is newer than the last
+// one forwarded for the same key, so a record delivered late or twice can never
+// overwrite a newer one downstream.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type UpsertGuard[T any] struct {
+	name         string
+	keyFn        func(T) string
+	versionFn    func(T) uint64
+	rejectStale  bool
+	maxKeys      int
+	staleCount   atomic.Uint64
+	evictedCount atomic.Uint64
+}
+
+// versionEntry is the last forwarded version of a key, kept in recency order.
+type versionEntry struct {
+	key     string
+	version uint64
+}
+
+// NewUpsertGuard creates a processor that forwards an item only if its version
+// is strictly greater than the last forwarded version for its key. The first
+// item seen for a key is always forwarded. Stale items, including repeats of the
+// current version, are dropped unless WithRejectStale is set.
+// Errors are passed through unchanged and never affect the tracked versions.
+//
+// When to use:
+//   - Applying CDC streams where events can arrive out of order
+//   - Protecting upsert sinks from redelivered older records
+//   - Discarding duplicate updates that carry the same version
+//
+// Example:
+//
+//	guard := streamz.NewUpsertGuard(
+//		func(c Customer) string { return c.ID },
+//		func(c Customer) uint64 { return c.Revision },
+//	).WithMaxKeys(100000)
+//
+//	latest := guard.Process(ctx, changes)
+//
+// Parameters:
+//   - keyFn: Extracts the key whose versions are compared
+//   - versionFn: Extracts the item's version; newer versions are larger
+//
+// Returns a new UpsertGuard processor.
+func NewUpsertGuard[T any](keyFn func(T) string, versionFn func(T) uint64) *UpsertGuard[T] {
+	return &UpsertGuard[T]{
+		name:      "upsert-guard",
+		keyFn:     keyFn,
+		versionFn: versionFn,
+	}
+}
+
+// WithRejectStale emits stale items as error Results wrapping ErrStaleVersion
+// instead of dropping them silently.
+func (g *UpsertGuard[T]) WithRejectStale() *UpsertGuard[T] {
+	g.rejectStale = true
+	return g
+}
+
+// WithMaxKeys bounds memory by tracking at most n keys, evicting the least
+// recently updated key when a new one arrives. An evicted key is treated as
+// unseen, so its next item is forwarded whatever its version.
+// If not set or not positive, every key is tracked.
+func (g *UpsertGuard[T]) WithMaxKeys(n int) *UpsertGuard[T] {
+	g.maxKeys = n
+	return g
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "upsert-guard".
+func (g *UpsertGuard[T]) WithName(name string) *UpsertGuard[T] {
+	g.name = name
+	return g
+}
+
+// StaleCount returns the number of stale items dropped or rejected.
+// Safe to call concurrently with Process.
+func (g *UpsertGuard[T]) StaleCount() uint64 {
+	return g.staleCount.Load()
+}
+
+// EvictedCount returns the number of keys evicted by WithMaxKeys.
+// Safe to call concurrently with Process.
+func (g *UpsertGuard[T]) EvictedCount() uint64 {
+	return g.evictedCount.Load()
+}
+
+// Process forwards items whose version is newer than their key's last forwarded version.
+func (g *UpsertGuard[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		// Most recently updated keys at the front
+		recency := list.New()
+		versions := make(map[string]*list.Element)
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsSuccess() {
+				key := g.keyFn(item.Value())
+				version := g.versionFn(item.Value())
+
+				if elem, seen := versions[key]; seen {
+					entry := elem.Value.(*versionEntry) //nolint:errcheck // only versionEntry values are stored
+					if version <= entry.version {
+						g.staleCount.Add(1)
+						if !g.rejectStale {
+							continue
+						}
+						err := fmt.Errorf("%w: key %q version %d, last forwarded %d", ErrStaleVersion, key, version, entry.version)
+						item = Result[T]{err: NewStreamError(item.Value(), err, g.name), metadata: item.metadata}
+					} else {
+						entry.version = version
+						recency.MoveToFront(elem)
+					}
+				} else {
+					versions[key] = recency.PushFront(&versionEntry{key: key, version: version})
+					if g.maxKeys > 0 && recency.Len() > g.maxKeys {
+						oldest := recency.Remove(recency.Back()).(*versionEntry) //nolint:errcheck // only versionEntry values are stored
+						delete(versions, oldest.key)
+						g.evictedCount.Add(1)
+					}
+				}
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (g *UpsertGuard[T]) Name() string {
+	return g.name
+}
@@ -0,0 +1,140 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+type change struct {
+	Key     string
+	Version uint64
+}
+
+func changeKey(c change) string     { return c.Key }
+func changeVersion(c change) uint64 { return c.Version }
+
+// guardChanges runs changes through guard and returns the Results it emits.
+func guardChanges(guard *UpsertGuard[change], changes ...change) []Result[change] {
+	in := make(chan Result[change], len(changes))
+	for _, c := range changes {
+		in <- NewSuccess(c)
+	}
+	close(in)
+
+	var results []Result[change]
+	for result := range guard.Process(context.Background(), in) {
+		results = append(results, result)
+	}
+	return results
+}
+
+func forwardedChanges(results []Result[change]) []change {
+	var changes []change
+	for _, r := range results {
+		if r.IsSuccess() {
+			changes = append(changes, r.Value())
+		}
+	}
+	return changes
+}
+
+func TestUpsertGuard_Name(t *testing.T) {
+	guard := NewUpsertGuard(changeKey, changeVersion)
+	if guard.Name() != "upsert-guard" {
+		t.Errorf("expected name 'upsert-guard', got %q", guard.Name())
+	}
+	if guard.WithName("cdc-guard").Name() != "cdc-guard" {
+		t.Errorf("expected name 'cdc-guard', got %q", guard.Name())
+	}
+}
+
+func TestUpsertGuard_IncreasingVersionsPass(t *testing.T) {
+	changes := []change{{"a", 1}, {"a", 2}, {"a", 5}, {"a", 9}}
+	guard := NewUpsertGuard(changeKey, changeVersion)
+	if got := forwardedChanges(guardChanges(guard, changes...)); !slices.Equal(got, changes) {
+		t.Errorf("expected every change forwarded, got %v", got)
+	}
+	if guard.StaleCount() != 0 {
+		t.Errorf("expected no stale changes, got %d", guard.StaleCount())
+	}
+}
+
+func TestUpsertGuard_DropsStaleVersions(t *testing.T) {
+	guard := NewUpsertGuard(changeKey, changeVersion)
+	results := guardChanges(guard, change{"a", 3}, change{"a", 2}, change{"a", 3}, change{"a", 4})
+
+	want := []change{{"a", 3}, {"a", 4}}
+	if len(results) != 2 || !slices.Equal(forwardedChanges(results), want) {
+		t.Errorf("expected only %v, got %v", want, results)
+	}
+	if guard.StaleCount() != 2 {
+		t.Errorf("expected 2 stale changes, got %d", guard.StaleCount())
+	}
+}
+
+func TestUpsertGuard_RejectStale(t *testing.T) {
+	guard := NewUpsertGuard(changeKey, changeVersion).WithRejectStale()
+	results := guardChanges(guard, change{"a", 3}, change{"a", 2}, change{"a", 4})
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	stale := results[1]
+	if !stale.IsError() || !errors.Is(stale.Error(), ErrStaleVersion) {
+		t.Fatalf("expected stale change rejected with ErrStaleVersion, got %+v", stale)
+	}
+	if stale.Error().Item != (change{"a", 2}) || stale.Error().ProcessorName != "upsert-guard" {
+		t.Errorf("expected error to carry the stale change, got %+v", stale.Error())
+	}
+	// A rejected change does not move the key's version
+	if results[2].IsError() || results[2].Value().Version != 4 {
+		t.Errorf("expected version 4 forwarded, got %+v", results[2])
+	}
+}
+
+func TestUpsertGuard_TracksKeysIndependently(t *testing.T) {
+	guard := NewUpsertGuard(changeKey, changeVersion)
+	results := guardChanges(guard, change{"a", 5}, change{"b", 1}, change{"b", 2}, change{"a", 4}, change{"b", 2}, change{"c", 1})
+
+	want := []change{{"a", 5}, {"b", 1}, {"b", 2}, {"c", 1}}
+	if got := forwardedChanges(results); !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestUpsertGuard_MaxKeysEvictsLeastRecent(t *testing.T) {
+	guard := NewUpsertGuard(changeKey, changeVersion).WithMaxKeys(2)
+	results := guardChanges(guard,
+		change{"a", 5}, change{"b", 5},
+		change{"a", 6}, // a is now the most recent
+		change{"c", 1}, // evicts b
+		change{"a", 1}, // still tracked: stale
+		change{"b", 1}, // forgotten: forwarded, evicts a
+	)
+
+	want := []change{{"a", 5}, {"b", 5}, {"a", 6}, {"c", 1}, {"b", 1}}
+	if got := forwardedChanges(results); !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if guard.EvictedCount() != 2 {
+		t.Errorf("expected 2 evictions, got %d", guard.EvictedCount())
+	}
+}
+
+func TestUpsertGuard_ErrorsPassThrough(t *testing.T) {
+	in := make(chan Result[change], 3)
+	in <- NewSuccess(change{"a", 2})
+	in <- NewError(change{"a", 1}, errors.New("decode failed"), "decoder")
+	in <- NewSuccess(change{"a", 3})
+	close(in)
+
+	var results []Result[change]
+	for result := range NewUpsertGuard(changeKey, changeVersion).Process(context.Background(), in) {
+		results = append(results, result)
+	}
+	if len(results) != 3 || !results[1].IsError() || results[1].Error().ProcessorName != "decoder" {
+		t.Errorf("expected the error passed through unchanged, got %+v", results)
+	}
+}
@@ -0,0 +1,196 @@
+package streamz
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// WeightedThrottle limits a stream by the summed cost of its items per interval
+// rather than by item count, modelling bandwidth-style limits where some items
+// are much heavier than others.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type WeightedThrottle[T any] struct {
+	name     string
+	costFn   func(T) int
+	budget   int
+	interval time.Duration
+	clock    Clock
+	drop     bool
+	dropped  atomic.Uint64
+}
+
+// NewWeightedThrottle creates a throttle that admits items until their summed cost
+// for the current interval reaches the budget. Intervals are fixed windows that
+// start with the first item. An item that does not fit the remaining budget is
+// held until the next interval begins, pausing upstream, or dropped when
+// configured with WithDropExcess(true).
+//
+// An item is always admitted into an interval that has admitted nothing yet, so
+// a single item costing more than the whole budget still passes, on its own.
+// Negative costs count as zero. Errors bypass the budget and pass through, even
+// while a success is held: errors arriving behind it are forwarded at once, and
+// input pauses only once a further success arrives.
+// A non-positive interval disables throttling.
+//
+// When to use:
+//   - Capping bytes per second sent to a network or storage sink
+//   - Limiting API usage where requests carry different quota costs
+//   - Smoothing load from items with highly variable processing cost
+//
+// Example:
+//
+//	// At most 10MB of payloads per second
+//	throttle := streamz.NewWeightedThrottle(func(m Message) int {
+//		return len(m.Payload)
+//	}, 10<<20, time.Second, streamz.RealClock)
+//
+//	limited := throttle.Process(ctx, messages)
+//
+// Parameters:
+//   - costFn: Returns the cost of an item against the budget
+//   - budgetPerInterval: Total cost admitted per interval
+//   - interval: Length of each budget interval
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new WeightedThrottle processor.
+func NewWeightedThrottle[T any](costFn func(T) int, budgetPerInterval int, interval time.Duration, clock Clock) *WeightedThrottle[T] {
+	return &WeightedThrottle[T]{
+		name:     "weighted-throttle",
+		costFn:   costFn,
+		budget:   budgetPerInterval,
+		interval: interval,
+		clock:    clock,
+	}
+}
+
+// WithDropExcess drops items that exceed the remaining budget instead of holding
+// them until the next interval.
+// If not set, defaults to false (items are delayed).
+func (w *WeightedThrottle[T]) WithDropExcess(drop bool) *WeightedThrottle[T] {
+	w.drop = drop
+	return w
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "weighted-throttle".
+func (w *WeightedThrottle[T]) WithName(name string) *WeightedThrottle[T] {
+	w.name = name
+	return w
+}
+
+// DroppedCount returns the number of items dropped for exceeding the budget.
+// Safe to call concurrently with Process.
+func (w *WeightedThrottle[T]) DroppedCount() uint64 {
+	return w.dropped.Load()
+}
+
+// Process admits items within the per-interval cost budget.
+func (w *WeightedThrottle[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		var windowStart time.Time
+		used := 0
+		started := false
+
+		// roll starts a new interval if the current one has ended
+		roll := func(now time.Time) {
+			if w.interval <= 0 {
+				// No interval to budget against
+				used = 0
+				return
+			}
+			if !started {
+				windowStart, used, started = now, 0, true
+				return
+			}
+			if elapsed := now.Sub(windowStart); elapsed >= w.interval {
+				// Keep windows aligned to the first interval
+				windowStart = windowStart.Add(elapsed - elapsed%w.interval)
+				used = 0
+			}
+		}
+
+		// A success read while another is held waits here, pausing input until handled
+		var pending Result[T]
+		hasPending := false
+		open := true
+
+		for {
+			var item Result[T]
+			if hasPending {
+				item, hasPending = pending, false
+			} else {
+				if !open {
+					return
+				}
+				var ok bool
+				if item, ok = receive(ctx, in); !ok {
+					return
+				}
+			}
+
+			if item.IsSuccess() {
+				cost := max(w.costFn(item.Value()), 0)
+				roll(w.clock.Now())
+
+				if used > 0 && used+cost > w.budget {
+					if w.drop {
+						w.dropped.Add(1)
+						continue
+					}
+
+					// Hold the item until the next interval begins, still passing errors through
+					timer := w.clock.NewTimer(windowStart.Add(w.interval).Sub(w.clock.Now()))
+					for holding := true; holding; {
+						input := in
+						if !open || hasPending {
+							input = nil
+						}
+						select {
+						case <-timer.C():
+							holding = false
+						case queued, ok := <-input:
+							if !ok {
+								open = false
+								continue
+							}
+							if queued.IsSuccess() {
+								pending, hasPending = queued, true
+								continue
+							}
+							select {
+							case out <- queued:
+							case <-ctx.Done():
+								timer.Stop()
+								return
+							}
+						case <-ctx.Done():
+							timer.Stop()
+							return
+						}
+					}
+					roll(w.clock.Now())
+				}
+				used += cost
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (w *WeightedThrottle[T]) Name() string {
+	return w.name
+}
@@ -0,0 +1,226 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+type payload struct {
+	ID   string
+	Size int
+}
+
+func payloadSize(p payload) int { return p.Size }
+
+// waitForTimer polls until the processor has a timer pending on the fake clock.
+func waitForTimer(t *testing.T, clock *clockz.FakeClock) {
+	t.Helper()
+	deadline := time.After(time.Second)
+	for !clock.HasWaiters() {
+		select {
+		case <-deadline:
+			t.Fatal("expected processor to wait on the clock")
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func TestWeightedThrottle_Name(t *testing.T) {
+	throttle := NewWeightedThrottle(payloadSize, 100, time.Second, RealClock)
+	if throttle.Name() != "weighted-throttle" {
+		t.Errorf("expected name 'weighted-throttle', got %q", throttle.Name())
+	}
+	if throttle.WithName("bandwidth").Name() != "bandwidth" {
+		t.Errorf("expected name 'bandwidth', got %q", throttle.Name())
+	}
+}
+
+func TestWeightedThrottle_UnderBudgetPasses(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[payload], 4)
+	in <- NewSuccess(payload{ID: "a", Size: 40})
+	in <- NewSuccess(payload{ID: "b", Size: 30})
+	in <- NewSuccess(payload{ID: "c", Size: 30})
+	close(in)
+
+	throttle := NewWeightedThrottle(payloadSize, 100, time.Second, clock)
+	var ids []string
+	for result := range throttle.Process(context.Background(), in) {
+		ids = append(ids, result.Value().ID)
+	}
+
+	if len(ids) != 3 {
+		t.Errorf("expected all items within budget to pass, got %v", ids)
+	}
+}
+
+func TestWeightedThrottle_HoldsUntilNextInterval(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	in := make(chan Result[payload])
+	out := NewWeightedThrottle(payloadSize, 100, time.Second, clock).Process(ctx, in)
+
+	in <- NewSuccess(payload{ID: "a", Size: 60})
+	<-out
+	clock.Advance(300 * time.Millisecond)
+
+	// Exceeds the remaining budget of 40
+	go func() { in <- NewSuccess(payload{ID: "b", Size: 50}) }()
+	waitForTimer(t, clock)
+
+	select {
+	case result := <-out:
+		t.Fatalf("expected item to be held, got %v", result)
+	default:
+	}
+
+	// The interval started with item a, so it ends 700ms from now
+	clock.Advance(700 * time.Millisecond)
+	clock.BlockUntilReady()
+
+	select {
+	case result := <-out:
+		if result.Value().ID != "b" {
+			t.Errorf("expected held item b, got %v", result)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected held item after interval reset")
+	}
+
+	// b consumed 50 of the new interval's budget
+	in <- NewSuccess(payload{ID: "c", Size: 50})
+	if result := <-out; result.Value().ID != "c" {
+		t.Errorf("expected item c to fit the new interval, got %v", result)
+	}
+	close(in)
+}
+
+func TestWeightedThrottle_DropExcess(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	in := make(chan Result[payload])
+	throttle := NewWeightedThrottle(payloadSize, 100, time.Second, clock).WithDropExcess(true)
+	out := throttle.Process(ctx, in)
+
+	in <- NewSuccess(payload{ID: "a", Size: 80})
+	<-out
+	in <- NewSuccess(payload{ID: "b", Size: 30}) // dropped
+	in <- NewSuccess(payload{ID: "c", Size: 20})
+	if result := <-out; result.Value().ID != "c" {
+		t.Errorf("expected item c to fit the remaining budget, got %v", result)
+	}
+
+	clock.Advance(time.Second)
+	in <- NewSuccess(payload{ID: "d", Size: 90})
+	if result := <-out; result.Value().ID != "d" {
+		t.Errorf("expected item d in the next interval, got %v", result)
+	}
+	close(in)
+
+	if throttle.DroppedCount() != 1 {
+		t.Errorf("expected 1 dropped item, got %d", throttle.DroppedCount())
+	}
+}
+
+func TestWeightedThrottle_ErrorsBypassBudget(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	in := make(chan Result[payload])
+	out := NewWeightedThrottle(payloadSize, 100, time.Second, clock).WithDropExcess(true).Process(ctx, in)
+
+	in <- NewSuccess(payload{ID: "a", Size: 100})
+	<-out
+	for i := 0; i < 3; i++ {
+		in <- NewError(payload{ID: "bad", Size: 1000}, errors.New("upload failed"), "uploader")
+		if result := <-out; !result.IsError() {
+			t.Errorf("expected error to pass through, got %v", result)
+		}
+	}
+	close(in)
+}
+
+func TestWeightedThrottle_ErrorsPassWhileHolding(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	in := make(chan Result[payload])
+	out := NewWeightedThrottle(payloadSize, 100, time.Second, clock).Process(ctx, in)
+
+	in <- NewSuccess(payload{ID: "a", Size: 60})
+	<-out
+
+	// b exceeds the remaining budget and is held
+	in <- NewSuccess(payload{ID: "b", Size: 50})
+	waitForTimer(t, clock)
+
+	// An error behind the held item is not delayed by it
+	in <- NewError(payload{ID: "bad"}, errors.New("upload failed"), "uploader")
+	select {
+	case result := <-out:
+		if !result.IsError() {
+			t.Fatalf("expected error to pass the held item, got %v", result)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected error forwarded while b is held")
+	}
+
+	// A further success waits behind b
+	in <- NewSuccess(payload{ID: "c", Size: 50})
+	clock.Advance(time.Second)
+	clock.BlockUntilReady()
+
+	for _, want := range []string{"b", "c"} {
+		select {
+		case result := <-out:
+			if result.Value().ID != want {
+				t.Errorf("expected %s, got %v", want, result)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("expected %s after the interval reset", want)
+		}
+	}
+	close(in)
+	if _, ok := <-out; ok {
+		t.Error("expected output closed")
+	}
+}
+
+func TestWeightedThrottle_OversizedItemAlone(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[payload], 1)
+	in <- NewSuccess(payload{ID: "huge", Size: 500})
+	close(in)
+
+	var ids []string
+	for result := range NewWeightedThrottle(payloadSize, 100, time.Second, clock).Process(context.Background(), in) {
+		ids = append(ids, result.Value().ID)
+	}
+	if len(ids) != 1 {
+		t.Errorf("expected oversized item admitted into an empty interval, got %v", ids)
+	}
+}
+
+func TestWeightedThrottle_CancelWhileHolding(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Result[payload], 2)
+	in <- NewSuccess(payload{ID: "a", Size: 100})
+	in <- NewSuccess(payload{ID: "b", Size: 100})
+	out := NewWeightedThrottle(payloadSize, 100, time.Second, clock).Process(ctx, in)
+
+	<-out
+	waitForTimer(t, clock)
+	cancel()
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Error("expected no item after cancellation")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected output to close after cancellation")
+	}
+}
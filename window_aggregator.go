@@ -0,0 +1,225 @@
+package streamz
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+)
+
+// IncrementalWindowAggregator folds windowed Results into one accumulator per
+// window as they arrive, emitting only each window's final accumulator. Unlike
+// WindowCollector it never holds the Results themselves, so memory stays
+// constant per window however many items it contains. It suits aggregations
+// that can be computed incrementally, such as counts, sums, and extremes.
+//
+// By default it reads windows from upstream metadata, but the window processors
+// that attach it buffer every item of a window themselves. WithTumbling assigns
+// windows from the clock instead, so nothing upstream retains the items either.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type IncrementalWindowAggregator[T, A any] struct {
+	name  string
+	init  A
+	step  func(A, Result[T]) A
+	size  time.Duration
+	clock Clock
+}
+
+// windowAccumulator is the running aggregate of one open window.
+type windowAccumulator[A any] struct {
+	meta WindowMetadata
+	acc  A
+}
+
+// NewIncrementalWindowAggregator creates a processor that aggregates Results by window.
+// Unless WithTumbling is set, input must carry window metadata, as emitted by
+// TumblingWindow, SlidingWindow, or SessionWindow; Results without it are
+// skipped. Each window starts from init, and step folds every Result of the
+// window, success or error, into it.
+//
+// A window is complete once a Result from a window ending later arrives, since
+// window processors emit each window in full before moving on, or, with
+// WithTumbling, once the clock reaches its end. Its accumulator is then emitted
+// as a success Result carrying the window metadata. Windows still open when the
+// input closes are emitted at that point; context cancellation
+// stops processing without emitting them.
+// Accumulators are emitted in order of window end, then window start.
+//
+// init is copied into each new window, so it should be a value type; step should
+// return an updated copy rather than mutating shared state such as maps.
+//
+// When to use:
+//   - Counting, summing, or tracking min/max over large windows
+//   - Computing per-window error rates without retaining every Result
+//   - Replacing WindowCollector followed by a reduce to save memory
+//
+// Example:
+//
+//	type Stats struct{ Count, Errors int; Total float64 }
+//
+//	window := streamz.NewTumblingWindow[Order](time.Minute, streamz.RealClock)
+//	totals := streamz.NewIncrementalWindowAggregator(Stats{}, func(s Stats, r streamz.Result[Order]) Stats {
+//		s.Count++
+//		if r.IsError() {
+//			s.Errors++
+//		} else {
+//			s.Total += r.Value().Amount
+//		}
+//		return s
+//	})
+//
+//	for result := range totals.Process(ctx, window.Process(ctx, orders)) {
+//		meta, _ := streamz.GetWindowMetadata(result)
+//		fmt.Printf("%s: %+v\n", meta.Start.Format("15:04"), result.Value())
+//	}
+//
+//	// Or window by arrival time without buffering anything upstream
+//	totals = totals.WithTumbling(time.Minute, streamz.RealClock)
+//	for result := range totals.Process(ctx, orders) {
+//		// same as above
+//	}
+//
+// Parameters:
+//   - init: Initial accumulator value for every window
+//   - step: Folds one Result into a window's accumulator
+//
+// Returns a new IncrementalWindowAggregator processor.
+func NewIncrementalWindowAggregator[T, A any](init A, step func(A, Result[T]) A) *IncrementalWindowAggregator[T, A] {
+	return &IncrementalWindowAggregator[T, A]{
+		name: "incremental-window-aggregator",
+		init: init,
+		step: step,
+	}
+}
+
+// WithTumbling makes the aggregator assign windows itself instead of reading
+// window metadata: each Result is folded into the tumbling window of the given
+// size containing its arrival time on clock, with boundaries at whole multiples
+// of size since the Unix epoch. A window's accumulator is emitted when the clock
+// reaches its end, tagged with metadata of type "tumbling", so only one
+// accumulator is held at a time and no Results are retained anywhere.
+// Sizes that are not positive leave the aggregator reading upstream metadata,
+// which is the default.
+func (w *IncrementalWindowAggregator[T, A]) WithTumbling(size time.Duration, clock Clock) *IncrementalWindowAggregator[T, A] {
+	w.size = size
+	w.clock = clock
+	return w
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "incremental-window-aggregator".
+func (w *IncrementalWindowAggregator[T, A]) WithName(name string) *IncrementalWindowAggregator[T, A] {
+	w.name = name
+	return w
+}
+
+// Process folds Results into per-window accumulators and emits each window once complete.
+func (w *IncrementalWindowAggregator[T, A]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[A] {
+	out := make(chan Result[A])
+
+	go func() {
+		defer close(out)
+
+		open := make(map[windowKey]*windowAccumulator[A])
+		tumbling := w.size > 0 && w.clock != nil
+
+		// Self-assigned windows close on the clock at their end
+		var timer Timer
+		var timerC <-chan time.Time
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+
+		// emitBefore emits, in window order, every open window that passes done.
+		emitBefore := func(done func(WindowMetadata) bool) bool {
+			var ready []*windowAccumulator[A]
+			for key, window := range open {
+				if done(window.meta) {
+					ready = append(ready, window)
+					delete(open, key)
+				}
+			}
+			slices.SortFunc(ready, func(a, b *windowAccumulator[A]) int {
+				if c := a.meta.End.Compare(b.meta.End); c != 0 {
+					return c
+				}
+				return cmp.Compare(a.meta.Start.UnixNano(), b.meta.Start.UnixNano())
+			})
+
+			for _, window := range ready {
+				select {
+				case out <- AddWindowMetadata(NewSuccess(window.acc), window.meta):
+				case <-ctx.Done():
+					return false
+				}
+			}
+			return true
+		}
+		all := func(WindowMetadata) bool { return true }
+
+		for {
+			select {
+			case result, ok := <-in:
+				if !ok {
+					emitBefore(all)
+					return
+				}
+
+				meta, err := w.window(result)
+				if err != nil {
+					continue
+				}
+
+				// Windows ending before this one have been emitted in full upstream
+				if !emitBefore(func(open WindowMetadata) bool { return open.End.Before(meta.End) }) {
+					return
+				}
+
+				key := windowKey{startNano: meta.Start.UnixNano(), endNano: meta.End.UnixNano()}
+				window, exists := open[key]
+				if !exists {
+					window = &windowAccumulator[A]{meta: meta, acc: w.init}
+					open[key] = window
+					if tumbling {
+						if timer != nil {
+							timer.Stop()
+						}
+						timer = w.clock.NewTimer(meta.End.Sub(w.clock.Now()))
+						timerC = timer.C()
+					}
+				}
+				window.acc = w.step(window.acc, result)
+
+			case <-timerC:
+				timerC = nil
+				now := w.clock.Now()
+				if !emitBefore(func(open WindowMetadata) bool { return !open.End.After(now) }) {
+					return
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// window returns the window a Result belongs to, from the clock when tumbling
+// and from its metadata otherwise.
+func (w *IncrementalWindowAggregator[T, A]) window(result Result[T]) (WindowMetadata, error) {
+	if w.size <= 0 || w.clock == nil {
+		return GetWindowMetadata(result)
+	}
+	start := alignToEpoch(w.clock.Now(), w.size)
+	return WindowMetadata{Start: start, End: start.Add(w.size), Type: "tumbling", Size: w.size}, nil
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (w *IncrementalWindowAggregator[T, A]) Name() string {
+	return w.name
+}
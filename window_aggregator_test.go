@@ -0,0 +1,230 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+type windowStats struct {
+	Count, Errors, Sum int
+}
+
+func stepWindowStats(s windowStats, r Result[int]) windowStats {
+	s.Count++
+	if r.IsError() {
+		s.Errors++
+	} else {
+		s.Sum += r.Value()
+	}
+	return s
+}
+
+// tumblingResults runs values through a one-minute TumblingWindow on a fake
+// clock, closing a window after every perWindow values, and returns its output.
+func tumblingResults(t *testing.T, values []int, perWindow int) []Result[int] {
+	t.Helper()
+	clock := clockz.NewFakeClock()
+	window := NewTumblingWindow[int](time.Minute, clock)
+
+	in := make(chan Result[int])
+	out := window.Process(context.Background(), in)
+
+	var results []Result[int]
+	collected := make(chan struct{})
+	go func() {
+		defer close(collected)
+		for result := range out {
+			results = append(results, result)
+		}
+	}()
+
+	for i, v := range values {
+		if v < 0 {
+			in <- NewError(v, errors.New("negative"), "source")
+		} else {
+			in <- NewSuccess(v)
+		}
+		if (i+1)%perWindow == 0 {
+			// Which window a boundary item lands in does not matter here: both
+			// approaches under comparison consume the same recorded output
+			clock.Advance(time.Minute)
+			clock.BlockUntilReady()
+		}
+	}
+	close(in)
+	<-collected
+	return results
+}
+
+func TestIncrementalWindowAggregator_Name(t *testing.T) {
+	agg := NewIncrementalWindowAggregator(windowStats{}, stepWindowStats)
+	if agg.Name() != "incremental-window-aggregator" {
+		t.Errorf("expected name 'incremental-window-aggregator', got %q", agg.Name())
+	}
+	if agg.WithName("order-totals").Name() != "order-totals" {
+		t.Errorf("expected name 'order-totals', got %q", agg.Name())
+	}
+}
+
+func TestIncrementalWindowAggregator_MatchesBufferThenReduce(t *testing.T) {
+	values := make([]int, 0, 300)
+	for i := 0; i < 300; i++ {
+		if i%7 == 0 {
+			values = append(values, -i)
+		} else {
+			values = append(values, i)
+		}
+	}
+	windowed := tumblingResults(t, values, 100)
+
+	feed := func() <-chan Result[int] {
+		ch := make(chan Result[int], len(windowed))
+		for _, r := range windowed {
+			ch <- r
+		}
+		close(ch)
+		return ch
+	}
+	ctx := context.Background()
+
+	// Buffer-then-reduce reference
+	want := make(map[time.Time]windowStats)
+	for collection := range NewWindowCollector[int]().Process(ctx, feed()) {
+		stats := windowStats{}
+		for _, r := range collection.Results {
+			stats = stepWindowStats(stats, r)
+		}
+		want[collection.Start] = stats
+	}
+
+	got := make(map[time.Time]windowStats)
+	var previousEnd time.Time
+	for result := range NewIncrementalWindowAggregator(windowStats{}, stepWindowStats).Process(ctx, feed()) {
+		meta, err := GetWindowMetadata(result)
+		if err != nil {
+			t.Fatalf("expected window metadata: %v", err)
+		}
+		if meta.End.Before(previousEnd) {
+			t.Errorf("expected windows in order, got %v after %v", meta.End, previousEnd)
+		}
+		previousEnd = meta.End
+		got[meta.Start] = result.Value()
+	}
+
+	if len(want) == 0 || len(got) != len(want) {
+		t.Fatalf("expected %d windows, got %d", len(want), len(got))
+	}
+	for start, stats := range want {
+		if got[start] != stats {
+			t.Errorf("window %v: expected %+v, got %+v", start, stats, got[start])
+		}
+	}
+}
+
+func TestIncrementalWindowAggregator_EmitsWithoutWaitingForClose(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	first := WindowMetadata{Start: start, End: start.Add(time.Minute), Type: "tumbling", Size: time.Minute}
+	second := WindowMetadata{Start: first.End, End: first.End.Add(time.Minute), Type: "tumbling", Size: time.Minute}
+
+	in := make(chan Result[int])
+	out := NewIncrementalWindowAggregator(windowStats{}, stepWindowStats).Process(context.Background(), in)
+
+	// Results carry only their window's accumulator, never a buffer of items
+	for i := 1; i <= 1000; i++ {
+		in <- AddWindowMetadata(NewSuccess(i), first)
+	}
+	in <- NewSuccess(0) // no window metadata, skipped
+	in <- AddWindowMetadata(NewSuccess(5), second)
+
+	result := <-out
+	if result.Value() != (windowStats{Count: 1000, Sum: 500500}) {
+		t.Errorf("expected first window emitted once the second began, got %+v", result.Value())
+	}
+	if meta, _ := GetWindowMetadata(result); !meta.Start.Equal(first.Start) {
+		t.Errorf("expected first window metadata, got start %v", meta.Start)
+	}
+
+	close(in)
+	if result := <-out; result.Value() != (windowStats{Count: 1, Sum: 5}) {
+		t.Errorf("expected last window flushed on close, got %+v", result.Value())
+	}
+	if _, ok := <-out; ok {
+		t.Error("expected output closed")
+	}
+}
+
+func TestIncrementalWindowAggregator_TumblingClosesOnClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := clockz.NewFakeClockAt(start.Add(10 * time.Second))
+
+	in := make(chan Result[int])
+	out := NewIncrementalWindowAggregator(windowStats{}, stepWindowStats).
+		WithTumbling(time.Minute, clock).
+		Process(context.Background(), in)
+
+	// Upstream window metadata is ignored in favor of arrival time
+	stale := WindowMetadata{Start: start.Add(-time.Hour), End: start, Type: "tumbling", Size: time.Minute}
+	for i := 1; i <= 100; i++ {
+		in <- AddWindowMetadata(NewSuccess(i), stale)
+	}
+	in <- NewError(-1, errors.New("negative"), "source")
+	waitForTimer(t, clock)
+
+	clock.Advance(50 * time.Second)
+	clock.BlockUntilReady()
+
+	result := <-out
+	if result.Value() != (windowStats{Count: 101, Errors: 1, Sum: 5050}) {
+		t.Errorf("expected first window emitted at its end, got %+v", result.Value())
+	}
+	meta, err := GetWindowMetadata(result)
+	if err != nil || !meta.Start.Equal(start) || !meta.End.Equal(start.Add(time.Minute)) || meta.Type != "tumbling" {
+		t.Errorf("expected aligned window [%v, %v), got %+v (%v)", start, start.Add(time.Minute), meta, err)
+	}
+
+	in <- NewSuccess(7)
+	close(in)
+	if result := <-out; result.Value() != (windowStats{Count: 1, Sum: 7}) {
+		t.Errorf("expected second window flushed on close, got %+v", result.Value())
+	}
+	if _, ok := <-out; ok {
+		t.Error("expected output closed")
+	}
+}
+
+func TestIncrementalWindowAggregator_TumblingRetainsNoItems(t *testing.T) {
+	const items, payload = 1000, 32 << 10 // 32 MiB if every item were kept
+
+	// The fixed-size accumulator must not grow with the window's contents
+
+	clock := clockz.NewFakeClock()
+	in := make(chan Result[[]byte])
+	out := NewIncrementalWindowAggregator(0, func(total int, r Result[[]byte]) int {
+		return total + len(r.Value())
+	}).WithTumbling(time.Minute, clock).Process(context.Background(), in)
+
+	var before, during runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	// Unbuffered sends return once each item has been folded into the open window
+	for i := 0; i < items; i++ {
+		in <- NewSuccess(make([]byte, payload))
+	}
+
+	runtime.GC()
+	runtime.ReadMemStats(&during)
+	if grown := int64(during.HeapAlloc) - int64(before.HeapAlloc); grown > items*payload/4 {
+		t.Errorf("expected open window to retain no items, heap grew by %d bytes", grown)
+	}
+
+	close(in)
+	if result := <-out; result.Value() != items*payload {
+		t.Errorf("expected %d bytes aggregated, got %d", items*payload, result.Value())
+	}
+}
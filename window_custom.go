@@ -0,0 +1,263 @@
+package streamz
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"time"
+)
+
+// LatenessStats classifies the items a CustomWindow has seen against its watermark.
+type LatenessStats struct {
+	OnTime  int64 // Items at or ahead of the watermark when they arrived
+	Late    int64 // Items behind the watermark whose window was still open, accepted into it
+	Dropped int64 // Items that arrived after their window closed
+}
+
+// CustomWindow groups items into windows chosen by a user-supplied assignment
+// function, for boundaries the fixed-size windows cannot express, such as
+// calendar months or business days. It is the most general windowing primitive:
+// any window whose bounds can be computed from a single timestamp fits.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type CustomWindow[T any] struct {
+	name     string
+	assign   func(time.Time) (start, end time.Time)
+	tsFn     func(T) time.Time
+	clock    Clock
+	lateness time.Duration
+	dropLate bool
+
+	onTime  atomic.Int64
+	late    atomic.Int64
+	dropped atomic.Int64
+}
+
+// NewCustomWindow creates a processor that groups items by assigned window.
+// Each item's timestamp comes from tsFn and is mapped by assign to the bounds
+// of its window; items with the same bounds are collected together. Windows are
+// emitted as WindowCollections of type "custom", in order of their end, and
+// each Result in a collection carries the window metadata.
+//
+// With a timestamp function, windows close as event time advances: the watermark
+// is the latest timestamp seen, and a window is emitted once the watermark reaches
+// its end plus the allowed lateness (zero unless set with WithAllowedLateness).
+// With a nil tsFn, items are timestamped by the clock at arrival and windows close
+// when the clock reaches that point. Windows still open when the input closes are
+// flushed. An item whose window has already closed is emitted promptly in a
+// collection of its own, or discarded under WithDropLate. LatenessStats reports
+// how items were classified.
+//
+// Errors are assigned by the timestamp of the item they carry.
+//
+// When to use:
+//   - Calendar-aligned aggregations such as months, quarters, or weeks
+//   - Business-day or shift-based windows with irregular lengths
+//   - Grouping replayed historical data by event time
+//
+// Example:
+//
+//	// Monthly windows by order date
+//	monthly := streamz.NewCustomWindow(func(t time.Time) (time.Time, time.Time) {
+//		start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+//		return start, start.AddDate(0, 1, 0)
+//	}, func(o Order) time.Time {
+//		return o.PlacedAt
+//	}, streamz.RealClock)
+//
+//	for month := range monthly.Process(ctx, orders) {
+//		report.Monthly(month.Start, month.Values(), month.Errors())
+//	}
+//
+// Parameters:
+//   - assign: Maps a timestamp to the start and end of its window
+//   - tsFn: Extracts event time from an item; nil uses the clock at arrival
+//   - clock: Clock interface for time operations (use RealClock in production)
+//
+// Returns a new CustomWindow processor.
+func NewCustomWindow[T any](assign func(eventTime time.Time) (start, end time.Time), tsFn func(T) time.Time, clock Clock) *CustomWindow[T] {
+	return &CustomWindow[T]{
+		name:   "custom-window",
+		assign: assign,
+		tsFn:   tsFn,
+		clock:  clock,
+	}
+}
+
+// WithAllowedLateness keeps each window open until the watermark passes its end
+// by lateness, so items behind the watermark can still join it.
+// If not set, defaults to 0: windows close as soon as the watermark reaches their end.
+func (w *CustomWindow[T]) WithAllowedLateness(lateness time.Duration) *CustomWindow[T] {
+	w.lateness = lateness
+	return w
+}
+
+// WithDropLate discards items whose window has already closed instead of
+// emitting each in a collection of its own. Dropped items are still counted.
+func (w *CustomWindow[T]) WithDropLate() *CustomWindow[T] {
+	w.dropLate = true
+	return w
+}
+
+// LatenessStats returns how many items arrived on time, late but within their
+// window, and after their window closed.
+// Safe to call concurrently with Process.
+func (w *CustomWindow[T]) LatenessStats() LatenessStats {
+	return LatenessStats{
+		OnTime:  w.onTime.Load(),
+		Late:    w.late.Load(),
+		Dropped: w.dropped.Load(),
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "custom-window".
+func (w *CustomWindow[T]) WithName(name string) *CustomWindow[T] {
+	w.name = name
+	return w
+}
+
+// Process groups items into their assigned windows and emits each window once it closes.
+// Cancellation stops processing without flushing open windows.
+func (w *CustomWindow[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan WindowCollection[T] {
+	out := make(chan WindowCollection[T])
+
+	go func() {
+		defer close(out)
+
+		windows := make(map[windowKey]*WindowCollection[T])
+
+		// Latest event time seen; a window closes once it reaches the end plus lateness
+		var watermark time.Time
+
+		// Processing-time windows close on the clock, armed for the earliest end
+		var timer Timer
+		var timerC <-chan time.Time
+		var armedFor time.Time
+		rearm := func() {
+			if w.tsFn != nil {
+				return
+			}
+			var earliest time.Time
+			for _, window := range windows {
+				if closes := window.End.Add(w.lateness); earliest.IsZero() || closes.Before(earliest) {
+					earliest = closes
+				}
+			}
+			if earliest.Equal(armedFor) && timerC != nil {
+				return
+			}
+			if timer != nil {
+				timer.Stop()
+				timerC = nil
+			}
+			armedFor = earliest
+			if !earliest.IsZero() {
+				timer = w.clock.NewTimer(earliest.Sub(w.clock.Now()))
+				timerC = timer.C()
+			}
+		}
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+
+		// emit sends every window closed by limit, or all windows if flush is set.
+		emit := func(limit time.Time, flush bool) bool {
+			var closed []*WindowCollection[T]
+			for key, window := range windows {
+				if flush || !window.End.Add(w.lateness).After(limit) {
+					closed = append(closed, window)
+					delete(windows, key)
+				}
+			}
+			slices.SortFunc(closed, func(a, b *WindowCollection[T]) int {
+				if c := a.End.Compare(b.End); c != 0 {
+					return c
+				}
+				return a.Start.Compare(b.Start)
+			})
+			for _, window := range closed {
+				select {
+				case out <- *window:
+				case <-ctx.Done():
+					return false
+				}
+			}
+			return true
+		}
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					emit(time.Time{}, true)
+					return
+				}
+
+				ts := w.timestamp(item)
+				start, end := w.assign(ts)
+				switch {
+				case !end.Add(w.lateness).After(watermark):
+					w.dropped.Add(1)
+					if w.dropLate {
+						continue
+					}
+				case ts.Before(watermark):
+					w.late.Add(1)
+				default:
+					w.onTime.Add(1)
+				}
+
+				key := windowKey{startNano: start.UnixNano(), endNano: end.UnixNano()}
+				window, exists := windows[key]
+				if !exists {
+					meta := WindowMetadata{Start: start, End: end, Type: "custom", Size: end.Sub(start)}
+					window = &WindowCollection[T]{Start: start, End: end, Meta: meta}
+					windows[key] = window
+				}
+				window.Results = append(window.Results, AddWindowMetadata(item, window.Meta))
+
+				if ts.After(watermark) {
+					watermark = ts
+				}
+				if !emit(watermark, false) {
+					return
+				}
+				rearm()
+
+			case <-timerC:
+				timerC = nil
+				if now := w.clock.Now(); now.After(watermark) {
+					watermark = now
+				}
+				if !emit(watermark, false) {
+					return
+				}
+				rearm()
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// timestamp returns the event time of an item, falling back to the clock.
+func (w *CustomWindow[T]) timestamp(item Result[T]) time.Time {
+	if w.tsFn == nil {
+		return w.clock.Now()
+	}
+	if item.IsError() {
+		return w.tsFn(item.Error().Item)
+	}
+	return w.tsFn(item.Value())
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (w *CustomWindow[T]) Name() string {
+	return w.name
+}
@@ -0,0 +1,217 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+type order struct {
+	ID       int
+	PlacedAt time.Time
+}
+
+func orderTime(o order) time.Time { return o.PlacedAt }
+
+func calendarMonth(t time.Time) (start, end time.Time) {
+	start = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	return start, start.AddDate(0, 1, 0)
+}
+
+func day(month time.Month, d int) time.Time {
+	return time.Date(2024, month, d, 12, 0, 0, 0, time.UTC)
+}
+
+func orderIDs(collection WindowCollection[order]) []int {
+	ids := make([]int, 0, len(collection.Results))
+	for _, result := range collection.Results {
+		if result.IsError() {
+			ids = append(ids, result.Error().Item.ID)
+		} else {
+			ids = append(ids, result.Value().ID)
+		}
+	}
+	return ids
+}
+
+func receiveWindow(t *testing.T, out <-chan WindowCollection[order]) WindowCollection[order] {
+	t.Helper()
+	select {
+	case collection, ok := <-out:
+		if !ok {
+			t.Fatal("expected a window, output closed")
+		}
+		return collection
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for window")
+		return WindowCollection[order]{}
+	}
+}
+
+func TestCustomWindow_Name(t *testing.T) {
+	window := NewCustomWindow(calendarMonth, orderTime, RealClock)
+	if window.Name() != "custom-window" {
+		t.Errorf("expected name 'custom-window', got %q", window.Name())
+	}
+	if window.WithName("monthly").Name() != "monthly" {
+		t.Errorf("expected name 'monthly', got %q", window.Name())
+	}
+}
+
+func TestCustomWindow_GroupsByCalendarMonth(t *testing.T) {
+	in := make(chan Result[order])
+	out := NewCustomWindow(calendarMonth, orderTime, RealClock).Process(context.Background(), in)
+
+	go func() {
+		defer close(in)
+		in <- NewSuccess(order{1, day(time.January, 5)})
+		in <- NewSuccess(order{2, day(time.January, 31)})
+		in <- NewError(order{3, day(time.January, 20)}, errors.New("declined"), "payments")
+		in <- NewSuccess(order{4, day(time.February, 1)})
+		in <- NewSuccess(order{5, day(time.February, 29)})
+		in <- NewSuccess(order{6, day(time.March, 2)})
+	}()
+
+	january := receiveWindow(t, out)
+	if got := orderIDs(january); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("expected January orders [1 2 3], got %v", got)
+	}
+	jan, feb := calendarMonth(day(time.January, 1))
+	if !january.Start.Equal(jan) || !january.End.Equal(feb) {
+		t.Errorf("expected January bounds [%v, %v), got [%v, %v)", jan, feb, january.Start, january.End)
+	}
+	if january.Meta.Type != "custom" || january.Meta.Size != 31*24*time.Hour {
+		t.Errorf("expected custom window of 31 days, got %s of %v", january.Meta.Type, january.Meta.Size)
+	}
+	meta, err := GetWindowMetadata(january.Results[0])
+	if err != nil || !meta.Start.Equal(jan) || !meta.End.Equal(feb) {
+		t.Errorf("expected January metadata on each Result, got %+v (%v)", meta, err)
+	}
+	if len(january.Values()) != 2 || len(january.Errors()) != 1 {
+		t.Errorf("expected 2 values and 1 error in January, got %d and %d", len(january.Values()), len(january.Errors()))
+	}
+
+	february := receiveWindow(t, out)
+	if got := orderIDs(february); !slices.Equal(got, []int{4, 5}) {
+		t.Errorf("expected February orders [4 5], got %v", got)
+	}
+	if february.Meta.Size != 29*24*time.Hour {
+		t.Errorf("expected leap February of 29 days, got %v", february.Meta.Size)
+	}
+
+	// The open March window is flushed when the input closes
+	march := receiveWindow(t, out)
+	if got := orderIDs(march); !slices.Equal(got, []int{6}) {
+		t.Errorf("expected March orders [6], got %v", got)
+	}
+	if _, ok := <-out; ok {
+		t.Error("expected output closed")
+	}
+}
+
+func TestCustomWindow_LateItemEmittedAlone(t *testing.T) {
+	in := make(chan Result[order])
+	out := NewCustomWindow(calendarMonth, orderTime, RealClock).Process(context.Background(), in)
+
+	go func() {
+		defer close(in)
+		in <- NewSuccess(order{1, day(time.January, 5)})
+		in <- NewSuccess(order{2, day(time.February, 3)})
+		in <- NewSuccess(order{3, day(time.January, 9)})
+	}()
+
+	if got := orderIDs(receiveWindow(t, out)); !slices.Equal(got, []int{1}) {
+		t.Errorf("expected January orders [1], got %v", got)
+	}
+	late := receiveWindow(t, out)
+	if got := orderIDs(late); !slices.Equal(got, []int{3}) || late.Start.Month() != time.January {
+		t.Errorf("expected late January order [3] on its own, got %v in %v", got, late.Start.Month())
+	}
+	if got := orderIDs(receiveWindow(t, out)); !slices.Equal(got, []int{2}) {
+		t.Errorf("expected February orders [2], got %v", got)
+	}
+}
+
+func TestCustomWindow_ProcessingTimeClosesOnClock(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	clock := clockz.NewFakeClockAt(start)
+	minute := func(t time.Time) (time.Time, time.Time) {
+		start := t.Truncate(time.Minute)
+		return start, start.Add(time.Minute)
+	}
+
+	in := make(chan Result[order])
+	out := NewCustomWindow[order](minute, nil, clock).Process(context.Background(), in)
+
+	in <- NewSuccess(order{ID: 1})
+	in <- NewSuccess(order{ID: 2})
+	// The send above only returns once item 1 is windowed, and this one once item 2 is
+	in <- NewSuccess(order{ID: 3})
+	waitForTimer(t, clock)
+
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+
+	// Item 3 may be stamped before or after the boundary
+	window := receiveWindow(t, out)
+	if !window.Start.Equal(start) || !window.End.Equal(start.Add(time.Minute)) {
+		t.Errorf("expected window [%v, %v), got [%v, %v)", start, start.Add(time.Minute), window.Start, window.End)
+	}
+	got := orderIDs(window)
+	if len(got) < 2 || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("expected window to start with orders [1 2], got %v", got)
+	}
+
+	close(in)
+	for rest := range out {
+		got = append(got, orderIDs(rest)...)
+	}
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("expected every order windowed once, got %v", got)
+	}
+}
+
+func TestCustomWindow_LatenessStats(t *testing.T) {
+	for _, dropLate := range []bool{false, true} {
+		window := NewCustomWindow(calendarMonth, orderTime, RealClock).WithAllowedLateness(5 * 24 * time.Hour)
+		if dropLate {
+			window = window.WithDropLate()
+		}
+
+		in := make(chan Result[order])
+		out := window.Process(context.Background(), in)
+		go func() {
+			defer close(in)
+			in <- NewSuccess(order{1, day(time.January, 10)})  // on time
+			in <- NewSuccess(order{2, day(time.January, 5)})   // late, January still open
+			in <- NewSuccess(order{3, day(time.February, 3)})  // on time, within January's lateness
+			in <- NewSuccess(order{4, day(time.January, 20)})  // late, January still open
+			in <- NewSuccess(order{5, day(time.February, 10)}) // on time, closes January
+			in <- NewSuccess(order{6, day(time.January, 25)})  // January already closed
+			in <- NewSuccess(order{7, day(time.February, 8)})  // late, February still open
+		}()
+
+		if got := orderIDs(receiveWindow(t, out)); !slices.Equal(got, []int{1, 2, 4}) {
+			t.Errorf("dropLate=%v: expected January orders [1 2 4], got %v", dropLate, got)
+		}
+		if !dropLate {
+			if got := orderIDs(receiveWindow(t, out)); !slices.Equal(got, []int{6}) {
+				t.Errorf("expected too-late order [6] on its own, got %v", got)
+			}
+		}
+		if got := orderIDs(receiveWindow(t, out)); !slices.Equal(got, []int{3, 5, 7}) {
+			t.Errorf("dropLate=%v: expected February orders [3 5 7], got %v", dropLate, got)
+		}
+		if _, ok := <-out; ok {
+			t.Errorf("dropLate=%v: expected output closed", dropLate)
+		}
+
+		if stats := window.LatenessStats(); stats != (LatenessStats{OnTime: 3, Late: 3, Dropped: 1}) {
+			t.Errorf("dropLate=%v: expected 3 on time, 3 late, 1 dropped, got %+v", dropLate, stats)
+		}
+	}
+}
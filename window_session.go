@@ -2,6 +2,7 @@ package streamz
 
 import (
 	"context"
+	"sort"
 	"time"
 )
 
@@ -31,6 +32,7 @@ type SessionWindow[T any] struct {
 	clock   Clock
 	keyFunc func(Result[T]) string // Extract session key from Result
 	gap     time.Duration
+	less    func(a, b WindowMetadata) bool // Optional emission order for simultaneous closes
 }
 
 // sessionState tracks enhanced session state for the single-goroutine architecture.
@@ -136,6 +138,25 @@ func (w *SessionWindow[T]) WithGap(gap time.Duration) *SessionWindow[T] {
 	return w
 }
 
+// WithOrderedEmission sets a deterministic emission order for sessions that close together.
+// Sessions expiring in the same check, or flushed when processing ends, are emitted
+// in the order defined by less instead of map iteration order. Results within a
+// session always keep their arrival order.
+//
+// Example:
+//
+//	// Emit simultaneously closing sessions by start time, then by key
+//	window.WithOrderedEmission(func(a, b streamz.WindowMetadata) bool {
+//		if !a.Start.Equal(b.Start) {
+//			return a.Start.Before(b.Start)
+//		}
+//		return *a.SessionKey < *b.SessionKey
+//	})
+func (w *SessionWindow[T]) WithOrderedEmission(less func(a, b WindowMetadata) bool) *SessionWindow[T] {
+	w.less = less
+	return w
+}
+
 // WithName sets a custom name for this processor.
 // If not set, defaults to "session-window".
 func (w *SessionWindow[T]) WithName(name string) *SessionWindow[T] {
@@ -233,23 +254,21 @@ func (w *SessionWindow[T]) Process(ctx context.Context, in <-chan Result[T]) <-c
 			case <-ticker.C():
 				// Periodic session expiry check
 				now := w.clock.Now()
-				expiredKeys := make([]string, 0)
+				expired := make([]*sessionState[T], 0)
 
 				for key, session := range sessions {
 					if now.Sub(session.lastActivity) >= w.gap {
-						expiredKeys = append(expiredKeys, key)
-
-						// Update final end time in metadata for emission
-						finalMeta := session.meta
-						finalMeta.End = session.currentEndTime
-
-						w.emitWindowResults(ctx, out, session.results, finalMeta)
+						expired = append(expired, session)
+						delete(sessions, key)
 					}
 				}
 
-				// Clean up expired sessions
-				for _, key := range expiredKeys {
-					delete(sessions, key)
+				for _, session := range w.orderSessions(expired) {
+					// Update final end time in metadata for emission
+					finalMeta := session.meta
+					finalMeta.End = session.currentEndTime
+
+					w.emitWindowResults(ctx, out, session.results, finalMeta)
 				}
 			}
 		}
@@ -270,9 +289,29 @@ func (*SessionWindow[T]) emitWindowResults(ctx context.Context, out chan<- Resul
 	}
 }
 
+// orderSessions sorts sessions by the configured emission order.
+// Sessions are returned unchanged when no order is configured.
+func (w *SessionWindow[T]) orderSessions(sessions []*sessionState[T]) []*sessionState[T] {
+	if w.less == nil {
+		return sessions
+	}
+	sort.SliceStable(sessions, func(i, j int) bool {
+		a, b := sessions[i].meta, sessions[j].meta
+		a.End = sessions[i].currentEndTime
+		b.End = sessions[j].currentEndTime
+		return w.less(a, b)
+	})
+	return sessions
+}
+
 // emitAllSessions emits all remaining sessions when processing ends.
 func (w *SessionWindow[T]) emitAllSessions(ctx context.Context, out chan<- Result[T], sessions map[string]*sessionState[T]) {
+	remaining := make([]*sessionState[T], 0, len(sessions))
 	for _, session := range sessions {
+		remaining = append(remaining, session)
+	}
+
+	for _, session := range w.orderSessions(remaining) {
 		if len(session.results) > 0 {
 			// Use current end time for final emission
 			finalMeta := session.meta
@@ -286,3 +286,84 @@ func TestSessionWindow_WindowMetadataFields(t *testing.T) {
 		t.Errorf("expected session key 'user123', got %v", meta.SessionKey)
 	}
 }
+
+func TestSessionWindow_WithOrderedEmission(t *testing.T) {
+	keyFunc := func(r Result[string]) string {
+		return r.Value()[:1]
+	}
+	// Reverse key order proves emission does not follow insertion or map order
+	byKeyDesc := func(a, b WindowMetadata) bool {
+		return *a.SessionKey > *b.SessionKey
+	}
+
+	t.Run("simultaneous expiry", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		clock := clockz.NewFakeClock()
+
+		window := NewSessionWindow(keyFunc, clock).
+			WithGap(100 * time.Millisecond).
+			WithOrderedEmission(byKeyDesc)
+
+		input := make(chan Result[string])
+		output := window.Process(ctx, input)
+
+		for _, v := range []string{"b1", "d1", "a1", "e1", "c1", "a2"} {
+			input <- NewSuccess(v)
+		}
+		// The processor stamps activity after receiving, so a trailing sentinel
+		// (ordered last) ensures every session above was stamped before advancing
+		input <- NewSuccess("0-sync")
+
+		clock.Advance(125 * time.Millisecond)
+		clock.BlockUntilReady()
+
+		var got []string
+		for len(got) < 6 {
+			select {
+			case r := <-output:
+				got = append(got, r.Value())
+			case <-time.After(time.Second):
+				t.Fatalf("timeout waiting for expired sessions, got %v", got)
+			}
+		}
+
+		expected := []string{"e1", "d1", "c1", "b1", "a1", "a2"}
+		for i := range expected {
+			if got[i] != expected[i] {
+				t.Fatalf("expected emission order %v, got %v", expected, got)
+			}
+		}
+		close(input)
+	})
+
+	t.Run("flush on close", func(t *testing.T) {
+		ctx := context.Background()
+		clock := clockz.NewFakeClock()
+
+		window := NewSessionWindow(keyFunc, clock).
+			WithGap(time.Minute).
+			WithOrderedEmission(byKeyDesc)
+
+		input := make(chan Result[string], 5)
+		for _, v := range []string{"c1", "a1", "e1", "b1", "d1"} {
+			input <- NewSuccess(v)
+		}
+		close(input)
+
+		var got []string
+		for r := range window.Process(ctx, input) {
+			got = append(got, r.Value())
+		}
+
+		expected := []string{"e1", "d1", "c1", "b1", "a1"}
+		if len(got) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, got)
+		}
+		for i := range expected {
+			if got[i] != expected[i] {
+				t.Fatalf("expected emission order %v, got %v", expected, got)
+			}
+		}
+	})
+}
@@ -2,6 +2,7 @@ package streamz
 
 import (
 	"context"
+	"sort"
 	"time"
 )
 
@@ -31,6 +32,7 @@ type SlidingWindow[T any] struct {
 	clock Clock
 	size  time.Duration
 	slide time.Duration
+	less  func(a, b WindowMetadata) bool // Optional emission order for simultaneous closes
 }
 
 // NewSlidingWindow creates a processor that groups Results into overlapping time windows.
@@ -108,6 +110,21 @@ func (w *SlidingWindow[T]) WithSlide(slide time.Duration) *SlidingWindow[T] {
 	return w
 }
 
+// WithOrderedEmission sets a deterministic emission order for windows that close together.
+// Windows expiring on the same tick, or flushed when processing ends, are emitted
+// in the order defined by less instead of map iteration order.
+//
+// Example:
+//
+//	// Emit overlapping windows oldest first
+//	window.WithOrderedEmission(func(a, b streamz.WindowMetadata) bool {
+//		return a.Start.Before(b.Start)
+//	})
+func (w *SlidingWindow[T]) WithOrderedEmission(less func(a, b WindowMetadata) bool) *SlidingWindow[T] {
+	w.less = less
+	return w
+}
+
 // WithName sets a custom name for this processor.
 // If not set, defaults to "sliding-window".
 func (w *SlidingWindow[T]) WithName(name string) *SlidingWindow[T] {
@@ -207,19 +224,19 @@ func (w *SlidingWindow[T]) Process(ctx context.Context, in <-chan Result[T]) <-c
 
 			case <-ticker.C():
 				now := w.clock.Now()
-				// Emit expired windows
-				expiredStarts := make([]time.Time, 0)
+				// Collect and remove expired windows
+				expired := make([]*windowState[T], 0)
 
 				for start, window := range windows {
 					if !window.meta.End.After(now) {
-						w.emitWindowResults(ctx, out, window.results, window.meta)
-						expiredStarts = append(expiredStarts, start)
+						expired = append(expired, window)
+						delete(windows, start)
 					}
 				}
 
-				// Clean up expired windows
-				for _, start := range expiredStarts {
-					delete(windows, start)
+				// Emit expired windows
+				for _, window := range w.orderWindows(expired) {
+					w.emitWindowResults(ctx, out, window.results, window.meta)
 				}
 			}
 		}
@@ -298,9 +315,26 @@ type windowState[T any] struct {
 	results []Result[T]
 }
 
+// orderWindows sorts windows by the configured emission order.
+// Windows are returned unchanged when no order is configured.
+func (w *SlidingWindow[T]) orderWindows(windows []*windowState[T]) []*windowState[T] {
+	if w.less == nil {
+		return windows
+	}
+	sort.SliceStable(windows, func(i, j int) bool {
+		return w.less(windows[i].meta, windows[j].meta)
+	})
+	return windows
+}
+
 // emitAllWindows emits all windows when processing ends.
 func (w *SlidingWindow[T]) emitAllWindows(ctx context.Context, out chan<- Result[T], windows map[time.Time]*windowState[T]) {
+	remaining := make([]*windowState[T], 0, len(windows))
 	for _, window := range windows {
+		remaining = append(remaining, window)
+	}
+
+	for _, window := range w.orderWindows(remaining) {
 		if len(window.results) > 0 {
 			w.emitWindowResults(ctx, out, window.results, window.meta)
 		}
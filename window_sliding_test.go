@@ -240,3 +240,57 @@ func TestSlidingWindow_OverlappingItems(t *testing.T) {
 		t.Errorf("expected at least 2 results with overlapping windows, got %d", len(results))
 	}
 }
+
+func TestSlidingWindow_WithOrderedEmission(t *testing.T) {
+	ctx := context.Background()
+	clock := clockz.NewFakeClock()
+
+	window := NewSlidingWindow[int](200*time.Millisecond, clock).
+		WithSlide(50 * time.Millisecond).
+		WithOrderedEmission(func(a, b WindowMetadata) bool {
+			return a.Start.Before(b.Start)
+		})
+
+	input := make(chan Result[int])
+	output := window.Process(ctx, input)
+
+	done := make(chan []Result[int])
+	go func() {
+		var results []Result[int]
+		for r := range output {
+			results = append(results, r)
+		}
+		done <- results
+	}()
+
+	// Open windows at 0ms, 50ms, and 100ms; none expire before close
+	for i := 0; i < 3; i++ {
+		input <- NewSuccess(i)
+		clock.Advance(50 * time.Millisecond)
+		clock.BlockUntilReady()
+	}
+	close(input)
+
+	var results []Result[int]
+	select {
+	case results = <-done:
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for windows to flush")
+	}
+
+	if len(results) == 0 {
+		t.Fatal("expected flushed windows")
+	}
+
+	var previous time.Time
+	for i, r := range results {
+		meta, err := GetWindowMetadata(r)
+		if err != nil {
+			t.Fatalf("result %d missing window metadata: %v", i, err)
+		}
+		if meta.Start.Before(previous) {
+			t.Fatalf("result %d: window starting %v emitted after window starting %v", i, meta.Start, previous)
+		}
+		previous = meta.Start
+	}
+}
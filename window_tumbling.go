@@ -27,11 +27,29 @@ import (
 //
 //nolint:govet // fieldalignment: struct layout optimized for readability
 type TumblingWindow[T any] struct {
-	name  string
-	clock Clock
-	size  time.Duration
+	name          string
+	clock         Clock
+	size          time.Duration
+	earlyFiring   time.Duration
+	grace         time.Duration
+	tsFn          func(T) time.Time
+	alignment     WindowAlignment
+	onWindowClose func(WindowMetadata, int)
 }
 
+// WindowAlignment controls where TumblingWindow boundaries fall.
+type WindowAlignment int
+
+// Tumbling window alignments.
+const (
+	// AlignToStart starts the first window when Process is called.
+	AlignToStart WindowAlignment = iota
+	// AlignToEpoch places boundaries at whole multiples of the window size since
+	// the Unix epoch, so a one-minute window always spans :00 to :01. The first
+	// window closes early, at the next boundary after Process is called.
+	AlignToEpoch
+)
+
 // NewTumblingWindow creates a processor that groups Results into fixed-size time windows.
 // Unlike sliding windows, tumbling windows don't overlap - each Result belongs to exactly
 // one window. Windows are emitted when their time period expires.
@@ -86,6 +104,68 @@ func NewTumblingWindow[T any](size time.Duration, clock Clock) *TumblingWindow[T
 	}
 }
 
+// WithEarlyFiring emits the open window's Results accumulated so far every interval,
+// each tagged with MetadataWindowPartial set to true, giving incremental visibility
+// into long windows. The final emission at window close is complete and carries no
+// partial flag. Partial emissions repeat earlier Results, so downstream consumers
+// should replace rather than accumulate them.
+// Intervals that are not positive or not shorter than the window size disable
+// early firing, which is the default. Under AlignToEpoch, early firings fall on
+// whole multiples of the interval since the Unix epoch, starting at the first one
+// after Process is called. The interval should divide the size evenly
+// to keep partial emissions aligned with window boundaries.
+func (w *TumblingWindow[T]) WithEarlyFiring(interval time.Duration) *TumblingWindow[T] {
+	w.earlyFiring = interval
+	return w
+}
+
+// WithGracePeriod keeps each window open for d past its end boundary so that
+// slightly late items are still included. Window emission is delayed by d, while
+// the window metadata keeps its nominal boundaries. Later items belong to the
+// next window.
+//
+// Arrival time alone cannot tell a late item from an on-time one, so without
+// WithTimestamp every item arriving within the grace period is assigned to the
+// closing window, including items that belong to the next one: each window
+// then loses its first d of items to the window before it. Set WithTimestamp to
+// assign each such item by its own timestamp instead.
+//
+// Grace periods that are not positive or not shorter than the window size are
+// ignored. If not set, windows close exactly at their boundary.
+func (w *TumblingWindow[T]) WithGracePeriod(d time.Duration) *TumblingWindow[T] {
+	w.grace = d
+	return w
+}
+
+// WithTimestamp sets how items arriving during a grace period are told apart:
+// an item whose timestamp is before the closing window's end joins that window,
+// and any other item joins the current one. Errors are assigned by the timestamp
+// of the item they carry. Windows are still opened and closed by the clock;
+// the timestamp only matters while a grace period is running.
+func (w *TumblingWindow[T]) WithTimestamp(tsFn func(T) time.Time) *TumblingWindow[T] {
+	w.tsFn = tsFn
+	return w
+}
+
+// OnWindowClose registers a callback invoked after each window's Results are
+// emitted, with the window metadata and the number of Results it held, errors
+// included. It also fires for empty windows and for the final window flushed
+// when processing stops.
+// Callbacks run on the processing goroutine and should return quickly.
+func (w *TumblingWindow[T]) OnWindowClose(fn func(meta WindowMetadata, count int)) *TumblingWindow[T] {
+	w.onWindowClose = fn
+	return w
+}
+
+// WithAlignment sets where window boundaries fall. With AlignToEpoch, windows
+// line up with wall-clock boundaries for matching external systems, and window
+// metadata reports the aligned boundaries even for the first, shortened window.
+// If not set, defaults to AlignToStart.
+func (w *TumblingWindow[T]) WithAlignment(alignment WindowAlignment) *TumblingWindow[T] {
+	w.alignment = alignment
+	return w
+}
+
 // WithName sets a custom name for this processor.
 func (w *TumblingWindow[T]) WithName(name string) *TumblingWindow[T] {
 	w.name = name
@@ -98,7 +178,8 @@ func (w *TumblingWindow[T]) WithName(name string) *TumblingWindow[T] {
 //
 // Window behavior:
 //   - Each Result gets window metadata attached (start, end, type, size)
-//   - Results are emitted exactly at their window boundary expiration
+//   - Results are emitted exactly at their window boundary expiration, or after the
+//     grace period when one is configured
 //   - Empty windows produce no output
 //   - On context cancellation or input close, partial windows emit their Results if non-empty
 //
@@ -113,44 +194,142 @@ func (w *TumblingWindow[T]) Process(ctx context.Context, in <-chan Result[T]) <-
 	go func() {
 		defer close(out)
 
-		ticker := w.clock.NewTicker(w.size)
-		defer ticker.Stop()
-
 		now := w.clock.Now()
+		start := now
+		if w.alignment == AlignToEpoch {
+			start = alignToEpoch(now, w.size)
+		}
 		currentWindow := WindowMetadata{
-			Start: now,
-			End:   now.Add(w.size),
+			Start: start,
+			End:   start.Add(w.size),
 			Type:  "tumbling",
 			Size:  w.size,
 		}
 
+		// Aligned windows wait for the first boundary before ticking every size
+		var ticker Ticker
+		var tickC <-chan time.Time
+		if currentWindow.End.Sub(now) < w.size {
+			boundary := w.clock.NewTimer(currentWindow.End.Sub(now))
+			defer boundary.Stop()
+			tickC = boundary.C()
+		} else {
+			ticker = w.clock.NewTicker(w.size)
+			tickC = ticker.C()
+		}
+		defer func() {
+			if ticker != nil {
+				ticker.Stop()
+			}
+		}()
+
+		// Aligned early firings likewise wait for the first multiple of the interval
+		var early Ticker
+		var earlyC <-chan time.Time
+		if w.earlyFiring > 0 && w.earlyFiring < w.size {
+			first := now.Add(w.earlyFiring)
+			if w.alignment == AlignToEpoch {
+				first = alignToEpoch(now, w.earlyFiring).Add(w.earlyFiring)
+			}
+			if first.Sub(now) < w.earlyFiring {
+				boundary := w.clock.NewTimer(first.Sub(now))
+				defer boundary.Stop()
+				earlyC = boundary.C()
+			} else {
+				early = w.clock.NewTicker(w.earlyFiring)
+				earlyC = early.C()
+			}
+		}
+		defer func() {
+			if early != nil {
+				early.Stop()
+			}
+		}()
+
 		var windowResults []Result[T]
 
+		// A window past its end boundary that still accepts late items during the grace period
+		var closing *WindowMetadata
+		var closingResults []Result[T]
+		var graceTimer Timer
+		var graceC <-chan time.Time
+		finishClosing := func(ctx context.Context) {
+			if closing == nil {
+				return
+			}
+			w.closeWindow(ctx, out, closingResults, *closing)
+			closing, closingResults, graceC = nil, nil, nil
+		}
+		defer func() {
+			if graceTimer != nil {
+				graceTimer.Stop()
+			}
+		}()
+
 		for {
 			select {
 			case <-ctx.Done():
 				// Emit remaining results - use background context to ensure delivery
-				w.emitWindowResults(context.Background(), out, windowResults, currentWindow)
+				finishClosing(context.Background())
+				w.closeWindow(context.Background(), out, windowResults, currentWindow)
 				return
 
 			case result, ok := <-in:
 				if !ok {
 					// Input closed, emit remaining results
-					w.emitWindowResults(ctx, out, windowResults, currentWindow)
+					finishClosing(ctx)
+					w.closeWindow(ctx, out, windowResults, currentWindow)
 					return
 				}
-				windowResults = append(windowResults, result)
+				if closing != nil && w.late(result, *closing) {
+					closingResults = append(closingResults, result)
+				} else {
+					windowResults = append(windowResults, result)
+				}
+
+			case fired := <-earlyC:
+				if early == nil {
+					early = w.clock.NewTicker(w.earlyFiring)
+					earlyC = early.C()
+				}
+				// The window close supersedes an early firing due at the same instant,
+				// whether it is handled before the close or after the next window opened
+				if !w.clock.Now().Before(currentWindow.End) || !fired.After(currentWindow.Start) {
+					continue
+				}
+				w.emitPartialResults(ctx, out, windowResults, currentWindow)
 
-			case <-ticker.C():
-				// Window expired, emit all results with window metadata
-				w.emitWindowResults(ctx, out, windowResults, currentWindow)
+			case <-graceC:
+				// Grace period over, finalize the late window
+				finishClosing(ctx)
+
+			case <-tickC:
+				if ticker == nil {
+					ticker = w.clock.NewTicker(w.size)
+					tickC = ticker.C()
+				}
+
+				if w.grace > 0 && w.grace < w.size {
+					// Hold the window open for late items
+					finishClosing(ctx)
+					closed := currentWindow
+					closing, closingResults = &closed, windowResults
+					graceTimer = w.clock.NewTimer(w.grace)
+					graceC = graceTimer.C()
+				} else {
+					// Window expired, emit all results with window metadata
+					w.closeWindow(ctx, out, windowResults, currentWindow)
+				}
 
 				// Create new window
 				windowResults = nil
-				now := w.clock.Now()
+				start := w.clock.Now()
+				if w.alignment == AlignToEpoch {
+					start = currentWindow.End
+				}
 				currentWindow = WindowMetadata{
-					Start: now,
-					End:   now.Add(w.size),
+					Start: start,
+					End:   start.Add(w.size),
 					Type:  "tumbling",
 					Size:  w.size,
 				}
@@ -161,6 +340,34 @@ func (w *TumblingWindow[T]) Process(ctx context.Context, in <-chan Result[T]) <-
 	return out
 }
 
+// late reports whether a Result arriving during the grace period belongs to the closing window.
+func (w *TumblingWindow[T]) late(result Result[T], closing WindowMetadata) bool {
+	if w.tsFn == nil {
+		return true
+	}
+	if result.IsError() {
+		return w.tsFn(result.Error().Item).Before(closing.End)
+	}
+	return w.tsFn(result.Value()).Before(closing.End)
+}
+
+// alignToEpoch returns the latest whole multiple of size since the Unix epoch at or before t.
+func alignToEpoch(t time.Time, size time.Duration) time.Time {
+	offset := time.Duration(t.UnixNano() % int64(size))
+	if offset < 0 {
+		offset += size
+	}
+	return t.Add(-offset)
+}
+
+// closeWindow emits a finished window's results and reports it to OnWindowClose.
+func (w *TumblingWindow[T]) closeWindow(ctx context.Context, out chan<- Result[T], results []Result[T], meta WindowMetadata) {
+	w.emitWindowResults(ctx, out, results, meta)
+	if w.onWindowClose != nil {
+		w.onWindowClose(meta, len(results))
+	}
+}
+
 // emitWindowResults emits all results in the window with window metadata attached.
 func (*TumblingWindow[T]) emitWindowResults(ctx context.Context, out chan<- Result[T], results []Result[T], meta WindowMetadata) {
 	for _, result := range results {
@@ -173,6 +380,18 @@ func (*TumblingWindow[T]) emitWindowResults(ctx context.Context, out chan<- Resu
 	}
 }
 
+// emitPartialResults emits the results accumulated so far, flagged as partial.
+func (*TumblingWindow[T]) emitPartialResults(ctx context.Context, out chan<- Result[T], results []Result[T], meta WindowMetadata) {
+	for _, result := range results {
+		partial := AddWindowMetadata(result, meta).WithMetadata(MetadataWindowPartial, true)
+		select {
+		case out <- partial:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // Name returns the processor name for debugging and monitoring.
 func (w *TumblingWindow[T]) Name() string {
 	return w.name
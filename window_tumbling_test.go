@@ -2,6 +2,7 @@ package streamz
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -211,3 +212,355 @@ func TestTumblingWindow_WindowMetadataFields(t *testing.T) {
 		t.Errorf("expected window duration %v, got %v", windowSize, meta.End.Sub(meta.Start))
 	}
 }
+
+func TestTumblingWindow_EarlyFiring(t *testing.T) {
+	ctx := context.Background()
+	clock := clockz.NewFakeClock()
+
+	window := NewTumblingWindow[int](time.Hour, clock).WithEarlyFiring(15 * time.Minute)
+	in := make(chan Result[int])
+	out := window.Process(ctx, in)
+
+	// receive reads n results and checks their values and partial flag
+	receive := func(expected []int, partial bool) {
+		t.Helper()
+		for i, want := range expected {
+			r := <-out
+			if r.Value() != want {
+				t.Errorf("result %d: expected %d, got %d", i, want, r.Value())
+			}
+			flag, found := r.GetMetadata(MetadataWindowPartial)
+			if partial && flag != true {
+				t.Errorf("result %d: expected partial flag, got %v", i, flag)
+			}
+			if !partial && found {
+				t.Errorf("result %d: expected no partial flag on final emission, got %v", i, flag)
+			}
+			if meta, err := GetWindowMetadata(r); err != nil || meta.Size != time.Hour {
+				t.Errorf("result %d: expected window metadata, got %+v (%v)", i, meta, err)
+			}
+		}
+	}
+
+	in <- NewSuccess(1)
+	in <- NewSuccess(2)
+
+	clock.Advance(15 * time.Minute)
+	clock.BlockUntilReady()
+	receive([]int{1, 2}, true)
+
+	in <- NewSuccess(3)
+
+	clock.Advance(15 * time.Minute)
+	clock.BlockUntilReady()
+	receive([]int{1, 2, 3}, true)
+
+	clock.Advance(15 * time.Minute)
+	clock.BlockUntilReady()
+	receive([]int{1, 2, 3}, true)
+
+	// Window close: the complete window, with no duplicate partial firing
+	clock.Advance(15 * time.Minute)
+	clock.BlockUntilReady()
+	receive([]int{1, 2, 3}, false)
+
+	// The next window starts empty; its early firings carry only new items
+	in <- NewSuccess(4)
+	clock.Advance(15 * time.Minute)
+	clock.BlockUntilReady()
+	receive([]int{4}, true)
+
+	close(in)
+	receive([]int{4}, false)
+	if _, ok := <-out; ok {
+		t.Error("expected output to close")
+	}
+}
+
+func TestTumblingWindow_EarlyFiringAlignedToEpoch(t *testing.T) {
+	ctx := context.Background()
+	// Start 25 seconds past the minute
+	clock := clockz.NewFakeClockAt(time.Date(2024, 1, 1, 12, 0, 25, 0, time.UTC))
+	minute := func(m int) time.Time { return time.Date(2024, 1, 1, 12, m, 0, 0, time.UTC) }
+
+	window := NewTumblingWindow[int](time.Minute, clock).
+		WithAlignment(AlignToEpoch).
+		WithEarlyFiring(15 * time.Second)
+	in := make(chan Result[int])
+	out := window.Process(ctx, in)
+
+	receive := func(expected []int, partial bool, start time.Time) {
+		t.Helper()
+		for i, want := range expected {
+			r := <-out
+			_, found := r.GetMetadata(MetadataWindowPartial)
+			meta, err := GetWindowMetadata(r)
+			if r.Value() != want || found != partial || err != nil || !meta.Start.Equal(start) {
+				t.Errorf("result %d: expected %d (partial %v) in window at %v, got %d (partial %v) in %+v (%v)",
+					i, want, partial, start, r.Value(), found, meta, err)
+			}
+		}
+	}
+
+	// The first early firing falls on the quarter minute, not 15s after Process
+	in <- NewSuccess(1)
+	clock.Advance(5 * time.Second)
+	clock.BlockUntilReady()
+	receive([]int{1}, true, minute(0))
+
+	in <- NewSuccess(2)
+	clock.Advance(15 * time.Second)
+	clock.BlockUntilReady()
+	receive([]int{1, 2}, true, minute(0))
+
+	// The window close at 12:01:00 supersedes the early firing due with it
+	clock.Advance(15 * time.Second)
+	clock.BlockUntilReady()
+	receive([]int{1, 2}, false, minute(0))
+
+	in <- NewSuccess(3)
+	clock.Advance(15 * time.Second)
+	clock.BlockUntilReady()
+	receive([]int{3}, true, minute(1))
+
+	close(in)
+	receive([]int{3}, false, minute(1))
+	if _, ok := <-out; ok {
+		t.Error("expected output to close")
+	}
+}
+
+func TestTumblingWindow_EarlyFiringDisabled(t *testing.T) {
+	ctx := context.Background()
+	clock := clockz.NewFakeClock()
+
+	// An interval no shorter than the window disables early firing
+	window := NewTumblingWindow[int](time.Minute, clock).WithEarlyFiring(time.Minute)
+	in := make(chan Result[int])
+	out := window.Process(ctx, in)
+
+	in <- NewSuccess(1)
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+
+	r := <-out
+	if _, found := r.GetMetadata(MetadataWindowPartial); found || r.Value() != 1 {
+		t.Errorf("expected single final emission, got %v", r)
+	}
+
+	close(in)
+	if _, ok := <-out; ok {
+		t.Error("expected output to close")
+	}
+}
+
+func TestTumblingWindow_GracePeriod(t *testing.T) {
+	ctx := context.Background()
+	clock := clockz.NewFakeClock()
+	start := clock.Now()
+
+	type closeEvent struct {
+		meta  WindowMetadata
+		count int
+	}
+	var closes []closeEvent
+	window := NewTumblingWindow[int](time.Minute, clock).
+		WithGracePeriod(10 * time.Second).
+		OnWindowClose(func(meta WindowMetadata, count int) {
+			closes = append(closes, closeEvent{meta: meta, count: count})
+		})
+	in := make(chan Result[int])
+	out := window.Process(ctx, in)
+
+	in <- NewSuccess(1)
+	in <- NewError(2, errors.New("bad"), "test")
+
+	// Past the boundary but within the grace period
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+	clock.Advance(5 * time.Second)
+	in <- NewSuccess(3)
+
+	// Grace period over: the first window is emitted with the late item
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+	for _, want := range []int{1, 2, 3} {
+		r := <-out
+		if r.IsSuccess() && r.Value() != want {
+			t.Errorf("expected %d in the first window, got %d", want, r.Value())
+		}
+		if meta, err := GetWindowMetadata(r); err != nil || !meta.Start.Equal(start) || !meta.End.Equal(start.Add(time.Minute)) {
+			t.Errorf("expected nominal first window boundaries, got %+v (%v)", meta, err)
+		}
+	}
+
+	// After the grace period: belongs to a later window
+	in <- NewSuccess(4)
+	close(in)
+	r := <-out
+	if meta, err := GetWindowMetadata(r); r.Value() != 4 || err != nil || meta.Start.Equal(start) {
+		t.Errorf("expected item 4 outside the first window, got %v in %+v", r.Value(), meta)
+	}
+	if _, ok := <-out; ok {
+		t.Error("expected output to close")
+	}
+
+	if len(closes) == 0 || !closes[0].meta.Start.Equal(start) || closes[0].count != 3 {
+		t.Fatalf("expected first close callback with 3 results, got %+v", closes)
+	}
+	total := 0
+	for _, c := range closes {
+		total += c.count
+	}
+	if total != 4 {
+		t.Errorf("expected close callbacks to account for all 4 results, got %d", total)
+	}
+}
+
+func TestTumblingWindow_GracePeriodAssignsByTimestamp(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := clockz.NewFakeClockAt(start.Add(30 * time.Second))
+
+	// Aligned, the first boundary is a one-shot timer, so a new waiter shows
+	// the boundary has been handled and the grace period has started
+	window := NewTumblingWindow[order](time.Minute, clock).
+		WithAlignment(AlignToEpoch).
+		WithGracePeriod(10 * time.Second).
+		WithTimestamp(orderTime)
+	in := make(chan Result[order])
+	out := window.Process(ctx, in)
+
+	in <- NewSuccess(order{1, start.Add(40 * time.Second)})
+	clock.Advance(30 * time.Second)
+	clock.BlockUntilReady()
+	waitForTimer(t, clock)
+
+	// Within the grace period: a late item and two stamped in the new window
+	clock.Advance(5 * time.Second)
+	in <- NewSuccess(order{2, start.Add(58 * time.Second)})
+	in <- NewError(order{3, start.Add(63 * time.Second)}, errors.New("bad"), "test")
+	in <- NewSuccess(order{4, start.Add(64 * time.Second)})
+
+	clock.Advance(5 * time.Second)
+	clock.BlockUntilReady()
+	expect := func(want int, windowStart time.Time) {
+		t.Helper()
+		r := <-out
+		var id int
+		if r.IsError() {
+			id = r.Error().Item.ID
+		} else {
+			id = r.Value().ID
+		}
+		meta, err := GetWindowMetadata(r)
+		if id != want || err != nil || !meta.Start.Equal(windowStart) {
+			t.Errorf("expected order %d in window starting %v, got %d in %+v (%v)", want, windowStart, id, meta, err)
+		}
+	}
+	expect(1, start)
+	expect(2, start)
+
+	close(in)
+	expect(3, start.Add(time.Minute))
+	expect(4, start.Add(time.Minute))
+	if _, ok := <-out; ok {
+		t.Error("expected output to close")
+	}
+}
+
+func TestTumblingWindow_OnWindowClose(t *testing.T) {
+	ctx := context.Background()
+	clock := clockz.NewFakeClock()
+
+	var counts []int
+	window := NewTumblingWindow[int](time.Minute, clock).
+		OnWindowClose(func(_ WindowMetadata, count int) {
+			counts = append(counts, count)
+		})
+	in := make(chan Result[int])
+	out := window.Process(ctx, in)
+
+	in <- NewSuccess(1)
+	in <- NewSuccess(2)
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+	<-out
+	<-out
+
+	close(in)
+	if _, ok := <-out; ok {
+		t.Error("expected output to close")
+	}
+
+	// The first window, then the empty final window flushed on close
+	if len(counts) != 2 || counts[0] != 2 || counts[1] != 0 {
+		t.Errorf("expected close counts [2 0], got %v", counts)
+	}
+}
+
+func TestTumblingWindow_AlignToEpoch(t *testing.T) {
+	ctx := context.Background()
+	// Start 25 seconds past the minute
+	clock := clockz.NewFakeClockAt(time.Date(2024, 1, 1, 12, 0, 25, 0, time.UTC))
+	minute := func(m int) time.Time { return time.Date(2024, 1, 1, 12, m, 0, 0, time.UTC) }
+
+	window := NewTumblingWindow[int](time.Minute, clock).WithAlignment(AlignToEpoch)
+	in := make(chan Result[int])
+	out := window.Process(ctx, in)
+
+	expectWindow := func(value int, start, end time.Time) {
+		t.Helper()
+		select {
+		case r := <-out:
+			meta, err := GetWindowMetadata(r)
+			if r.Value() != value || err != nil || !meta.Start.Equal(start) || !meta.End.Equal(end) {
+				t.Errorf("expected %d in [%v, %v), got %d in %+v (%v)", value, start, end, r.Value(), meta, err)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for item %d", value)
+		}
+	}
+
+	// The first window is cut short at 12:01:00
+	in <- NewSuccess(1)
+	clock.Advance(34 * time.Second)
+	clock.BlockUntilReady()
+	select {
+	case r := <-out:
+		t.Fatalf("expected no emission before the aligned boundary, got %v", r)
+	default:
+	}
+	clock.Advance(time.Second)
+	clock.BlockUntilReady()
+	expectWindow(1, minute(0), minute(1))
+
+	// Later windows span whole minutes
+	in <- NewSuccess(2)
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+	expectWindow(2, minute(1), minute(2))
+
+	in <- NewSuccess(3)
+	clock.Advance(time.Minute)
+	clock.BlockUntilReady()
+	expectWindow(3, minute(2), minute(3))
+	close(in)
+}
+
+func TestAlignToEpoch(t *testing.T) {
+	at := time.Date(2024, 1, 1, 12, 7, 42, 500, time.UTC)
+	tests := []struct {
+		size     time.Duration
+		expected time.Time
+	}{
+		{size: time.Minute, expected: time.Date(2024, 1, 1, 12, 7, 0, 0, time.UTC)},
+		{size: 5 * time.Minute, expected: time.Date(2024, 1, 1, 12, 5, 0, 0, time.UTC)},
+		{size: time.Hour, expected: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		if got := alignToEpoch(at, tt.size); !got.Equal(tt.expected) {
+			t.Errorf("size %v: expected %v, got %v", tt.size, tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package streamz
+
+import (
+	"context"
+	"log"
+)
+
+// Wrap converts a plain channel of values into a Result[T] channel.
+// Every value is emitted as a successful Result, allowing legacy producers
+// that emit raw values to feed Result-based processors directly.
+type Wrap[T any] struct {
+	name string
+}
+
+// NewWrap creates a processor that lifts plain values into successful Results.
+//
+// When to use:
+//   - Feeding existing `chan T` producers into streamz pipelines
+//   - Adapting third-party libraries that emit raw values
+//   - Incrementally migrating code to the Result[T] pattern
+//
+// Example:
+//
+//	// Legacy producer emits plain strings
+//	lines := readLines(file) // <-chan string
+//
+//	wrapped := streamz.NewWrap[string]().Process(ctx, lines)
+//	filtered := streamz.NewFilter(isComment).Process(ctx, wrapped)
+//
+// Returns a new Wrap processor.
+func NewWrap[T any]() *Wrap[T] {
+	return &Wrap[T]{
+		name: "wrap",
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "wrap".
+func (w *Wrap[T]) WithName(name string) *Wrap[T] {
+	w.name = name
+	return w
+}
+
+// Process wraps each input value in a successful Result.
+// The output channel closes when the input closes or the context is canceled.
+func (*Wrap[T]) Process(ctx context.Context, in <-chan T) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		for {
+			select {
+			case value, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case out <- NewSuccess(value):
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (w *Wrap[T]) Name() string {
+	return w.name
+}
+
+// Unwrap converts a Result[T] channel back into a plain channel of values.
+// Successful values are forwarded unchanged. Errors are handed to an optional
+// handler and never reach the output channel.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Unwrap[T any] struct {
+	name    string
+	onError func(*StreamError[T])
+}
+
+// NewUnwrap creates a processor that extracts successful values from Results.
+// The onError handler is invoked for every error Result; pass nil to drop errors silently.
+// Panics raised by the handler are recovered and logged so they cannot break the pipeline.
+//
+// When to use:
+//   - Handing pipeline output to consumers that expect `chan T`
+//   - Terminating a Result pipeline with centralized error logging
+//   - Bridging streamz processors into legacy code
+//
+// Example:
+//
+//	// Log errors and forward values to a legacy consumer
+//	unwrap := streamz.NewUnwrap(func(err *streamz.StreamError[Order]) {
+//		log.Printf("dropping failed order: %v", err)
+//	})
+//
+//	orders := unwrap.Process(ctx, results) // <-chan Order
+//	legacySink(orders)
+//
+// Parameters:
+//   - onError: Handler for error Results (nil drops errors)
+//
+// Returns a new Unwrap processor.
+func NewUnwrap[T any](onError func(*StreamError[T])) *Unwrap[T] {
+	return &Unwrap[T]{
+		name:    "unwrap",
+		onError: onError,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "unwrap".
+func (u *Unwrap[T]) WithName(name string) *Unwrap[T] {
+	u.name = name
+	return u
+}
+
+// Process forwards successful values and routes errors to the handler.
+// The output channel closes when the input closes or the context is canceled.
+func (u *Unwrap[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+				if item.IsError() {
+					u.handleError(item.Error())
+					continue
+				}
+				select {
+				case out <- item.Value():
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// handleError invokes the error handler with panic recovery.
+func (u *Unwrap[T]) handleError(err *StreamError[T]) {
+	if u.onError == nil {
+		return
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Unwrap[%s]: error handler panicked: %v", u.name, r)
+		}
+	}()
+	u.onError(err)
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (u *Unwrap[T]) Name() string {
+	return u.name
+}
@@ -0,0 +1,179 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWrap_Name(t *testing.T) {
+	wrap := NewWrap[int]()
+	if wrap.Name() != "wrap" {
+		t.Errorf("expected name 'wrap', got %q", wrap.Name())
+	}
+	if wrap.WithName("lift").Name() != "lift" {
+		t.Errorf("expected name 'lift', got %q", wrap.Name())
+	}
+
+	unwrap := NewUnwrap[int](nil)
+	if unwrap.Name() != "unwrap" {
+		t.Errorf("expected name 'unwrap', got %q", unwrap.Name())
+	}
+	if unwrap.WithName("lower").Name() != "lower" {
+		t.Errorf("expected name 'lower', got %q", unwrap.Name())
+	}
+}
+
+func TestWrap_AllSuccesses(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan string, 3)
+	in <- "a"
+	in <- "b"
+	in <- "c"
+	close(in)
+
+	var results []Result[string]
+	for result := range NewWrap[string]().Process(ctx, in) {
+		results = append(results, result)
+	}
+
+	expected := []string{"a", "b", "c"}
+	if len(results) != len(expected) {
+		t.Fatalf("expected %d results, got %d", len(expected), len(results))
+	}
+	for i, result := range results {
+		if result.IsError() {
+			t.Fatalf("expected success at %d, got error: %v", i, result.Error())
+		}
+		if result.Value() != expected[i] {
+			t.Errorf("expected %q at %d, got %q", expected[i], i, result.Value())
+		}
+	}
+}
+
+func TestUnwrap_ErrorHandling(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int], 4)
+	in <- NewSuccess(1)
+	in <- NewError(2, errors.New("bad"), "upstream")
+	in <- NewSuccess(3)
+	in <- NewError(4, errors.New("worse"), "upstream")
+	close(in)
+
+	var handled []int
+	unwrap := NewUnwrap(func(err *StreamError[int]) {
+		handled = append(handled, err.Item)
+	})
+
+	var values []int
+	for v := range unwrap.Process(ctx, in) {
+		values = append(values, v)
+	}
+
+	if len(values) != 2 || values[0] != 1 || values[1] != 3 {
+		t.Errorf("expected values [1 3], got %v", values)
+	}
+	if len(handled) != 2 || handled[0] != 2 || handled[1] != 4 {
+		t.Errorf("expected handled errors for items [2 4], got %v", handled)
+	}
+}
+
+func TestUnwrap_NilHandlerDropsErrors(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int], 2)
+	in <- NewError(1, errors.New("bad"), "upstream")
+	in <- NewSuccess(2)
+	close(in)
+
+	var values []int
+	for v := range NewUnwrap[int](nil).Process(ctx, in) {
+		values = append(values, v)
+	}
+
+	if len(values) != 1 || values[0] != 2 {
+		t.Errorf("expected values [2], got %v", values)
+	}
+}
+
+func TestUnwrap_HandlerPanicRecovered(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int], 2)
+	in <- NewError(1, errors.New("bad"), "upstream")
+	in <- NewSuccess(2)
+	close(in)
+
+	unwrap := NewUnwrap(func(_ *StreamError[int]) {
+		panic("handler failure")
+	})
+
+	var values []int
+	for v := range unwrap.Process(ctx, in) {
+		values = append(values, v)
+	}
+
+	if len(values) != 1 || values[0] != 2 {
+		t.Errorf("expected processing to continue after handler panic, got %v", values)
+	}
+}
+
+func TestWrapUnwrap_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan int, 5)
+	for i := 1; i <= 5; i++ {
+		in <- i
+	}
+	close(in)
+
+	// Fail even numbers in the Result-based stage
+	mapper := NewMapper(func(_ context.Context, n int) (int, error) {
+		if n%2 == 0 {
+			return n, errors.New("even")
+		}
+		return n * 10, nil
+	})
+
+	var failed []int
+	wrapped := NewWrap[int]().Process(ctx, in)
+	mapped := mapper.Process(ctx, wrapped)
+	unwrapped := NewUnwrap(func(err *StreamError[int]) {
+		failed = append(failed, err.Item)
+	}).Process(ctx, mapped)
+
+	var values []int
+	for v := range unwrapped {
+		values = append(values, v)
+	}
+
+	expectedValues := []int{10, 30, 50}
+	if len(values) != len(expectedValues) {
+		t.Fatalf("expected %v, got %v", expectedValues, values)
+	}
+	for i := range expectedValues {
+		if values[i] != expectedValues[i] {
+			t.Errorf("expected %v, got %v", expectedValues, values)
+			break
+		}
+	}
+	if len(failed) != 2 || failed[0] != 2 || failed[1] != 4 {
+		t.Errorf("expected failed items [2 4], got %v", failed)
+	}
+}
+
+func TestWrap_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+	out := NewWrap[int]().Process(ctx, in)
+
+	cancel()
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Error("expected output to be closed after cancellation")
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("output didn't close promptly after cancellation")
+	}
+	close(in)
+}
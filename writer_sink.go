@@ -0,0 +1,178 @@
+package streamz
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
+
+// WriterSink writes encoded items to an io.Writer, such as a file, socket, or
+// bufio.Writer. It is the terminal stage of a pipeline: successful items are
+// consumed, and only errors are emitted on the output channel.
+//
+// Writers that buffer output (anything with a Flush() error method, like
+// bufio.Writer) are flushed when the input closes and, optionally, on an interval.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type WriterSink[T any] struct {
+	name          string
+	w             io.Writer
+	encode        func(T) ([]byte, error)
+	flushInterval time.Duration
+	clock         Clock
+}
+
+// flusher is implemented by buffered writers such as bufio.Writer.
+type flusher interface {
+	Flush() error
+}
+
+// NewWriterSink creates a sink that encodes each successful item and writes it to w.
+// Encoding and write failures are emitted as per-item errors and processing continues.
+// Upstream errors pass through to the output unchanged.
+//
+// To write whole batches, use a slice type for T (for example after a Batcher)
+// and encode the batch in one call.
+//
+// When to use:
+//   - Persisting logs or events to files
+//   - Streaming newline-delimited JSON to a socket or stdout
+//   - Writing batched records through a bufio.Writer
+//
+// Example:
+//
+//	file, _ := os.Create("events.jsonl")
+//	buffered := bufio.NewWriter(file)
+//
+//	sink := streamz.NewWriterSink(buffered, func(e Event) ([]byte, error) {
+//		data, err := json.Marshal(e)
+//		return append(data, '\n'), err
+//	}).WithFlushInterval(time.Second, streamz.RealClock)
+//
+//	for result := range sink.Process(ctx, events) {
+//		log.Printf("write failed: %v", result.Error())
+//	}
+//
+// Parameters:
+//   - w: Destination writer
+//   - encode: Converts an item to the bytes written for it
+//
+// Returns a new WriterSink processor.
+func NewWriterSink[T any](w io.Writer, encode func(T) ([]byte, error)) *WriterSink[T] {
+	return &WriterSink[T]{
+		name:   "writer-sink",
+		w:      w,
+		encode: encode,
+		clock:  RealClock,
+	}
+}
+
+// WithFlushInterval flushes buffered writers every interval in addition to on close.
+// Has no effect if the writer has no Flush method. If not set, the writer is only
+// flushed when the input closes.
+func (s *WriterSink[T]) WithFlushInterval(interval time.Duration, clock Clock) *WriterSink[T] {
+	s.flushInterval = interval
+	s.clock = clock
+	return s
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "writer-sink".
+func (s *WriterSink[T]) WithName(name string) *WriterSink[T] {
+	s.name = name
+	return s
+}
+
+// Process writes every successful item and emits errors on the returned channel.
+// The output channel closes after the final flush when the input closes,
+// or immediately after a best-effort flush when the context is canceled.
+func (s *WriterSink[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		f, canFlush := s.w.(flusher)
+
+		var tickC <-chan time.Time
+		if canFlush && s.flushInterval > 0 {
+			ticker := s.clock.NewTicker(s.flushInterval)
+			defer ticker.Stop()
+			tickC = ticker.C()
+		}
+
+		emit := func(result Result[T]) bool {
+			select {
+			case out <- result:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		flush := func() bool {
+			if !canFlush {
+				return true
+			}
+			if err := f.Flush(); err != nil {
+				return emit(NewError(*new(T), fmt.Errorf("flush: %w", err), s.name))
+			}
+			return true
+		}
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+
+				if item.IsError() {
+					if !emit(item) {
+						return
+					}
+					continue
+				}
+
+				if err := s.write(item.Value()); err != nil {
+					failed := Result[T]{err: NewStreamError(item.Value(), err, s.name), metadata: item.metadata}
+					if !emit(failed) {
+						return
+					}
+				}
+
+			case <-tickC:
+				if !flush() {
+					return
+				}
+
+			case <-ctx.Done():
+				if canFlush {
+					f.Flush() //nolint:errcheck // best-effort flush, no one left to report to
+				}
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// write encodes a single item and writes it in full.
+func (s *WriterSink[T]) write(item T) error {
+	data, err := s.encode(item)
+	if err != nil {
+		return fmt.Errorf("encode: %w", err)
+	}
+	if _, err := s.w.Write(data); err != nil {
+		return fmt.Errorf("write: %w", err)
+	}
+	return nil
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (s *WriterSink[T]) Name() string {
+	return s.name
+}
@@ -0,0 +1,230 @@
+package streamz
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/clockz"
+)
+
+var errOddValue = errors.New("odd values cannot be encoded")
+
+// syncBuffer is a bytes.Buffer safe for concurrent writes and reads.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// failingWriter rejects every write.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func encodeLine(n int) ([]byte, error) {
+	return []byte(strconv.Itoa(n) + "\n"), nil
+}
+
+func encodeEvenLine(n int) ([]byte, error) {
+	if n%2 != 0 {
+		return nil, errOddValue
+	}
+	return encodeLine(n)
+}
+
+func TestWriterSink_Name(t *testing.T) {
+	sink := NewWriterSink(&bytes.Buffer{}, encodeLine)
+	if sink.Name() != "writer-sink" {
+		t.Errorf("expected name 'writer-sink', got %q", sink.Name())
+	}
+	if sink.WithName("log-writer").Name() != "log-writer" {
+		t.Errorf("expected name 'log-writer', got %q", sink.Name())
+	}
+}
+
+func TestWriterSink_WritesEncodedItems(t *testing.T) {
+	ctx := context.Background()
+	var buf bytes.Buffer
+
+	in := make(chan Result[int], 3)
+	in <- NewSuccess(1)
+	in <- NewSuccess(2)
+	in <- NewSuccess(3)
+	close(in)
+
+	var errs []Result[int]
+	for result := range NewWriterSink(&buf, encodeLine).Process(ctx, in) {
+		errs = append(errs, result)
+	}
+
+	if len(errs) != 0 {
+		t.Errorf("expected no errors, got %d", len(errs))
+	}
+	if buf.String() != "1\n2\n3\n" {
+		t.Errorf("expected \"1\\n2\\n3\\n\", got %q", buf.String())
+	}
+}
+
+func TestWriterSink_WritesBatches(t *testing.T) {
+	ctx := context.Background()
+	var buf bytes.Buffer
+
+	in := make(chan Result[[]int], 2)
+	in <- NewSuccess([]int{1, 2})
+	in <- NewSuccess([]int{3})
+	close(in)
+
+	encodeBatch := func(batch []int) ([]byte, error) {
+		var line []byte
+		for i, n := range batch {
+			if i > 0 {
+				line = append(line, ',')
+			}
+			line = strconv.AppendInt(line, int64(n), 10)
+		}
+		return append(line, '\n'), nil
+	}
+
+	for range NewWriterSink(&buf, encodeBatch).Process(ctx, in) {
+		t.Error("unexpected error")
+	}
+
+	if buf.String() != "1,2\n3\n" {
+		t.Errorf("expected one line per batch, got %q", buf.String())
+	}
+}
+
+func TestWriterSink_EncoderFailure(t *testing.T) {
+	ctx := context.Background()
+	var buf bytes.Buffer
+	upstreamErr := errors.New("upstream failed")
+
+	in := make(chan Result[int], 5)
+	in <- NewSuccess(2)
+	in <- NewSuccess(3).WithMetadata(MetadataSource, "sensor")
+	in <- NewError(0, upstreamErr, "reader")
+	in <- NewSuccess(4)
+	in <- NewSuccess(5)
+	close(in)
+
+	var errs []Result[int]
+	for result := range NewWriterSink(&buf, encodeEvenLine).Process(ctx, in) {
+		errs = append(errs, result)
+	}
+
+	if buf.String() != "2\n4\n" {
+		t.Errorf("expected writing to continue past failures, got %q", buf.String())
+	}
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 errors, got %d", len(errs))
+	}
+	if !errors.Is(errs[0].Error(), errOddValue) || errs[0].Error().Item != 3 || errs[0].Error().ProcessorName != "writer-sink" {
+		t.Errorf("expected encode error for item 3, got %v", errs[0].Error())
+	}
+	if source, _, _ := errs[0].GetStringMetadata(MetadataSource); source != "sensor" {
+		t.Errorf("expected metadata preserved on encode error, got %q", source)
+	}
+	if !errors.Is(errs[1].Error(), upstreamErr) || errs[1].Error().ProcessorName != "reader" {
+		t.Errorf("expected upstream error passed through, got %v", errs[1].Error())
+	}
+	if errs[2].Error().Item != 5 {
+		t.Errorf("expected encode error for item 5, got %v", errs[2].Error())
+	}
+}
+
+func TestWriterSink_WriteFailure(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan Result[int], 2)
+	in <- NewSuccess(1)
+	in <- NewSuccess(2)
+	close(in)
+
+	var errs []Result[int]
+	for result := range NewWriterSink(failingWriter{}, encodeLine).Process(ctx, in) {
+		errs = append(errs, result)
+	}
+
+	if len(errs) != 2 {
+		t.Fatalf("expected an error per item, got %d", len(errs))
+	}
+	for i, result := range errs {
+		if result.Error().Item != i+1 {
+			t.Errorf("expected write error for item %d, got %v", i+1, result.Error())
+		}
+	}
+}
+
+func TestWriterSink_FlushOnClose(t *testing.T) {
+	ctx := context.Background()
+	var buf bytes.Buffer
+	buffered := bufio.NewWriterSize(&buf, 4096)
+
+	in := make(chan Result[int])
+	out := NewWriterSink(buffered, encodeLine).Process(ctx, in)
+
+	in <- NewSuccess(1)
+	in <- NewSuccess(2)
+	close(in)
+
+	for range out {
+		t.Error("unexpected error")
+	}
+
+	if buf.String() != "1\n2\n" {
+		t.Errorf("expected buffered output flushed on close, got %q", buf.String())
+	}
+}
+
+func TestWriterSink_FlushInterval(t *testing.T) {
+	clock := clockz.NewFakeClock()
+	ctx := context.Background()
+	var dest syncBuffer
+	buffered := bufio.NewWriterSize(&dest, 4096)
+
+	in := make(chan Result[int])
+	out := NewWriterSink(buffered, encodeEvenLine).
+		WithFlushInterval(time.Second, clock).
+		Process(ctx, in)
+
+	in <- NewSuccess(2)
+	// A failing item confirms the previous write has been buffered
+	in <- NewSuccess(1)
+	<-out
+
+	if dest.String() != "" {
+		t.Fatalf("expected nothing flushed before interval, got %q", dest.String())
+	}
+
+	clock.Advance(time.Second)
+	clock.BlockUntilReady()
+
+	deadline := time.After(time.Second)
+	for dest.String() != "2\n" {
+		select {
+		case <-deadline:
+			t.Fatalf("expected interval flush, got %q", dest.String())
+		case <-time.After(time.Millisecond):
+		}
+	}
+
+	close(in)
+	for range out {
+	}
+}
@@ -0,0 +1,164 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrZipUnpaired is reported for items left without a partner when one Zip input
+// closes before the other.
+var ErrZipUnpaired = errors.New("unpaired zip item")
+
+// Zip combines two streams element by element, pairing the ith item of one with
+// the ith item of the other. Each output waits until both items at its position
+// have arrived, so the faster input is held back to the pace of the slower one.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type Zip[A, B, O any] struct {
+	name            string
+	combine         func(A, B) O
+	errorOnUnpaired bool
+}
+
+// NewZip creates a processor that emits combine(a, b) for each aligned pair.
+// If either item at a position is an error, that position produces an error
+// Result wrapping the original StreamError instead, preferring the first input's
+// error when both fail. Successful outputs carry the metadata of both items, with
+// the second input's entries taking precedence.
+//
+// When one input closes first, the rest of the other is drained and dropped. With
+// WithErrorOnUnpaired, each unpaired item is reported as an error wrapping
+// ErrZipUnpaired instead.
+//
+// When to use:
+//   - Joining request and response streams produced in the same order
+//   - Pairing values with labels, timestamps, or weights from a parallel source
+//   - Recombining the outputs of an order-preserving Fork
+//
+// Example:
+//
+//	zip := streamz.NewZip(func(q Quote, r Rate) Price {
+//		return Price{Symbol: q.Symbol, Amount: q.Amount * r.Value}
+//	}).WithErrorOnUnpaired()
+//
+//	prices := zip.Process(ctx, quotes, rates)
+//
+// Parameters:
+//   - combine: Builds the output from a pair of successful items
+//
+// Returns a new Zip processor.
+func NewZip[A, B, O any](combine func(A, B) O) *Zip[A, B, O] {
+	return &Zip[A, B, O]{
+		name:    "zip",
+		combine: combine,
+	}
+}
+
+// WithErrorOnUnpaired reports items left over when one input closes early as
+// errors rather than dropping them silently.
+func (z *Zip[A, B, O]) WithErrorOnUnpaired() *Zip[A, B, O] {
+	z.errorOnUnpaired = true
+	return z
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "zip".
+func (z *Zip[A, B, O]) WithName(name string) *Zip[A, B, O] {
+	z.name = name
+	return z
+}
+
+// Process pairs items from first and second in arrival order.
+// The output channel closes once both inputs have closed, or when the context
+// is canceled.
+func (z *Zip[A, B, O]) Process(ctx context.Context, first <-chan Result[A], second <-chan Result[B]) <-chan Result[O] {
+	out := make(chan Result[O])
+
+	go func() {
+		defer close(out)
+
+		var position int
+		for {
+			a, ok := receive(ctx, first)
+			if !ok {
+				if ctx.Err() == nil {
+					drainUnpaired(ctx, z, out, second, position, "first")
+				}
+				return
+			}
+
+			b, ok := receive(ctx, second)
+			if !ok {
+				if ctx.Err() != nil || !z.unpaired(ctx, out, position, "second") {
+					return
+				}
+				drainUnpaired(ctx, z, out, first, position+1, "second")
+				return
+			}
+
+			select {
+			case out <- z.pair(a, b):
+			case <-ctx.Done():
+				return
+			}
+			position++
+		}
+	}()
+
+	return out
+}
+
+// pair combines the items at one position, or converts the error among them.
+func (z *Zip[A, B, O]) pair(a Result[A], b Result[B]) Result[O] {
+	switch {
+	case a.IsError():
+		return Result[O]{err: &StreamError[O]{
+			Err:           a.Error(),
+			ProcessorName: z.name,
+			Timestamp:     a.Error().Timestamp,
+		}, metadata: a.metadata}
+	case b.IsError():
+		return Result[O]{err: &StreamError[O]{
+			Err:           b.Error(),
+			ProcessorName: z.name,
+			Timestamp:     b.Error().Timestamp,
+		}, metadata: b.metadata}
+	default:
+		return Result[O]{value: z.combine(a.Value(), b.Value()), metadata: a.metadata}.WithMetadataMap(b.metadata)
+	}
+}
+
+// drainUnpaired consumes the rest of the longer input after the other has closed,
+// reporting each item from position onward if configured to.
+func drainUnpaired[T, A, B, O any](ctx context.Context, z *Zip[A, B, O], out chan<- Result[O], in <-chan Result[T], position int, closed string) {
+	for {
+		if _, ok := receive(ctx, in); !ok {
+			return
+		}
+		if !z.unpaired(ctx, out, position, closed) {
+			return
+		}
+		position++
+	}
+}
+
+// unpaired reports the item at position as lacking a partner when configured to.
+// Returns false if the context was canceled.
+func (z *Zip[A, B, O]) unpaired(ctx context.Context, out chan<- Result[O], position int, closed string) bool {
+	if !z.errorOnUnpaired {
+		return true
+	}
+	var zero O
+	select {
+	case out <- NewError(zero, fmt.Errorf("%w: position %d, %s input closed", ErrZipUnpaired, position, closed), z.name):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (z *Zip[A, B, O]) Name() string {
+	return z.name
+}
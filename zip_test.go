@@ -0,0 +1,131 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func zipLabel(n int, s string) string { return fmt.Sprintf("%d%s", n, s) }
+
+// zipInputs returns closed, buffered inputs holding the given items.
+func zipInputs(as []Result[int], bs []Result[string]) (first chan Result[int], second chan Result[string]) {
+	first = make(chan Result[int], len(as))
+	for _, a := range as {
+		first <- a
+	}
+	close(first)
+	second = make(chan Result[string], len(bs))
+	for _, b := range bs {
+		second <- b
+	}
+	close(second)
+	return first, second
+}
+
+func TestZip_Name(t *testing.T) {
+	zip := NewZip(zipLabel)
+	if zip.Name() != "zip" {
+		t.Errorf("expected name 'zip', got %q", zip.Name())
+	}
+	if zip.WithName("pair").Name() != "pair" {
+		t.Errorf("expected name 'pair', got %q", zip.Name())
+	}
+}
+
+func TestZip_PairsEqualLengthStreams(t *testing.T) {
+	first, second := zipInputs(
+		[]Result[int]{NewSuccess(1).WithMetadata(MetadataSource, "left"), NewSuccess(2), NewSuccess(3)},
+		[]Result[string]{NewSuccess("a").WithMetadata(MetadataSource, "right"), NewSuccess("b"), NewSuccess("c")},
+	)
+
+	results := collectResults(NewZip(zipLabel).Process(context.Background(), first, second), time.Second)
+	if len(results) != 3 {
+		t.Fatalf("expected 3 pairs, got %d", len(results))
+	}
+	for i, want := range []string{"1a", "2b", "3c"} {
+		if results[i].Value() != want {
+			t.Errorf("position %d: expected %q, got %v", i, want, results[i])
+		}
+	}
+	// The second input's metadata takes precedence
+	if source, _, _ := results[0].GetStringMetadata(MetadataSource); source != "right" {
+		t.Errorf("expected merged metadata source 'right', got %q", source)
+	}
+}
+
+func TestZip_DropsUnpairedByDefault(t *testing.T) {
+	first, second := zipInputs(
+		[]Result[int]{NewSuccess(1), NewSuccess(2), NewSuccess(3), NewSuccess(4)},
+		[]Result[string]{NewSuccess("a"), NewSuccess("b")},
+	)
+
+	results := collectResults(NewZip(zipLabel).Process(context.Background(), first, second), time.Second)
+	if len(results) != 2 || results[0].Value() != "1a" || results[1].Value() != "2b" {
+		t.Errorf("expected [1a 2b] with leftovers dropped, got %v", results)
+	}
+	if len(first) != 0 {
+		t.Errorf("expected longer input drained, %d items left", len(first))
+	}
+}
+
+func TestZip_ErrorsOnUnpaired(t *testing.T) {
+	first, second := zipInputs(
+		[]Result[int]{NewSuccess(1)},
+		[]Result[string]{NewSuccess("a"), NewSuccess("b"), NewSuccess("c")},
+	)
+
+	results := collectResults(NewZip(zipLabel).WithErrorOnUnpaired().Process(context.Background(), first, second), time.Second)
+	if len(results) != 3 {
+		t.Fatalf("expected 1 pair and 2 unpaired errors, got %d results", len(results))
+	}
+	if results[0].Value() != "1a" {
+		t.Errorf("expected first pair 1a, got %v", results[0])
+	}
+	for _, result := range results[1:] {
+		if !result.IsError() || !errors.Is(result.Error().Err, ErrZipUnpaired) {
+			t.Errorf("expected ErrZipUnpaired, got %v", result)
+		}
+	}
+
+	// A shorter second input leaves the already-read first item unpaired too
+	first, second = zipInputs(
+		[]Result[int]{NewSuccess(1), NewSuccess(2), NewSuccess(3)},
+		[]Result[string]{NewSuccess("a")},
+	)
+	results = collectResults(NewZip(zipLabel).WithErrorOnUnpaired().Process(context.Background(), first, second), time.Second)
+	if len(results) != 3 || results[0].Value() != "1a" || !results[1].IsError() || !results[2].IsError() {
+		t.Errorf("expected [1a error error], got %v", results)
+	}
+}
+
+func TestZip_ErrorSurfacesAtItsPosition(t *testing.T) {
+	errDecode := errors.New("decode failed")
+	errLookup := errors.New("lookup failed")
+	first, second := zipInputs(
+		[]Result[int]{NewSuccess(1), NewError(0, errDecode, "decoder"), NewSuccess(3), NewError(0, errDecode, "decoder")},
+		[]Result[string]{NewSuccess("a"), NewSuccess("b"), NewError("", errLookup, "lookup"), NewError("", errLookup, "lookup")},
+	)
+
+	results := collectResults(NewZip(zipLabel).Process(context.Background(), first, second), time.Second)
+	if len(results) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(results))
+	}
+	if results[0].Value() != "1a" {
+		t.Errorf("expected 1a, got %v", results[0])
+	}
+	if !results[1].IsError() || !errors.Is(results[1].Error().Err, errDecode) {
+		t.Errorf("expected first-input error at position 1, got %v", results[1])
+	}
+	if !results[2].IsError() || !errors.Is(results[2].Error().Err, errLookup) {
+		t.Errorf("expected second-input error at position 2, got %v", results[2])
+	}
+	if !results[3].IsError() || !errors.Is(results[3].Error().Err, errDecode) {
+		t.Errorf("expected first-input error preferred at position 3, got %v", results[3])
+	}
+	if results[1].Error().ProcessorName != "zip" {
+		t.Errorf("expected error from zip, got %q", results[1].Error().ProcessorName)
+	}
+}
@@ -0,0 +1,186 @@
+package streamz
+
+import (
+	"context"
+	"math"
+	"sync/atomic"
+)
+
+// Z-score anomaly metadata keys set by ZScoreAnomaly.
+const (
+	MetadataAnomaly = "anomaly" // bool - |z-score| exceeded the threshold
+	MetadataZScore  = "z_score" // float64 - standard deviations from the rolling mean of the preceding values
+)
+
+// ZScoreAnomaly flags statistical outliers by comparing each value with the mean
+// and standard deviation of the values before it. Unlike a fixed threshold, the
+// baseline follows the stream, so the same detector works for quiet and busy
+// periods alike.
+//
+//nolint:govet // fieldalignment: struct layout optimized for readability
+type ZScoreAnomaly[T any] struct {
+	name       string
+	valFn      func(T) float64
+	windowSize int
+	threshold  float64
+	anomalies  atomic.Uint64
+}
+
+// zscoreWindow keeps a ring of recent values.
+type zscoreWindow struct {
+	values []float64
+	next   int
+}
+
+// NewZScoreAnomaly creates a processor that flags values far from the rolling mean.
+// Each successful item's z-score is computed against the population mean and
+// standard deviation of the previous windowSize values, so an outlier cannot
+// dampen its own score. Items are forwarded with MetadataAnomaly, true when the
+// absolute z-score exceeds threshold, and MetadataZScore once at least two values
+// have been seen. When the window has no variance, a value equal to the mean
+// scores 0 and any other value scores ±Inf.
+//
+// Mean and deviation are recomputed from the window for each item, costing
+// O(windowSize) but staying exact for large magnitudes and long streams.
+// Every value, including flagged ones, then enters the window, so a lasting
+// level shift stops being anomalous once the window has adapted to it. Errors
+// pass through unchanged and do not enter the window.
+//
+// When to use:
+//   - Detecting latency, error-rate, or traffic spikes without hand-tuned limits
+//   - Flagging sensor readings that break from their recent pattern
+//   - Surfacing unusual transactions for review
+//
+// Example:
+//
+//	detector := streamz.NewZScoreAnomaly(func(m Metric) float64 {
+//		return m.ErrorRate
+//	}, 60, 3)
+//
+//	for result := range detector.Process(ctx, metrics) {
+//		if anomaly, _ := result.GetMetadata(streamz.MetadataAnomaly); anomaly == true {
+//			score, _ := result.GetMetadata(streamz.MetadataZScore)
+//			alert(result.Value(), score)
+//		}
+//	}
+//
+// Parameters:
+//   - valFn: Extracts the observed value from an item
+//   - windowSize: Number of preceding values forming the baseline (must be at least 2)
+//   - threshold: Absolute z-score above which an item is flagged (must be positive)
+//
+// Returns a new ZScoreAnomaly processor.
+// Panics if windowSize is less than 2 or threshold is not positive.
+func NewZScoreAnomaly[T any](valFn func(T) float64, windowSize int, threshold float64) *ZScoreAnomaly[T] {
+	if windowSize < 2 {
+		panic("z-score anomaly windowSize must be at least 2")
+	}
+	if threshold <= 0 {
+		panic("z-score anomaly threshold must be positive")
+	}
+
+	return &ZScoreAnomaly[T]{
+		name:       "zscore-anomaly",
+		valFn:      valFn,
+		windowSize: windowSize,
+		threshold:  threshold,
+	}
+}
+
+// WithName sets a custom name for this processor.
+// If not set, defaults to "zscore-anomaly".
+func (z *ZScoreAnomaly[T]) WithName(name string) *ZScoreAnomaly[T] {
+	z.name = name
+	return z
+}
+
+// AnomalyCount returns the number of items flagged as anomalies.
+// Safe to call concurrently with Process.
+func (z *ZScoreAnomaly[T]) AnomalyCount() uint64 {
+	return z.anomalies.Load()
+}
+
+// Process scores each successful item against the rolling baseline and annotates it.
+func (z *ZScoreAnomaly[T]) Process(ctx context.Context, in <-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+
+	go func() {
+		defer close(out)
+
+		window := &zscoreWindow{values: make([]float64, 0, z.windowSize)}
+
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if item.IsSuccess() {
+				value := z.valFn(item.Value())
+				anomaly := false
+				if score, scored := window.score(value); scored {
+					anomaly = math.Abs(score) > z.threshold
+					item = item.WithMetadata(MetadataZScore, score)
+				}
+				if anomaly {
+					z.anomalies.Add(1)
+				}
+				item = item.WithMetadata(MetadataAnomaly, anomaly)
+				window.add(value)
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// score returns the z-score of value against the window, if it holds at least two values.
+func (w *zscoreWindow) score(value float64) (float64, bool) {
+	n := float64(len(w.values))
+	if n < 2 {
+		return 0, false
+	}
+
+	// Two passes over the window avoid the cancellation of sum-of-squares formulas
+	// on large magnitudes and any drift from subtracting evicted values
+	var mean float64
+	for _, v := range w.values {
+		mean += v
+	}
+	mean /= n
+	var variance float64
+	for _, v := range w.values {
+		variance += (v - mean) * (v - mean)
+	}
+	variance /= n
+
+	deviation := value - mean
+	if stddev := math.Sqrt(variance); stddev > 0 {
+		return deviation / stddev, true
+	}
+	if deviation == 0 {
+		return 0, true
+	}
+	return math.Copysign(math.Inf(1), deviation), true
+}
+
+// add inserts value, evicting the oldest once the window is full.
+func (w *zscoreWindow) add(value float64) {
+	if len(w.values) < cap(w.values) {
+		w.values = append(w.values, value)
+		return
+	}
+	w.values[w.next] = value
+	w.next = (w.next + 1) % len(w.values)
+}
+
+// Name returns the processor name for debugging and monitoring.
+func (z *ZScoreAnomaly[T]) Name() string {
+	return z.name
+}
@@ -0,0 +1,155 @@
+package streamz
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func identityFloat(v float64) float64 { return v }
+
+// zscoreOf reads the anomaly flag and z-score of a Result.
+func zscoreOf(t *testing.T, r Result[float64]) (anomaly bool, score float64, scored bool) {
+	t.Helper()
+	flag, found := r.GetMetadata(MetadataAnomaly)
+	anomaly, ok := flag.(bool)
+	if !found || !ok {
+		t.Fatalf("expected bool anomaly metadata, got %v", flag)
+	}
+	raw, scored := r.GetMetadata(MetadataZScore)
+	if scored {
+		score, _ = raw.(float64)
+	}
+	return anomaly, score, scored
+}
+
+func TestZScoreAnomaly_Name(t *testing.T) {
+	detector := NewZScoreAnomaly(identityFloat, 10, 3)
+	if detector.Name() != "zscore-anomaly" {
+		t.Errorf("expected name 'zscore-anomaly', got %q", detector.Name())
+	}
+	if detector.WithName("latency-outliers").Name() != "latency-outliers" {
+		t.Errorf("expected name 'latency-outliers', got %q", detector.Name())
+	}
+}
+
+func TestZScoreAnomaly_PanicsOnInvalidConfig(t *testing.T) {
+	for _, tc := range []struct {
+		window    int
+		threshold float64
+	}{{1, 3}, {10, 0}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for window %d, threshold %v", tc.window, tc.threshold)
+				}
+			}()
+			NewZScoreAnomaly(identityFloat, tc.window, tc.threshold)
+		}()
+	}
+}
+
+func TestZScoreAnomaly_FlagsOutliers(t *testing.T) {
+	// A baseline alternating 9 and 11 has mean 10 and standard deviation 1
+	in := make(chan Result[float64], 16)
+	for i := 0; i < 10; i++ {
+		in <- NewSuccess(9 + float64(i%2)*2)
+	}
+	in <- NewSuccess(11.5)
+	in <- NewError(0.0, errors.New("bad"), "upstream")
+	in <- NewSuccess(15.0)
+	close(in)
+
+	detector := NewZScoreAnomaly(identityFloat, 10, 3)
+	results := collectResults(detector.Process(context.Background(), in), time.Second)
+	if len(results) != 13 {
+		t.Fatalf("expected 13 results, got %d", len(results))
+	}
+
+	// The first two values have no baseline to be scored against
+	for i, result := range results[:2] {
+		if anomaly, _, scored := zscoreOf(t, result); anomaly || scored {
+			t.Errorf("value %d: expected unscored and unflagged, got anomaly=%v scored=%v", i, anomaly, scored)
+		}
+	}
+	for i, result := range results[2:10] {
+		if anomaly, score, _ := zscoreOf(t, result); anomaly || math.Abs(score) > 3 {
+			t.Errorf("baseline value %d: expected within normal variance, got z=%v anomaly=%v", i+2, score, anomaly)
+		}
+	}
+
+	// Within normal variance: (11.5 - 10) / 1
+	if anomaly, score, _ := zscoreOf(t, results[10]); anomaly || math.Abs(score-1.5) > 1e-9 {
+		t.Errorf("expected 11.5 unflagged with z=1.5, got z=%v anomaly=%v", score, anomaly)
+	}
+
+	if !results[11].IsError() || results[11].HasMetadata() {
+		t.Errorf("expected error passed through without annotation, got %v", results[11])
+	}
+
+	// 11.5 evicted the oldest 9 from the baseline
+	window := []float64{11, 9, 11, 9, 11, 9, 11, 9, 11, 11.5}
+	var mean, variance float64
+	for _, v := range window {
+		mean += v / 10
+	}
+	for _, v := range window {
+		variance += (v - mean) * (v - mean) / 10
+	}
+	want := (15 - mean) / math.Sqrt(variance)
+	if anomaly, score, _ := zscoreOf(t, results[12]); !anomaly || math.Abs(score-want) > 1e-9 {
+		t.Errorf("expected 15 flagged with z=%.3f, got z=%v anomaly=%v", want, score, anomaly)
+	}
+	if detector.AnomalyCount() != 1 {
+		t.Errorf("expected 1 anomaly, got %d", detector.AnomalyCount())
+	}
+}
+
+func TestZScoreAnomaly_LargeMagnitudeBaseline(t *testing.T) {
+	// Around 1e9 a sum-of-squares variance cancels to zero and scores everything ±Inf
+	const base = 1e9
+	noise := []float64{-1, 1, 0.5, -0.5, 1.5, -1.5, 0, 1, -1, 0.25}
+
+	in := make(chan Result[float64], 1000+1)
+	for i := 0; i < 1000; i++ {
+		in <- NewSuccess(base + noise[i%len(noise)])
+	}
+	in <- NewSuccess(base + 10)
+	close(in)
+
+	detector := NewZScoreAnomaly(identityFloat, 50, 3)
+	results := collectResults(detector.Process(context.Background(), in), time.Second)
+	if len(results) != 1001 {
+		t.Fatalf("expected 1001 results, got %d", len(results))
+	}
+	for i, result := range results[2:1000] {
+		if anomaly, score, _ := zscoreOf(t, result); anomaly || math.IsInf(score, 0) {
+			t.Fatalf("value %d: expected normal noise unflagged, got z=%v", i+2, score)
+		}
+	}
+	if anomaly, score, _ := zscoreOf(t, results[1000]); !anomaly || score < 5 || math.IsInf(score, 0) {
+		t.Errorf("expected base+10 flagged with a finite score, got z=%v anomaly=%v", score, anomaly)
+	}
+	if detector.AnomalyCount() != 1 {
+		t.Errorf("expected 1 anomaly, got %d", detector.AnomalyCount())
+	}
+}
+
+func TestZScoreAnomaly_ZeroVariance(t *testing.T) {
+	in := make(chan Result[float64], 4)
+	in <- NewSuccess(5.0)
+	in <- NewSuccess(5.0)
+	in <- NewSuccess(5.0)
+	in <- NewSuccess(4.0)
+	close(in)
+
+	results := collectResults(NewZScoreAnomaly(identityFloat, 5, 3).Process(context.Background(), in), time.Second)
+	if anomaly, score, _ := zscoreOf(t, results[2]); anomaly || score != 0 {
+		t.Errorf("expected value equal to a constant baseline to score 0, got z=%v", score)
+	}
+	if anomaly, score, _ := zscoreOf(t, results[3]); !anomaly || !math.IsInf(score, -1) {
+		t.Errorf("expected deviation from a constant baseline to score -Inf, got z=%v", score)
+	}
+}